@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package v1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestGetAutoscaledReplicas(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := &coh.Coherence{}
+	deployment.Spec.Replicas = ptr.To(int32(3))
+	deployment.Status.Autoscale = &coh.AutoscaleStatus{Replicas: ptr.To(int32(5))}
+
+	// the autoscaled replicas are only used while autoscaling is enabled
+	_, ok := deployment.GetAutoscaledReplicas()
+	g.Expect(ok).To(BeFalse())
+	g.Expect(deployment.GetReplicas()).To(Equal(int32(3)))
+
+	deployment.Spec.Scaling = &coh.ScalingSpec{Autoscale: &coh.AutoscaleSpec{MinReplicas: 1, MaxReplicas: 6}}
+	replicas, ok := deployment.GetAutoscaledReplicas()
+	g.Expect(ok).To(BeTrue())
+	g.Expect(replicas).To(Equal(int32(5)))
+	g.Expect(deployment.GetReplicas()).To(Equal(int32(5)))
+
+	// setting the replicas to zero still stops the deployment
+	deployment.Spec.Replicas = ptr.To(int32(0))
+	_, ok = deployment.GetAutoscaledReplicas()
+	g.Expect(ok).To(BeFalse())
+	g.Expect(deployment.GetReplicas()).To(BeZero())
+
+	// the deployment has not been autoscaled yet
+	deployment.Spec.Replicas = ptr.To(int32(3))
+	deployment.Status.Autoscale = nil
+	_, ok = deployment.GetAutoscaledReplicas()
+	g.Expect(ok).To(BeFalse())
+	g.Expect(deployment.GetReplicas()).To(Equal(int32(3)))
+}
@@ -11,19 +11,25 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/go-logr/logr"
 	"github.com/go-test/deep"
 	"github.com/oracle/coherence-operator/pkg/operator"
 	"github.com/pkg/errors"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/robfig/cron/v3"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -34,6 +40,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 // Common Coherence API structs
@@ -493,6 +500,12 @@ func (in *CoherenceSpec) RequiresWKAService() bool {
 	return true
 }
 
+// IsStorageEnabled returns true if this deployment is storage enabled.
+// If the StorageEnabled field is not set the default is true.
+func (in *CoherenceSpec) IsStorageEnabled() bool {
+	return in == nil || in.StorageEnabled == nil || *in.StorageEnabled
+}
+
 // GetWKA returns the host name Coherence should for WKA.
 func (in *CoherenceSpec) GetWKA(deployment CoherenceResource) string {
 	var ns string
@@ -614,9 +627,11 @@ func (in *CoherenceSpec) UpdatePodTemplateSpec(podTemplate *corev1.PodTemplateSp
 	}
 
 	in.Management.AddSSLVolumesForPod(podTemplate, c, VolumeNameManagementSSL, VolumeMountPathManagementCerts)
+	in.Management.AddCertManagerVolumesForPod(deployment, podTemplate, c, PortNameManagement, VolumeNameManagementSSL, VolumeMountPathManagementCerts)
 	c.Env = append(c.Env, in.Management.CreateEnvVars(EnvVarCohMgmtPrefix, VolumeMountPathManagementCerts, DefaultManagementPort)...)
 
 	in.Metrics.AddSSLVolumesForPod(podTemplate, c, VolumeNameMetricsSSL, VolumeMountPathMetricsCerts)
+	in.Metrics.AddCertManagerVolumesForPod(deployment, podTemplate, c, PortNameMetrics, VolumeNameMetricsSSL, VolumeMountPathMetricsCerts)
 	c.Env = append(c.Env, in.Metrics.CreateEnvVars(EnvVarCohMetricsPrefix, VolumeMountPathMetricsCerts, DefaultMetricsPort)...)
 
 	// set the persistence mode
@@ -624,6 +639,9 @@ func (in *CoherenceSpec) UpdatePodTemplateSpec(podTemplate *corev1.PodTemplateSp
 		c.Env = append(c.Env, corev1.EnvVar{Name: EnvVarCohPersistenceMode, Value: *mode})
 	}
 
+	// configure the snapshot archiver
+	c.Env = append(c.Env, in.Persistence.GetArchiver().CreateEnvVars()...)
+
 	in.AddPersistenceVolumeMounts(c)
 	in.AddPersistenceVolumes(podTemplate)
 }
@@ -665,6 +683,27 @@ func (in *CoherenceSpec) GetManagementPort() int32 {
 	}
 }
 
+// IsManagementEnabled returns true if Coherence management over REST is enabled.
+func (in *CoherenceSpec) IsManagementEnabled() bool {
+	return in != nil && in.Management != nil && notNilBool(in.Management.Enabled)
+}
+
+// CreateCertificateResources creates the cert-manager Certificates for the SSL enabled
+// management and metrics endpoints.
+func (in *CoherenceSpec) CreateCertificateResources(deployment CoherenceResource) []Resource {
+	var res []Resource
+	if in == nil {
+		return res
+	}
+	if cert := in.Management.CreateCertificate(deployment, PortNameManagement); cert != nil {
+		res = append(res, Resource{Kind: ResourceTypeCertificate, Name: cert.GetName(), Spec: cert})
+	}
+	if cert := in.Metrics.CreateCertificate(deployment, PortNameMetrics); cert != nil {
+		res = append(res, Resource{Kind: ResourceTypeCertificate, Name: cert.GetName(), Spec: cert})
+	}
+	return res
+}
+
 // GetPersistenceSpec returns the Coherence persistence specification.
 func (in *CoherenceSpec) GetPersistenceSpec() *PersistenceSpec {
 	if in == nil {
@@ -916,6 +955,33 @@ type PersistenceSpec struct {
 	// persistence data in the Persistence section.
 	// +optional
 	Snapshots *PersistentStorageSpec `json:"snapshots,omitempty"`
+	// SnapshotSchedule configures the Operator to create snapshots of persistent services
+	// on a schedule and to remove old snapshots.
+	// Coherence management over REST must be enabled to use scheduled snapshots.
+	// +optional
+	SnapshotSchedule *SnapshotScheduleSpec `json:"snapshotSchedule,omitempty"`
+	// Archiver configures an S3 compatible object store that Coherence uses to archive
+	// and retrieve snapshots. The archiver is configured in the Coherence operational
+	// configuration with the id "coherence-operator-s3", which must be set as the
+	// archiver in the persistence configuration of services in the cache configuration.
+	// +optional
+	Archiver *SnapshotArchiverSpec `json:"archiver,omitempty"`
+}
+
+// GetArchiver returns the snapshot archiver, or nil if no archiver is configured.
+func (in *PersistenceSpec) GetArchiver() *SnapshotArchiverSpec {
+	if in == nil {
+		return nil
+	}
+	return in.Archiver
+}
+
+// GetSnapshotSchedule returns the snapshot schedule, or nil if no schedule is configured.
+func (in *PersistenceSpec) GetSnapshotSchedule() *SnapshotScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	return in.SnapshotSchedule
 }
 
 // GetMode returns the persistence mode to be used.
@@ -995,6 +1061,236 @@ func (in *PersistenceSpec) AddVolumeMounts(c *corev1.Container) {
 	}
 }
 
+// ----- SnapshotScheduleSpec struct ----------------------------------------
+
+// SnapshotScheduleSpec configures scheduled snapshots of persistent Coherence services.
+// Each scheduled snapshot is created as a CoherenceSnapshot resource owned by the
+// Coherence resource.
+// +k8s:openapi-gen=true
+type SnapshotScheduleSpec struct {
+	// Schedule is the schedule in Cron format, for example "0 2 * * *" to take
+	// a snapshot at 02:00 every day.
+	// See https://en.wikipedia.org/wiki/Cron.
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+	// TimeZone is the name of the time zone used to evaluate the schedule,
+	// for example "Europe/London". If not set the time zone of the Operator is used.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+	// Services is the list of names of the persistent Coherence services to snapshot.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Services []string `json:"services"`
+	// Archive, when true, archives each snapshot using the archiver configured for each
+	// service after the snapshot has been created.
+	// +optional
+	Archive *bool `json:"archive,omitempty"`
+	// Timeout is the maximum amount of time allowed for each snapshot to complete.
+	// The default is 30 minutes.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// RetentionCount is the number of successful snapshots to keep.
+	// Older snapshots are removed from the cluster.
+	// If neither RetentionCount nor RetentionAge is set, the default is to keep seven snapshots.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RetentionCount *int32 `json:"retentionCount,omitempty"`
+	// RetentionAge is the maximum age of a successful snapshot to keep.
+	// Older snapshots are removed from the cluster, although the most recent
+	// successful snapshot is always kept.
+	// +optional
+	RetentionAge *metav1.Duration `json:"retentionAge,omitempty"`
+}
+
+// ParseSchedule parses the cron schedule and time zone, returning the schedule
+// and the location to use to evaluate it.
+func (in *SnapshotScheduleSpec) ParseSchedule() (cron.Schedule, *time.Location, error) {
+	if in == nil {
+		return nil, nil, fmt.Errorf("no snapshot schedule is configured")
+	}
+	sched, err := cron.ParseStandard(in.Schedule)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid snapshot schedule %q", in.Schedule)
+	}
+	loc := time.Local
+	if in.TimeZone != nil && *in.TimeZone != "" {
+		if loc, err = time.LoadLocation(*in.TimeZone); err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid snapshot schedule time zone %q", *in.TimeZone)
+		}
+	}
+	return sched, loc, nil
+}
+
+// GetRetentionCount returns the number of successful snapshots to keep, or zero
+// if the number of snapshots is not limited.
+func (in *SnapshotScheduleSpec) GetRetentionCount() int {
+	switch {
+	case in == nil:
+		return 0
+	case in.RetentionCount != nil:
+		return int(*in.RetentionCount)
+	case in.RetentionAge != nil:
+		return 0
+	default:
+		return DefaultSnapshotRetentionCount
+	}
+}
+
+// GetRetentionAge returns the maximum age of a successful snapshot to keep, or zero
+// if the age of snapshots is not limited.
+func (in *SnapshotScheduleSpec) GetRetentionAge() time.Duration {
+	if in == nil || in.RetentionAge == nil || in.RetentionAge.Duration < 0 {
+		return 0
+	}
+	return in.RetentionAge.Duration
+}
+
+// ----- MaintenanceWindowSpec struct ---------------------------------------
+
+// MaintenanceWindowSpec is a recurring window of time in which disruptive operations,
+// such as Operator managed rolling upgrades and scaling down, are allowed to run.
+// +k8s:openapi-gen=true
+type MaintenanceWindowSpec struct {
+	// Schedule is the start of the window in Cron format, for example "0 22 * * 1-4"
+	// to open the window at 22:00 from Monday to Thursday.
+	// See https://en.wikipedia.org/wiki/Cron.
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+	// Duration is how long the window stays open each time it opens, for example "4h".
+	Duration metav1.Duration `json:"duration"`
+	// TimeZone is the name of the time zone used to evaluate the schedule,
+	// for example "Europe/London". If not set the time zone of the Operator is used.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// ParseSchedule parses the cron schedule and time zone, returning the schedule
+// and the location to use to evaluate it.
+func (in *MaintenanceWindowSpec) ParseSchedule() (cron.Schedule, *time.Location, error) {
+	if in == nil {
+		return nil, nil, fmt.Errorf("no maintenance window is configured")
+	}
+	sched, err := cron.ParseStandard(in.Schedule)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid maintenance window schedule %q", in.Schedule)
+	}
+	loc := time.Local
+	if in.TimeZone != nil && *in.TimeZone != "" {
+		if loc, err = time.LoadLocation(*in.TimeZone); err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid maintenance window time zone %q", *in.TimeZone)
+		}
+	}
+	return sched, loc, nil
+}
+
+// NextOpen returns the zero time if the window is open at the specified time,
+// otherwise the time that the window next opens.
+func (in *MaintenanceWindowSpec) NextOpen(now time.Time) (time.Time, error) {
+	sched, loc, err := in.ParseSchedule()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if in.Duration.Duration <= 0 {
+		return time.Time{}, fmt.Errorf("invalid maintenance window duration %s", in.Duration.Duration)
+	}
+	// the window is open if it opened after now minus the duration, and not after now
+	opened := sched.Next(now.Add(-in.Duration.Duration).In(loc))
+	if !opened.After(now) {
+		return time.Time{}, nil
+	}
+	return opened, nil
+}
+
+// ----- SnapshotArchiverSpec struct ----------------------------------------
+
+// SnapshotArchiverSpec configures an S3 compatible object store used to archive
+// Coherence persistence snapshots.
+// Archived snapshots are stored in the bucket under the key prefix
+// "<prefix>/<cluster>/<service>/<snapshot>/".
+// +k8s:openapi-gen=true
+type SnapshotArchiverSpec struct {
+	// Endpoint is the URL of the S3 compatible object store,
+	// for example "https://s3.us-east-1.amazonaws.com" or "http://minio.storage.svc:9000".
+	// +kubebuilder:validation:MinLength=1
+	Endpoint string `json:"endpoint"`
+	// Bucket is the name of the bucket to archive snapshots to.
+	// The bucket must already exist.
+	// +kubebuilder:validation:MinLength=1
+	Bucket string `json:"bucket"`
+	// Prefix is an optional key prefix for archived snapshots in the bucket.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+	// Region is the region used to sign requests to the object store.
+	// If not set the default is "us-east-1".
+	// +optional
+	Region *string `json:"region,omitempty"`
+	// PathStyle, when true, uses path style requests to access the bucket, where the bucket
+	// name is part of the request path instead of the host name.
+	// The default is true, which is required by most S3 compatible stores such as MinIO.
+	// +optional
+	PathStyle *bool `json:"pathStyle,omitempty"`
+	// CredentialsSecret is the name of the Secret, in the same namespace as the
+	// Coherence resource, containing the access key and secret key used to access the bucket.
+	// +kubebuilder:validation:MinLength=1
+	CredentialsSecret string `json:"credentialsSecret"`
+	// AccessKey is the key in the credentials Secret containing the access key.
+	// If not set the default is "accessKey".
+	// +optional
+	AccessKey *string `json:"accessKey,omitempty"`
+	// SecretKey is the key in the credentials Secret containing the secret key.
+	// If not set the default is "secretKey".
+	// +optional
+	SecretKey *string `json:"secretKey,omitempty"`
+}
+
+// CreateEnvVars creates the environment variables used by the runner to configure the snapshot archiver.
+// The credentials are added as environment variables from the credentials Secret so that they
+// never appear on the Coherence command line.
+func (in *SnapshotArchiverSpec) CreateEnvVars() []corev1.EnvVar {
+	if in == nil {
+		return nil
+	}
+
+	envVars := []corev1.EnvVar{
+		{Name: EnvVarCohArchiverEndpoint, Value: in.Endpoint},
+		{Name: EnvVarCohArchiverBucket, Value: in.Bucket},
+		{Name: EnvVarCohArchiverPathStyle, Value: strconv.FormatBool(in.IsPathStyle())},
+	}
+	if in.Prefix != nil && *in.Prefix != "" {
+		envVars = append(envVars, corev1.EnvVar{Name: EnvVarCohArchiverPrefix, Value: *in.Prefix})
+	}
+	if in.Region != nil && *in.Region != "" {
+		envVars = append(envVars, corev1.EnvVar{Name: EnvVarCohArchiverRegion, Value: *in.Region})
+	}
+
+	envVars = append(envVars,
+		in.secretEnvVar(EnvVarCohArchiverAccessKey, in.AccessKey, DefaultArchiverAccessKey),
+		in.secretEnvVar(EnvVarCohArchiverSecretKey, in.SecretKey, DefaultArchiverSecretKey))
+
+	return envVars
+}
+
+// IsPathStyle returns true if path style requests are used to access the bucket.
+func (in *SnapshotArchiverSpec) IsPathStyle() bool {
+	return in == nil || in.PathStyle == nil || *in.PathStyle
+}
+
+func (in *SnapshotArchiverSpec) secretEnvVar(name string, key *string, dflt string) corev1.EnvVar {
+	k := dflt
+	if key != nil && *key != "" {
+		k = *key
+	}
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: in.CredentialsSecret},
+				Key:                  k,
+			},
+		},
+	}
+}
+
 // ----- PersistentStorageSpec struct ---------------------------------------
 
 // PersistentStorageSpec defines the persistence settings for the Coherence
@@ -1121,6 +1417,23 @@ type SSLSpec struct {
 	//   If not set the default is false
 	// +optional
 	RequireClientCert *bool `json:"requireClientCert,omitempty"`
+	// CertManager configures the Operator to create a cert-manager Certificate for the component,
+	//   instead of using a pre-built Secret. The Secret created by cert-manager is mounted and
+	//   the key store and trust store settings default to the key stores cert-manager creates
+	//   in that Secret. CertManager cannot be used with Secrets.
+	// +optional
+	CertManager *SSLCertManagerSpec `json:"certManager,omitempty"`
+	// RestartOnChange controls whether the Pods are restarted, using the normal rolling upgrade,
+	//   when the content of the Secret named by the Secrets field changes.
+	//   If not set the default is true.
+	// +optional
+	RestartOnChange *bool `json:"restartOnChange,omitempty"`
+}
+
+// IsRestartOnChange returns true if the Pods should be restarted when the content
+// of the Secret named by the Secrets field changes.
+func (in *SSLSpec) IsRestartOnChange() bool {
+	return in != nil && (in.RestartOnChange == nil || *in.RestartOnChange)
 }
 
 // CreateEnvVars creates the SSL environment variables
@@ -1131,11 +1444,15 @@ func (in *SSLSpec) CreateEnvVars(prefix, secretMount string) []corev1.EnvVar {
 		return envVars
 	}
 
+	if in.CertManager != nil {
+		in = in.withCertManagerDefaults()
+	}
+
 	if in.Enabled != nil && *in.Enabled {
 		envVars = append(envVars, corev1.EnvVar{Name: prefix + EnvVarSuffixSSLEnabled, Value: "true"})
 	}
 
-	if in.Secrets != nil && *in.Secrets != "" {
+	if (in.Secrets != nil && *in.Secrets != "") || in.CertManager != nil {
 		envVars = append(envVars, corev1.EnvVar{Name: prefix + EnvVarSuffixSSLCerts, Value: secretMount})
 	}
 
@@ -1190,6 +1507,165 @@ func (in *SSLSpec) CreateEnvVars(prefix, secretMount string) []corev1.EnvVar {
 	return envVars
 }
 
+// withCertManagerDefaults returns a copy of this SSLSpec with any unset key store and
+// trust store fields defaulted to the files in the cert-manager Certificate Secret.
+func (in *SSLSpec) withCertManagerDefaults() *SSLSpec {
+	ssl := in.DeepCopy()
+	cm := ssl.CertManager
+	storeType := cm.GetKeyStoreType()
+	setDefault := func(p **string, value string) {
+		if *p == nil || **p == "" {
+			*p = ptr.To(value)
+		}
+	}
+
+	setDefault(&ssl.KeyStore, cm.GetKeyStoreFile())
+	setDefault(&ssl.KeyStorePasswordFile, CertificatePasswordFile)
+	setDefault(&ssl.KeyPasswordFile, CertificatePasswordFile)
+	setDefault(&ssl.KeyStoreType, storeType)
+	if cm.IsTrustStoreEnabled() {
+		setDefault(&ssl.TrustStore, cm.GetTrustStoreFile())
+		setDefault(&ssl.TrustStorePasswordFile, CertificatePasswordFile)
+		setDefault(&ssl.TrustStoreType, storeType)
+	}
+	return ssl
+}
+
+// ----- SSLCertManagerSpec struct ----------------------------------------------
+
+// SSLCertManagerSpec configures a cert-manager Certificate used to create the SSL key stores
+// for a Coherence component.
+// +k8s:openapi-gen=true
+type SSLCertManagerSpec struct {
+	// IssuerRef is a reference to the cert-manager Issuer or ClusterIssuer used to issue the certificate.
+	IssuerRef cmmeta.IssuerReference `json:"issuerRef"`
+	// PasswordSecretRef is a reference to a key in a Secret containing the password used to
+	// encrypt the key stores. The Secret must be in the same namespace as the Coherence resource.
+	PasswordSecretRef cmmeta.SecretKeySelector `json:"passwordSecretRef"`
+	// SecretName is the name of the Secret cert-manager will create containing the certificate
+	// and key stores. If not set the name is the Coherence resource name followed by the
+	// component name and "-tls", for example "storage-management-tls".
+	// +optional
+	SecretName *string `json:"secretName,omitempty"`
+	// KeyStoreType is the type of key store cert-manager creates, either PKCS12 or JKS.
+	// The default is PKCS12.
+	// +kubebuilder:validation:Enum:=PKCS12;JKS
+	// +optional
+	KeyStoreType *string `json:"keyStoreType,omitempty"`
+	// TrustStore controls whether the trust store cert-manager creates from the issuer's CA
+	// certificate is used. This should be set to false if the Issuer does not provide a CA certificate.
+	// The default is true.
+	// +optional
+	TrustStore *bool `json:"trustStore,omitempty"`
+	// DNSNames is the list of DNS subject alternative names for the certificate.
+	// If not set, the names of the component's Service and the names of the Pods
+	// in the headless StatefulSet Service are used.
+	// +listType=atomic
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+	// Duration is the requested duration of the certificate.
+	// If not set, the cert-manager default is used.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// RenewBefore is how long before the certificate expires cert-manager should renew it.
+	// If not set, the cert-manager default is used.
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// GetSecretName returns the name of the Secret created by cert-manager.
+func (in *SSLCertManagerSpec) GetSecretName(deployment CoherenceResource, component string) string {
+	if in != nil && in.SecretName != nil && *in.SecretName != "" {
+		return *in.SecretName
+	}
+	return deployment.GetName() + "-" + component + CertificateSecretSuffix
+}
+
+// GetKeyStoreType returns the type of key store cert-manager creates.
+func (in *SSLCertManagerSpec) GetKeyStoreType() string {
+	if in != nil && in.KeyStoreType != nil && strings.EqualFold(*in.KeyStoreType, KeyStoreTypeJKS) {
+		return KeyStoreTypeJKS
+	}
+	return KeyStoreTypePKCS12
+}
+
+// GetKeyStoreFile returns the name of the key store file cert-manager creates in the Secret.
+func (in *SSLCertManagerSpec) GetKeyStoreFile() string {
+	if in.GetKeyStoreType() == KeyStoreTypeJKS {
+		return certmanagerv1.JKSSecretKey
+	}
+	return certmanagerv1.PKCS12SecretKey
+}
+
+// GetTrustStoreFile returns the name of the trust store file cert-manager creates in the Secret.
+func (in *SSLCertManagerSpec) GetTrustStoreFile() string {
+	if in.GetKeyStoreType() == KeyStoreTypeJKS {
+		return certmanagerv1.JKSTruststoreKey
+	}
+	return certmanagerv1.PKCS12TruststoreKey
+}
+
+// IsTrustStoreEnabled returns true if the trust store created by cert-manager should be used.
+func (in *SSLCertManagerSpec) IsTrustStoreEnabled() bool {
+	return in != nil && (in.TrustStore == nil || *in.TrustStore)
+}
+
+// GetDNSNames returns the DNS subject alternative names for the certificate.
+func (in *SSLCertManagerSpec) GetDNSNames(deployment CoherenceResource, component string) []string {
+	if in != nil && len(in.DNSNames) > 0 {
+		return in.DNSNames
+	}
+	ns := deployment.GetNamespace()
+	svc := deployment.GetName() + "-" + component
+	headless := deployment.GetHeadlessServiceName()
+	return []string{
+		svc,
+		svc + "." + ns,
+		svc + "." + ns + ".svc",
+		"*." + headless + "." + ns + ".svc",
+	}
+}
+
+// CreateCertificate creates the cert-manager Certificate for a Coherence component.
+func (in *SSLCertManagerSpec) CreateCertificate(deployment CoherenceResource, component string) *certmanagerv1.Certificate {
+	if in == nil {
+		return nil
+	}
+
+	name := in.GetSecretName(deployment, component)
+	labels := deployment.CreateGlobalLabels()
+	labels[LabelComponent] = LabelComponentCertificate
+	labels[LabelPort] = component
+
+	keystores := &certmanagerv1.CertificateKeystores{}
+	if in.GetKeyStoreType() == KeyStoreTypeJKS {
+		keystores.JKS = &certmanagerv1.JKSKeystore{Create: true, PasswordSecretRef: in.PasswordSecretRef}
+	} else {
+		keystores.PKCS12 = &certmanagerv1.PKCS12Keystore{
+			Create:            true,
+			Profile:           certmanagerv1.Modern2023PKCS12Profile,
+			PasswordSecretRef: in.PasswordSecretRef,
+		}
+	}
+
+	return &certmanagerv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   deployment.GetNamespace(),
+			Name:        name,
+			Labels:      labels,
+			Annotations: deployment.CreateGlobalAnnotations(),
+		},
+		Spec: certmanagerv1.CertificateSpec{
+			SecretName:  name,
+			IssuerRef:   in.IssuerRef,
+			DNSNames:    in.GetDNSNames(deployment, component),
+			Duration:    in.Duration,
+			RenewBefore: in.RenewBefore,
+			Keystores:   keystores,
+		},
+	}
+}
+
 // ----- NamedPortSpec struct ----------------------------------------------------
 
 // NamedPortSpec defines a named port for a Coherence component
@@ -1246,6 +1722,13 @@ type NamedPortSpec struct {
 	// headless service.
 	// +optional
 	ExposeOnSTS *bool `json:"exposeOnSts,omitempty"`
+	// Ingress configures an Ingress to expose the port's Service outside the Kubernetes cluster.
+	// +optional
+	Ingress *PortIngressSpec `json:"ingress,omitempty"`
+	// HTTPRoute configures a Gateway API HTTPRoute to expose the port's Service outside the Kubernetes cluster.
+	// The Gateway API CRDs must be installed for the HTTPRoute to be created.
+	// +optional
+	HTTPRoute *PortHTTPRouteSpec `json:"httpRoute,omitempty"`
 }
 
 // GetServiceName returns the name of the Service used to expose this port, or returns
@@ -1472,6 +1955,260 @@ func (in *NamedPortSpec) CreatePort(d CoherenceResource) corev1.ContainerPort {
 	}
 }
 
+// CreateIngress creates the Ingress to expose this port's Service, or returns nil
+// if no Ingress is required.
+func (in *NamedPortSpec) CreateIngress(deployment CoherenceResource) *networkingv1.Ingress {
+	if in == nil || !in.Ingress.IsEnabled() {
+		return nil
+	}
+	svcName, found := in.GetServiceName(deployment)
+	if !found {
+		return nil
+	}
+
+	spec := in.Ingress
+	labels := deployment.CreateGlobalLabels()
+	labels[LabelComponent] = LabelComponentPortIngress
+	labels[LabelPort] = in.Name
+	for k, v := range spec.Labels {
+		labels[k] = v
+	}
+
+	backend := networkingv1.IngressBackend{
+		Service: &networkingv1.IngressServiceBackend{
+			Name: svcName,
+			Port: networkingv1.ServiceBackendPort{Number: in.GetServicePort(deployment)},
+		},
+	}
+	value := networkingv1.IngressRuleValue{
+		HTTP: &networkingv1.HTTPIngressRuleValue{
+			Paths: []networkingv1.HTTPIngressPath{
+				{Path: spec.GetPath(), PathType: ptr.To(spec.GetPathType()), Backend: backend},
+			},
+		},
+	}
+
+	var rules []networkingv1.IngressRule
+	if len(spec.Hosts) == 0 {
+		rules = append(rules, networkingv1.IngressRule{IngressRuleValue: value})
+	}
+	for _, host := range spec.Hosts {
+		rules = append(rules, networkingv1.IngressRule{Host: host, IngressRuleValue: *value.DeepCopy()})
+	}
+
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   deployment.GetNamespace(),
+			Name:        spec.GetName(svcName),
+			Labels:      labels,
+			Annotations: mergeAnnotations(deployment.CreateGlobalAnnotations(), spec.Annotations),
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: spec.IngressClassName,
+			TLS:              spec.TLS,
+			Rules:            rules,
+		},
+	}
+}
+
+// CreateHTTPRoute creates the Gateway API HTTPRoute to expose this port's Service, or returns nil
+// if no HTTPRoute is required.
+func (in *NamedPortSpec) CreateHTTPRoute(deployment CoherenceResource) *gatewayv1.HTTPRoute {
+	if in == nil || !in.HTTPRoute.IsEnabled() {
+		return nil
+	}
+	svcName, found := in.GetServiceName(deployment)
+	if !found {
+		return nil
+	}
+
+	spec := in.HTTPRoute
+	labels := deployment.CreateGlobalLabels()
+	labels[LabelComponent] = LabelComponentPortHTTPRoute
+	labels[LabelPort] = in.Name
+	for k, v := range spec.Labels {
+		labels[k] = v
+	}
+
+	backend := gatewayv1.HTTPBackendRef{
+		BackendRef: gatewayv1.BackendRef{
+			BackendObjectReference: gatewayv1.BackendObjectReference{
+				Name: gatewayv1.ObjectName(svcName),
+				Port: ptr.To(in.GetServicePort(deployment)),
+			},
+		},
+	}
+
+	return &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   deployment.GetNamespace(),
+			Name:        spec.GetName(svcName),
+			Labels:      labels,
+			Annotations: mergeAnnotations(deployment.CreateGlobalAnnotations(), spec.Annotations),
+		},
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{ParentRefs: spec.ParentRefs},
+			Hostnames:       spec.Hostnames,
+			Rules: []gatewayv1.HTTPRouteRule{
+				{
+					Matches: []gatewayv1.HTTPRouteMatch{
+						{Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(spec.GetPathType()), Value: ptr.To(spec.GetPath())}},
+					},
+					BackendRefs: []gatewayv1.HTTPBackendRef{backend},
+				},
+			},
+		},
+	}
+}
+
+// mergeAnnotations adds the additional annotations to the annotations map, creating it if required.
+func mergeAnnotations(annotations, additional map[string]string) map[string]string {
+	if len(additional) == 0 {
+		return annotations
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	for k, v := range additional {
+		annotations[k] = v
+	}
+	return annotations
+}
+
+// ----- PortIngressSpec struct ---------------------------------------------
+
+// PortIngressSpec configures the Ingress used to expose a port's Service.
+// +k8s:openapi-gen=true
+type PortIngressSpec struct {
+	// Enabled controls whether the Ingress is created. The default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// The name of the Ingress. If not set, the name of the port's Service is used.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// Additional labels to add to the Ingress.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Additional annotations to add to the Ingress, for example annotations used to configure
+	// the Ingress controller.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// IngressClassName is the name of the IngressClass to use.
+	// If not set, the cluster's default IngressClass is used.
+	// +optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+	// Hosts is the list of host names routed to the port's Service.
+	// If not set, all hosts are routed to the Service.
+	// +listType=atomic
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
+	// The path routed to the port's Service. The default is "/".
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// The type of path matching to use. The default is "Prefix".
+	// +optional
+	PathType *networkingv1.PathType `json:"pathType,omitempty"`
+	// TLS configures the hosts and the Secrets containing the TLS certificates
+	// used by the Ingress to terminate TLS.
+	// +listType=atomic
+	// +optional
+	TLS []networkingv1.IngressTLS `json:"tls,omitempty"`
+}
+
+// IsEnabled returns true if the Ingress should be created.
+func (in *PortIngressSpec) IsEnabled() bool {
+	return in != nil && (in.Enabled == nil || *in.Enabled)
+}
+
+// GetName returns the name of the Ingress, which defaults to the Service name.
+func (in *PortIngressSpec) GetName(svcName string) string {
+	if in == nil || in.Name == nil || *in.Name == "" {
+		return svcName
+	}
+	return *in.Name
+}
+
+// GetPath returns the path routed to the Service.
+func (in *PortIngressSpec) GetPath() string {
+	if in == nil || in.Path == nil || *in.Path == "" {
+		return "/"
+	}
+	return *in.Path
+}
+
+// GetPathType returns the type of path matching.
+func (in *PortIngressSpec) GetPathType() networkingv1.PathType {
+	if in == nil || in.PathType == nil {
+		return networkingv1.PathTypePrefix
+	}
+	return *in.PathType
+}
+
+// ----- PortHTTPRouteSpec struct -------------------------------------------
+
+// PortHTTPRouteSpec configures the Gateway API HTTPRoute used to expose a port's Service.
+// TLS is terminated by the Gateway listener the HTTPRoute is attached to, which references
+// the Secret containing the TLS certificates. Use the "sectionName" of a parent reference to
+// attach the HTTPRoute to a specific HTTPS listener.
+// +k8s:openapi-gen=true
+type PortHTTPRouteSpec struct {
+	// Enabled controls whether the HTTPRoute is created. The default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// The name of the HTTPRoute. If not set, the name of the port's Service is used.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// Additional labels to add to the HTTPRoute.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Additional annotations to add to the HTTPRoute.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// ParentRefs references the Gateways, or the listeners of Gateways, the HTTPRoute attaches to.
+	// +listType=atomic
+	ParentRefs []gatewayv1.ParentReference `json:"parentRefs"`
+	// Hostnames is the list of host names routed to the port's Service.
+	// If not set, the host names of the Gateway listener are used.
+	// +listType=atomic
+	// +optional
+	Hostnames []gatewayv1.Hostname `json:"hostnames,omitempty"`
+	// The path routed to the port's Service. The default is "/".
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// The type of path matching to use. The default is "PathPrefix".
+	// +optional
+	PathType *gatewayv1.PathMatchType `json:"pathType,omitempty"`
+}
+
+// IsEnabled returns true if the HTTPRoute should be created.
+func (in *PortHTTPRouteSpec) IsEnabled() bool {
+	return in != nil && (in.Enabled == nil || *in.Enabled)
+}
+
+// GetName returns the name of the HTTPRoute, which defaults to the Service name.
+func (in *PortHTTPRouteSpec) GetName(svcName string) string {
+	if in == nil || in.Name == nil || *in.Name == "" {
+		return svcName
+	}
+	return *in.Name
+}
+
+// GetPath returns the path routed to the Service.
+func (in *PortHTTPRouteSpec) GetPath() string {
+	if in == nil || in.Path == nil || *in.Path == "" {
+		return "/"
+	}
+	return *in.Path
+}
+
+// GetPathType returns the type of path matching.
+func (in *PortHTTPRouteSpec) GetPathType() gatewayv1.PathMatchType {
+	if in == nil || in.PathType == nil {
+		return gatewayv1.PathMatchPathPrefix
+	}
+	return *in.PathType
+}
+
 // ----- ServiceMonitorSpec struct ---------------------------------------------
 
 // ServiceMonitorSpec the ServiceMonitor spec for a port service.
@@ -2002,6 +2739,18 @@ func (in *PortSpecWithSSL) AddSSLVolumes(sts *appsv1.StatefulSet, c *corev1.Cont
 	}
 }
 
+// GetRestartOnChangeSecret returns the name of the SSL Secret mounted into the Pods, if
+// the Pods should be restarted when the content of that Secret changes.
+func (in *PortSpecWithSSL) GetRestartOnChangeSecret() (string, bool) {
+	if in == nil || !notNilBool(in.Enabled) || !in.IsSSLEnabled() {
+		return "", false
+	}
+	if in.SSL.Secrets == nil || *in.SSL.Secrets == "" || !in.SSL.IsRestartOnChange() {
+		return "", false
+	}
+	return *in.SSL.Secrets, true
+}
+
 // AddSSLVolumesForPod adds the SSL secret volume and volume mount if required
 func (in *PortSpecWithSSL) AddSSLVolumesForPod(podTemplate *corev1.PodTemplateSpec, c *corev1.Container, volName, path string) {
 	if in == nil || !notNilBool(in.Enabled) || in.SSL == nil || !notNilBool(in.SSL.Enabled) {
@@ -2016,17 +2765,70 @@ func (in *PortSpecWithSSL) AddSSLVolumesForPod(podTemplate *corev1.PodTemplateSp
 			MountPath: path,
 		})
 
-		podTemplate.Spec.Volumes = append(podTemplate.Spec.Volumes, corev1.Volume{
-			Name: volName,
-			VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{
-					SecretName:  *in.SSL.Secrets,
-					DefaultMode: ptr.To(int32(0777)),
+		podTemplate.Spec.Volumes = append(podTemplate.Spec.Volumes, corev1.Volume{
+			Name: volName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  *in.SSL.Secrets,
+					DefaultMode: ptr.To(int32(0777)),
+				},
+			},
+		})
+	}
+
+}
+
+// GetCertManager returns the cert-manager configuration if this port is enabled and uses
+// SSL with a cert-manager Certificate, otherwise returns nil.
+func (in *PortSpecWithSSL) GetCertManager() *SSLCertManagerSpec {
+	if in == nil || !notNilBool(in.Enabled) || !in.IsSSLEnabled() {
+		return nil
+	}
+	return in.SSL.CertManager
+}
+
+// CreateCertificate creates the cert-manager Certificate for this port if required.
+func (in *PortSpecWithSSL) CreateCertificate(deployment CoherenceResource, component string) *certmanagerv1.Certificate {
+	return in.GetCertManager().CreateCertificate(deployment, component)
+}
+
+// AddCertManagerVolumesForPod adds the volume and volume mount for the Secret created by cert-manager
+// if required. The key store password is projected into the same volume as the key stores.
+func (in *PortSpecWithSSL) AddCertManagerVolumesForPod(deployment CoherenceResource, podTemplate *corev1.PodTemplateSpec, c *corev1.Container, component, volName, path string) {
+	cm := in.GetCertManager()
+	if cm == nil {
+		return
+	}
+
+	c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+		Name:      volName,
+		ReadOnly:  true,
+		MountPath: path,
+	})
+
+	podTemplate.Spec.Volumes = append(podTemplate.Spec.Volumes, corev1.Volume{
+		Name: volName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				DefaultMode: ptr.To(int32(0777)),
+				Sources: []corev1.VolumeProjection{
+					{
+						Secret: &corev1.SecretProjection{
+							LocalObjectReference: corev1.LocalObjectReference{Name: cm.GetSecretName(deployment, component)},
+						},
+					},
+					{
+						Secret: &corev1.SecretProjection{
+							LocalObjectReference: corev1.LocalObjectReference{Name: cm.PasswordSecretRef.Name},
+							Items: []corev1.KeyToPath{
+								{Key: cm.PasswordSecretRef.Key, Path: CertificatePasswordFile},
+							},
+						},
+					},
 				},
 			},
-		})
-	}
-
+		},
+	})
 }
 
 // ----- ServiceSpec struct -------------------------------------------------
@@ -2311,6 +3113,507 @@ type ScalingSpec struct {
 	// a different handler may be specified.
 	// +optional
 	Probe *Probe `json:"probe,omitempty"`
+	// Autoscale configures the Operator to scale the deployment based on
+	// metrics obtained from Coherence management over REST.
+	// When autoscaling is enabled the Operator sets the replicas it has scaled the deployment to
+	// in the autoscale status, which then take precedence over the replicas field of the Coherence
+	// resource, and the deployment is scaled using the scaling policy. The replicas field is not
+	// changed, setting it to zero still stops the deployment.
+	// +optional
+	Autoscale *AutoscaleSpec `json:"autoscale,omitempty"`
+	// RequiredHALevel is the HA status level that every partitioned service with backups must be at,
+	// or above, for the deployment to be StatusHA. If set, the StatusHA check used for safe scaling, updates
+	// and Operator managed rolling upgrades using the Node or NodeLabel strategies also checks the HA status
+	// of the services using Coherence management over REST, which must be enabled. The SafeBatch scaling
+	// policy only removes a batch of members while every service is at or above this level.
+	// If present, the value must be one of "NODE_SAFE", "MACHINE_SAFE", "RACK_SAFE" or "SITE_SAFE".
+	// If not set, the StatusHA check does not check the HA status level, and the SafeBatch scaling policy
+	// requires "NODE_SAFE".
+	// +kubebuilder:validation:Enum=NODE_SAFE;MACHINE_SAFE;RACK_SAFE;SITE_SAFE
+	// +optional
+	RequiredHALevel *HALevel `json:"requiredHALevel,omitempty"`
+	// MaxBatchSize is the maximum number of members the SafeBatch scaling policy removes in a single batch.
+	// If not set, the batch size is only limited by the number of members that can be removed while
+	// keeping every partitioned service at the required HA level.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	MaxBatchSize *int32 `json:"maxBatchSize,omitempty"`
+}
+
+// GetAutoscale returns the autoscale configuration, or nil if autoscaling is not configured.
+func (in *ScalingSpec) GetAutoscale() *AutoscaleSpec {
+	if in == nil {
+		return nil
+	}
+	return in.Autoscale
+}
+
+// IsHALevelRequired returns true if a required HA status level is configured.
+func (in *ScalingSpec) IsHALevelRequired() bool {
+	return in != nil && in.RequiredHALevel != nil
+}
+
+// GetRequiredHALevel returns the required HA status level, or NODE_SAFE if no level is configured.
+func (in *ScalingSpec) GetRequiredHALevel() HALevel {
+	if in == nil || in.RequiredHALevel == nil {
+		return HALevelNodeSafe
+	}
+	return *in.RequiredHALevel
+}
+
+// GetMaxBatchSize returns the maximum number of members to remove in a single batch, or zero if there is no maximum.
+func (in *ScalingSpec) GetMaxBatchSize() int32 {
+	if in == nil || in.MaxBatchSize == nil {
+		return 0
+	}
+	return *in.MaxBatchSize
+}
+
+// ----- AutoscaleSpec ---------------------------------------------------
+
+// AutoscaleSpec configures metric driven scaling of a Coherence deployment.
+// The Operator periodically calculates the number of replicas required for each
+// configured target and recommends the largest value, limited to the minimum and
+// maximum replicas. Coherence management over REST must be enabled to use autoscaling.
+// +k8s:openapi-gen=true
+type AutoscaleSpec struct {
+	// Enabled controls whether the Operator scales the deployment.
+	// If set to false the Operator still calculates and reports the recommended
+	// replicas in the status but does not scale the deployment.
+	// The default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// MinReplicas is the minimum number of replicas.
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas int32 `json:"minReplicas"`
+	// MaxReplicas is the maximum number of replicas.
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// HeapUtilization is the target average heap utilization, as a percentage
+	// of the maximum heap, of the members of the deployment.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	HeapUtilization *int32 `json:"heapUtilization,omitempty"`
+	// CacheEntriesPerMember is the target number of cache entries per member of the deployment.
+	// The total number of entries is the sum of the sizes of the caches in the Caches field,
+	// or of all caches in the cluster if Caches is not set.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	CacheEntriesPerMember *int64 `json:"cacheEntriesPerMember,omitempty"`
+	// Caches is an optional list of cache names used to calculate the number of cache entries.
+	// +listType=set
+	// +optional
+	Caches []string `json:"caches,omitempty"`
+	// Tolerance is the percentage that a metric can differ from its target before
+	// the deployment is scaled. The default is 10.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Tolerance *int32 `json:"tolerance,omitempty"`
+	// Interval is how often the metrics are evaluated. The default is one minute.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// ScaleUpCooldown is the minimum time after the deployment was last scaled before it
+	// can be scaled up again. The default is three minutes.
+	// +optional
+	ScaleUpCooldown *metav1.Duration `json:"scaleUpCooldown,omitempty"`
+	// ScaleDownCooldown is the minimum time after the deployment was last scaled before it
+	// can be scaled down again. The default is ten minutes.
+	// +optional
+	ScaleDownCooldown *metav1.Duration `json:"scaleDownCooldown,omitempty"`
+}
+
+// IsEnabled returns true if the Operator should scale the deployment.
+func (in *AutoscaleSpec) IsEnabled() bool {
+	return in != nil && (in.Enabled == nil || *in.Enabled)
+}
+
+// GetTolerance returns the tolerance as a fraction.
+func (in *AutoscaleSpec) GetTolerance() float64 {
+	if in == nil || in.Tolerance == nil {
+		return float64(DefaultAutoscaleTolerance) / 100
+	}
+	return float64(*in.Tolerance) / 100
+}
+
+// GetInterval returns how often the metrics are evaluated.
+func (in *AutoscaleSpec) GetInterval() time.Duration {
+	if in == nil {
+		return DefaultAutoscaleInterval
+	}
+	return durationOrDefault(in.Interval, DefaultAutoscaleInterval)
+}
+
+// GetScaleUpCooldown returns the minimum time between scaling and a subsequent scale up.
+func (in *AutoscaleSpec) GetScaleUpCooldown() time.Duration {
+	if in == nil {
+		return DefaultAutoscaleScaleUpCooldown
+	}
+	return durationOrDefault(in.ScaleUpCooldown, DefaultAutoscaleScaleUpCooldown)
+}
+
+// GetScaleDownCooldown returns the minimum time between scaling and a subsequent scale down.
+func (in *AutoscaleSpec) GetScaleDownCooldown() time.Duration {
+	if in == nil {
+		return DefaultAutoscaleScaleDownCooldown
+	}
+	return durationOrDefault(in.ScaleDownCooldown, DefaultAutoscaleScaleDownCooldown)
+}
+
+// ClampReplicas limits a replica count to the minimum and maximum replicas.
+func (in *AutoscaleSpec) ClampReplicas(replicas int32) int32 {
+	if in == nil {
+		return replicas
+	}
+	if replicas > in.MaxReplicas {
+		replicas = in.MaxReplicas
+	}
+	if replicas < in.MinReplicas {
+		replicas = in.MinReplicas
+	}
+	return replicas
+}
+
+// ----- AutoscaleStatus -------------------------------------------------
+
+// AutoscaleStatus is the status of metric driven scaling of a Coherence deployment.
+type AutoscaleStatus struct {
+	// RecommendedReplicas is the number of replicas recommended by the last evaluation of the metrics.
+	// +optional
+	RecommendedReplicas int32 `json:"recommendedReplicas,omitempty"`
+	// Replicas is the number of replicas the Operator has scaled the deployment to. While autoscaling
+	// is enabled this is the desired number of replicas of the StatefulSet, instead of the replicas
+	// field of the Coherence resource. It is cleared when autoscaling is disabled or the deployment is stopped.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// LastEvaluationTime is the time the metrics were last evaluated.
+	// +optional
+	LastEvaluationTime *metav1.Time `json:"lastEvaluationTime,omitempty"`
+	// LastScaleTime is the time the Operator last changed the replicas of the deployment.
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+	// Metrics are the values of the metrics at the last evaluation.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Metrics []AutoscaleMetricStatus `json:"metrics,omitempty"`
+	// Message is a human-readable message describing the last evaluation.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ----- MemberStatusSpec ------------------------------------------------
+
+// MemberStatusSpec configures the members and services lists in the Coherence resource status.
+type MemberStatusSpec struct {
+	// Enabled controls whether the Operator populates the members and services lists in the status.
+	// The default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Interval is how often the members and services lists are updated. The default is one minute
+	// and the minimum is ten seconds.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// IsEnabled returns true if the Operator should populate the members and services lists in the status.
+func (in *MemberStatusSpec) IsEnabled() bool {
+	return in == nil || in.Enabled == nil || *in.Enabled
+}
+
+// GetInterval returns how often the members and services lists are updated.
+func (in *MemberStatusSpec) GetInterval() time.Duration {
+	if in == nil {
+		return DefaultMemberStatusInterval
+	}
+	return max(durationOrDefault(in.Interval, DefaultMemberStatusInterval), MinMemberStatusInterval)
+}
+
+// ----- CoherenceMemberStatus -------------------------------------------
+
+// CoherenceMemberStatus is the status of a single Coherence cluster member.
+type CoherenceMemberStatus struct {
+	// Pod is the name of the Pod running the member.
+	Pod string `json:"pod"`
+	// MemberID is the Coherence member id.
+	// +optional
+	MemberID int32 `json:"memberId,omitempty"`
+	// Machine is the Coherence machine name of the member.
+	// +optional
+	Machine string `json:"machine,omitempty"`
+	// Rack is the Coherence rack name of the member.
+	// +optional
+	Rack string `json:"rack,omitempty"`
+	// Site is the Coherence site name of the member.
+	// +optional
+	Site string `json:"site,omitempty"`
+	// Role is the Coherence role name of the member.
+	// +optional
+	Role string `json:"role,omitempty"`
+	// LoggingLevel is the Coherence logging level of the member.
+	// +optional
+	LoggingLevel int32 `json:"loggingLevel,omitempty"`
+}
+
+// ----- CoherenceServiceStatus ------------------------------------------
+
+// CoherenceServiceStatus is the HA status and partition distribution of a partitioned Coherence service.
+type CoherenceServiceStatus struct {
+	// Name is the name of the service.
+	Name string `json:"name"`
+	// Type is the type of the service, for example DistributedCache.
+	// +optional
+	Type string `json:"type,omitempty"`
+	// HAStatus is the high availability status of the service, for example ENDANGERED, NODE-SAFE,
+	// MACHINE-SAFE, RACK-SAFE or SITE-SAFE.
+	// +optional
+	HAStatus string `json:"haStatus,omitempty"`
+	// HAStatusCode is the numeric high availability status of the service, where zero is ENDANGERED
+	// and four is SITE-SAFE.
+	// +optional
+	HAStatusCode int32 `json:"haStatusCode"`
+	// BackupCount is the configured number of backups of each partition.
+	// +optional
+	BackupCount int32 `json:"backupCount"`
+	// NodeCount is the number of members running the service.
+	// +optional
+	NodeCount int32 `json:"nodeCount"`
+	// RemainingDistributionCount is the number of partition transfers remaining to complete
+	// the current distribution of partitions.
+	// +optional
+	RemainingDistributionCount int32 `json:"remainingDistributionCount"`
+}
+
+// ----- HistoryEntry ----------------------------------------------------
+
+// HistoryOperation is the type of operation recorded in the history of a Coherence resource.
+type HistoryOperation string
+
+const (
+	// HistoryOperationScale is a change to the replicas of a deployment.
+	HistoryOperationScale HistoryOperation = "Scale"
+	// HistoryOperationUpgradeStep is a step of an Operator managed rolling upgrade.
+	HistoryOperationUpgradeStep HistoryOperation = "UpgradeStep"
+	// HistoryOperationSuspend is the suspension of the Coherence services of a deployment.
+	HistoryOperationSuspend HistoryOperation = "Suspend"
+	// HistoryOperationAction is the execution of an action after a deployment is ready.
+	HistoryOperationAction HistoryOperation = "Action"
+	// HistoryOperationRecovery is the handling of, and recovery from, a reconcile error.
+	HistoryOperationRecovery HistoryOperation = "Recovery"
+	// HistoryOperationRollback is the automatic rollback of a failed rolling upgrade.
+	HistoryOperationRollback HistoryOperation = "Rollback"
+)
+
+// HistoryResult is the result of an operation recorded in the history of a Coherence resource.
+type HistoryResult string
+
+const (
+	// HistoryResultSucceeded is the result of an operation that succeeded.
+	HistoryResultSucceeded HistoryResult = "Succeeded"
+	// HistoryResultFailed is the result of an operation that failed.
+	HistoryResultFailed HistoryResult = "Failed"
+	// HistoryResultDeferred is the result of an operation that was deferred and will be retried.
+	HistoryResultDeferred HistoryResult = "Deferred"
+	// HistoryResultSkipped is the result of an operation that was not required.
+	HistoryResultSkipped HistoryResult = "Skipped"
+)
+
+// MaxHistoryEntries is the maximum number of entries kept in the history of a Coherence resource.
+const MaxHistoryEntries = 25
+
+// HistoryEntry is a record of an operation performed by the Operator on a Coherence deployment.
+type HistoryEntry struct {
+	// Time is the time the operation was performed.
+	Time metav1.Time `json:"time"`
+	// Operation is the type of operation, one of Scale, UpgradeStep, Suspend, Action or Recovery.
+	Operation HistoryOperation `json:"operation"`
+	// Inputs are the inputs of the operation, for example the current and desired replicas of a scale.
+	// +optional
+	Inputs map[string]string `json:"inputs,omitempty"`
+	// Result is the result of the operation, one of Succeeded, Failed, Deferred or Skipped.
+	Result HistoryResult `json:"result"`
+	// Message is a human-readable message describing the operation.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// NewHistoryEntry creates a HistoryEntry for an operation performed now.
+func NewHistoryEntry(op HistoryOperation, result HistoryResult, msg string, inputs map[string]string) HistoryEntry {
+	return HistoryEntry{
+		Time:      metav1.Now(),
+		Operation: op,
+		Inputs:    inputs,
+		Result:    result,
+		Message:   msg,
+	}
+}
+
+// IsRepeatOf returns true if this entry records the same operation, inputs, result
+// and message as the specified entry, ignoring the time.
+func (in HistoryEntry) IsRepeatOf(other HistoryEntry) bool {
+	return in.Operation == other.Operation &&
+		in.Result == other.Result &&
+		in.Message == other.Message &&
+		maps.Equal(in.Inputs, other.Inputs)
+}
+
+// ----- ApprovalStatus --------------------------------------------------
+
+// ApprovalAction is the type of disruptive action that requires approval.
+type ApprovalAction string
+
+const (
+	// ApprovalActionDeletePods is the deletion of a batch of Pods by an Operator managed rolling upgrade.
+	ApprovalActionDeletePods ApprovalAction = "DeletePods"
+	// ApprovalActionScale is a step of safe scaling.
+	ApprovalActionScale ApprovalAction = "Scale"
+)
+
+// ApprovalDecision is the decision of an approval webhook.
+type ApprovalDecision string
+
+const (
+	// ApprovalDecisionApprove approves an action, which is performed immediately.
+	ApprovalDecisionApprove ApprovalDecision = "Approve"
+	// ApprovalDecisionDeny denies an action, which is not retried until the deployment is next reconciled.
+	ApprovalDecisionDeny ApprovalDecision = "Deny"
+	// ApprovalDecisionDefer defers an action, approval is requested again after the retry time.
+	ApprovalDecisionDefer ApprovalDecision = "Defer"
+)
+
+// ApprovalStatus is a decision of the approval webhook.
+type ApprovalStatus struct {
+	// Time is the time the decision was made.
+	Time metav1.Time `json:"time"`
+	// Action is the action that approval was requested for, either DeletePods or Scale.
+	Action ApprovalAction `json:"action"`
+	// Inputs are the inputs of the action, for example the names of the Pods to be deleted.
+	// +optional
+	Inputs map[string]string `json:"inputs,omitempty"`
+	// Decision is the decision, one of Approve, Deny or Defer.
+	Decision ApprovalDecision `json:"decision"`
+	// Reason is the reason given for the decision.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// IsRepeatOf returns true if this decision is for the same action and inputs, with the same decision
+// and reason, as the specified decision, ignoring the time.
+func (in ApprovalStatus) IsRepeatOf(other ApprovalStatus) bool {
+	return in.Action == other.Action &&
+		in.Decision == other.Decision &&
+		in.Reason == other.Reason &&
+		maps.Equal(in.Inputs, other.Inputs)
+}
+
+// HALevelStatus is the result of the most recent check of the HA status level of the partitioned
+// services against the required HA status level.
+type HALevelStatus struct {
+	// Time is the time of the first check with this result, a check with the same result does not update the time.
+	Time metav1.Time `json:"time"`
+	// Required is the required HA status level.
+	Required HALevel `json:"required"`
+	// Observed is the lowest HA status of the partitioned services with backups, for example
+	// ENDANGERED or MACHINE_SAFE. Empty if there are no partitioned services with backups.
+	// +optional
+	Observed string `json:"observed,omitempty"`
+	// Service is the name of a partitioned service with the observed HA status.
+	// +optional
+	Service string `json:"service,omitempty"`
+	// Safe is true if every partitioned service with backups is at or above the required level.
+	Safe bool `json:"safe"`
+}
+
+// IsRepeatOf returns true if this check has the same result as the specified check, ignoring the time.
+func (in HALevelStatus) IsRepeatOf(other HALevelStatus) bool {
+	return in.Required == other.Required &&
+		in.Observed == other.Observed &&
+		in.Service == other.Service &&
+		in.Safe == other.Safe
+}
+
+// Message returns a description of the result of the check.
+func (in HALevelStatus) Message() string {
+	switch {
+	case in.Observed == "":
+		return fmt.Sprintf("there are no partitioned services with backups, the required HA status level is %s", in.Required)
+	case in.Safe:
+		return fmt.Sprintf("all partitioned services are at or above the required HA status level %s, the lowest is service %s at %s",
+			in.Required, in.Service, in.Observed)
+	default:
+		return fmt.Sprintf("service %s is %s, below the required HA status level %s", in.Service, in.Observed, in.Required)
+	}
+}
+
+// AutoscaleMetricStatus is the value of a single autoscaling metric.
+type AutoscaleMetricStatus struct {
+	// Name is the name of the metric, either HeapUtilization or CacheEntriesPerMember.
+	Name string `json:"name"`
+	// Current is the current value of the metric.
+	Current int64 `json:"current"`
+	// Target is the target value of the metric.
+	Target int64 `json:"target"`
+	// Replicas is the number of replicas required to meet the target.
+	Replicas int32 `json:"replicas"`
+}
+
+// durationOrDefault returns the value of a Duration, or the default if the Duration is nil or not positive.
+func durationOrDefault(d *metav1.Duration, dflt time.Duration) time.Duration {
+	if d == nil || d.Duration <= 0 {
+		return dflt
+	}
+	return d.Duration
+}
+
+// ----- PodDisruptionBudgetSpec -----------------------------------------
+
+// PodDisruptionBudgetSpec is the configuration of the PodDisruptionBudget created for a Coherence deployment.
+// Only one of MinAvailable or MaxUnavailable may be set. If neither is set the Operator will
+// work out a default based on the number of replicas and whether the deployment is storage enabled.
+// +k8s:openapi-gen=true
+type PodDisruptionBudgetSpec struct {
+	// Enabled controls whether the Operator creates a PodDisruptionBudget for the deployment.
+	// The default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// An eviction is allowed if at least "minAvailable" Pods in the deployment
+	// will still be available after the eviction, i.e. even in the absence of
+	// the evicted Pod. So for example you can prevent all voluntary evictions
+	// by specifying "100%".
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// An eviction is allowed if at most "maxUnavailable" Pods in the deployment
+	// are unavailable after the eviction, i.e. even in absence of the evicted Pod.
+	// For example, one can prevent all voluntary evictions by specifying 0.
+	// This is a mutually exclusive setting with "minAvailable".
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// BackupCount is the backup count configured for the partitioned cache services
+	// in storage enabled members of the deployment. This is used to work out the default
+	// "maxUnavailable" value, so that no more members can be evicted than the backup count
+	// tolerates without losing data. The default is 1, which is the Coherence default backup count.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	BackupCount *int32 `json:"backupCount,omitempty"`
+	// UnhealthyPodEvictionPolicy defines the criteria for when unhealthy Pods
+	// should be considered for eviction.
+	// See: https://kubernetes.io/docs/tasks/run-application/configure-pdb/#unhealthy-pod-eviction-policy
+	// +optional
+	UnhealthyPodEvictionPolicy *policyv1.UnhealthyPodEvictionPolicyType `json:"unhealthyPodEvictionPolicy,omitempty"`
+}
+
+// IsEnabled returns true if a PodDisruptionBudget should be created.
+func (in *PodDisruptionBudgetSpec) IsEnabled() bool {
+	return in == nil || in.Enabled == nil || *in.Enabled
+}
+
+// GetBackupCount returns the backup count to use to work out the default maxUnavailable value.
+func (in *PodDisruptionBudgetSpec) GetBackupCount() int32 {
+	if in == nil || in.BackupCount == nil || *in.BackupCount < 0 {
+		return 1
+	}
+	return *in.BackupCount
 }
 
 // ----- Probe ----------------------------------------------------
@@ -2445,8 +3748,63 @@ const (
 	// ParallelUpSafeDownScaling means that a deployment will be scaled up by adding or removing members in parallel
 	// but will be scaled down in a safe manner to ensure no data loss.
 	ParallelUpSafeDownScaling ScalingPolicy = "ParallelUpSafeDown"
+	// SafeBatchScaling means that a deployment will be scaled up by adding members in parallel and will be
+	// scaled down by removing batches of members, where each batch is as large as possible while keeping
+	// every partitioned service at or above the required HA status level.
+	SafeBatchScaling ScalingPolicy = "SafeBatch"
+)
+
+// ----- HALevel type -------------------------------------------------------
+
+// HALevel is the HA status level of a Coherence partitioned service.
+type HALevel string
+
+// HA status level constants
+const (
+	// HALevelNodeSafe means that data is safe if any single member fails.
+	HALevelNodeSafe HALevel = "NODE_SAFE"
+	// HALevelMachineSafe means that data is safe if all the members on any single machine fail.
+	HALevelMachineSafe HALevel = "MACHINE_SAFE"
+	// HALevelRackSafe means that data is safe if all the members on any single rack fail.
+	HALevelRackSafe HALevel = "RACK_SAFE"
+	// HALevelSiteSafe means that data is safe if all the members in any single site fail.
+	HALevelSiteSafe HALevel = "SITE_SAFE"
 )
 
+// StatusCode returns the Coherence HA status code of the level, which is one for NODE_SAFE up to
+// four for SITE_SAFE, or zero if the level is not valid.
+func (in HALevel) StatusCode() int {
+	switch in {
+	case HALevelNodeSafe:
+		return 1
+	case HALevelMachineSafe:
+		return 2
+	case HALevelRackSafe:
+		return 3
+	case HALevelSiteSafe:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// HALevelForStatusCode returns the HA status level for a Coherence HA status code, codes above
+// SITE_SAFE are treated as SITE_SAFE. The second result is false if the code is below NODE_SAFE.
+func HALevelForStatusCode(code int) (HALevel, bool) {
+	switch {
+	case code >= 4:
+		return HALevelSiteSafe, true
+	case code == 3:
+		return HALevelRackSafe, true
+	case code == 2:
+		return HALevelMachineSafe, true
+	case code == 1:
+		return HALevelNodeSafe, true
+	default:
+		return "", false
+	}
+}
+
 // ----- LocalObjectReference -----------------------------------------------
 
 // LocalObjectReference contains enough information to let you locate the
@@ -2496,6 +3854,18 @@ type NetworkSpec struct {
 	// If not specified, the pod will not have a domain name at all.
 	// +optional
 	Subdomain *string `json:"subdomain,omitempty"`
+	// NetworkPolicy configures the NetworkPolicies the Operator creates for the deployment.
+	// If not set, no NetworkPolicies are created.
+	// +optional
+	NetworkPolicy *NetworkPolicySpec `json:"networkPolicy,omitempty"`
+}
+
+// GetNetworkPolicy returns the NetworkPolicy configuration, or nil if none is set.
+func (in *NetworkSpec) GetNetworkPolicy() *NetworkPolicySpec {
+	if in == nil {
+		return nil
+	}
+	return in.NetworkPolicy
 }
 
 // UpdatePodTemplate updates the specified StatefulSet's network settings.
@@ -2517,6 +3887,107 @@ func (in *NetworkSpec) UpdatePodTemplate(podTemplate *corev1.PodTemplateSpec) {
 	podTemplate.Spec.Subdomain = notNilString(in.Subdomain)
 }
 
+// ----- NetworkPolicySpec --------------------------------------------------
+
+// NetworkPolicySpec configures the ingress and egress NetworkPolicies the Operator creates for a deployment.
+// The ingress policy allows cluster traffic from members of the same Coherence cluster, health checks from
+// the Operator and traffic to the ports in the deployment's "ports" list. The egress policy allows
+// traffic to members of the same Coherence cluster, to the Operator and to DNS.
+// +k8s:openapi-gen=true
+type NetworkPolicySpec struct {
+	// Enabled controls whether the Operator creates NetworkPolicies for the deployment.
+	// The default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// EgressEnabled controls whether the Operator creates the egress NetworkPolicy.
+	// If set to false only the ingress NetworkPolicy is created.
+	// The default is true.
+	// +optional
+	EgressEnabled *bool `json:"egressEnabled,omitempty"`
+	// OperatorNamespaceSelector selects the namespace the Operator is running in.
+	// If not set, the namespace is selected by its "kubernetes.io/metadata.name" label.
+	// +optional
+	OperatorNamespaceSelector *metav1.LabelSelector `json:"operatorNamespaceSelector,omitempty"`
+	// OperatorPodSelector selects the Operator Pods.
+	// If not set, the Operator Pods are selected by the "app.kubernetes.io/name=coherence-operator" label.
+	// +optional
+	OperatorPodSelector *metav1.LabelSelector `json:"operatorPodSelector,omitempty"`
+	// From is the list of sources allowed to access the ports in the deployment's "ports" list.
+	// If not set, the ports may be accessed from any source.
+	// +listType=atomic
+	// +optional
+	From []networkingv1.NetworkPolicyPeer `json:"from,omitempty"`
+	// Ports configures the sources allowed to access individual ports in the deployment's "ports" list,
+	// overriding the sources in the "from" field.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Ports []NetworkPolicyPortSpec `json:"ports,omitempty"`
+	// Ingress is a list of additional ingress rules to add to the ingress NetworkPolicy.
+	// +listType=atomic
+	// +optional
+	Ingress []networkingv1.NetworkPolicyIngressRule `json:"ingress,omitempty"`
+	// Egress is a list of additional egress rules to add to the egress NetworkPolicy.
+	// +listType=atomic
+	// +optional
+	Egress []networkingv1.NetworkPolicyEgressRule `json:"egress,omitempty"`
+}
+
+// NetworkPolicyPortSpec configures the sources allowed to access a port in the deployment's "ports" list.
+// +k8s:openapi-gen=true
+type NetworkPolicyPortSpec struct {
+	// Name is the name of the port in the deployment's "ports" list.
+	Name string `json:"name"`
+	// From is the list of sources allowed to access the port.
+	// If empty, the port may be accessed from any source.
+	// +listType=atomic
+	// +optional
+	From []networkingv1.NetworkPolicyPeer `json:"from,omitempty"`
+}
+
+// IsEnabled returns true if NetworkPolicies should be created.
+func (in *NetworkPolicySpec) IsEnabled() bool {
+	return in != nil && (in.Enabled == nil || *in.Enabled)
+}
+
+// IsEgressEnabled returns true if the egress NetworkPolicy should be created.
+func (in *NetworkPolicySpec) IsEgressEnabled() bool {
+	return in.IsEnabled() && (in.EgressEnabled == nil || *in.EgressEnabled)
+}
+
+// GetPortSources returns the sources allowed to access a named port.
+func (in *NetworkPolicySpec) GetPortSources(name string) []networkingv1.NetworkPolicyPeer {
+	if in == nil {
+		return nil
+	}
+	for _, p := range in.Ports {
+		if p.Name == name {
+			return p.From
+		}
+	}
+	return in.From
+}
+
+// GetOperatorPeer returns the NetworkPolicyPeer that selects the Operator Pods.
+func (in *NetworkPolicySpec) GetOperatorPeer() networkingv1.NetworkPolicyPeer {
+	peer := networkingv1.NetworkPolicyPeer{}
+	if in != nil && in.OperatorNamespaceSelector != nil {
+		peer.NamespaceSelector = in.OperatorNamespaceSelector
+	} else if ns := operator.GetNamespace(); ns != "" {
+		peer.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{LabelNamespaceName: ns}}
+	} else {
+		// the Operator namespace is not known so allow any namespace
+		peer.NamespaceSelector = &metav1.LabelSelector{}
+	}
+
+	if in != nil && in.OperatorPodSelector != nil {
+		peer.PodSelector = in.OperatorPodSelector
+	} else {
+		peer.PodSelector = &metav1.LabelSelector{MatchLabels: map[string]string{LabelOperatorName: LabelOperatorNameValue}}
+	}
+	return peer
+}
+
 // ----- PodDNSConfig -------------------------------------------------------
 
 // PodDNSConfig defines the DNS parameters of a pod in addition to
@@ -2654,6 +4125,16 @@ type ConfigMapVolumeSpec struct {
 	// Specify whether the ConfigMap or its keys must be defined
 	// +optional
 	Optional *bool `json:"optional,omitempty"`
+	// RestartOnChange controls whether the Pods are restarted, using the normal rolling upgrade,
+	// when the content of the ConfigMap changes.
+	// Defaults to true.
+	// +optional
+	RestartOnChange *bool `json:"restartOnChange,omitempty"`
+}
+
+// IsRestartOnChange returns true if the Pods should be restarted when the content of the ConfigMap changes.
+func (in *ConfigMapVolumeSpec) IsRestartOnChange() bool {
+	return in != nil && (in.RestartOnChange == nil || *in.RestartOnChange)
 }
 
 // AddVolumes adds the Volume and VolumeMount for this ConfigMap spec.
@@ -2776,6 +4257,16 @@ type SecretVolumeSpec struct {
 	// Specify whether the Secret or its keys must be defined
 	// +optional
 	Optional *bool `json:"optional,omitempty"`
+	// RestartOnChange controls whether the Pods are restarted, using the normal rolling upgrade,
+	// when the content of the Secret changes.
+	// Defaults to true.
+	// +optional
+	RestartOnChange *bool `json:"restartOnChange,omitempty"`
+}
+
+// IsRestartOnChange returns true if the Pods should be restarted when the content of the Secret changes.
+func (in *SecretVolumeSpec) IsRestartOnChange() bool {
+	return in != nil && (in.RestartOnChange == nil || *in.RestartOnChange)
 }
 
 // AddVolumes adds the Volume and VolumeMount for this Secret spec.
@@ -2855,6 +4346,12 @@ const (
 	ResourceTypeServiceMonitor ResourceType = ServiceMonitorKind
 	ResourceTypeStatefulSet    ResourceType = "StatefulSet"
 	ResourceTypeJob            ResourceType = "Job"
+
+	ResourceTypePodDisruptionBudget ResourceType = "PodDisruptionBudget"
+	ResourceTypeNetworkPolicy       ResourceType = "NetworkPolicy"
+	ResourceTypeIngress             ResourceType = "Ingress"
+	ResourceTypeHTTPRoute           ResourceType = HTTPRouteKind
+	ResourceTypeCertificate         ResourceType = CertificateKind
 )
 
 func ToResourceType(kind string) (ResourceType, error) {
@@ -2876,6 +4373,16 @@ func ToResourceType(kind string) (ResourceType, error) {
 		t = ResourceTypeStatefulSet
 	case ResourceTypeJob.Name():
 		t = ResourceTypeJob
+	case ResourceTypePodDisruptionBudget.Name():
+		t = ResourceTypePodDisruptionBudget
+	case ResourceTypeNetworkPolicy.Name():
+		t = ResourceTypeNetworkPolicy
+	case ResourceTypeIngress.Name():
+		t = ResourceTypeIngress
+	case ResourceTypeHTTPRoute.Name():
+		t = ResourceTypeHTTPRoute
+	case ResourceTypeCertificate.Name():
+		t = ResourceTypeCertificate
 	default:
 		err = fmt.Errorf("attempt to obtain ResourceType unsupported kind %s", kind)
 	}
@@ -2901,6 +4408,16 @@ func (t ResourceType) toObject() (client.Object, error) {
 		o = &appsv1.StatefulSet{}
 	case ResourceTypeJob:
 		o = &batchv1.Job{}
+	case ResourceTypePodDisruptionBudget:
+		o = &policyv1.PodDisruptionBudget{}
+	case ResourceTypeNetworkPolicy:
+		o = &networkingv1.NetworkPolicy{}
+	case ResourceTypeIngress:
+		o = &networkingv1.Ingress{}
+	case ResourceTypeHTTPRoute:
+		o = &gatewayv1.HTTPRoute{}
+	case ResourceTypeCertificate:
+		o = &certmanagerv1.Certificate{}
 	default:
 		err = fmt.Errorf("attempt to obtain runtime.Object for unsupported type %s", t)
 	}
@@ -3057,6 +4574,10 @@ func (in Resources) EnsureGVK(s *runtime.Scheme) {
 				Kind:    ServiceMonitorKind,
 			}
 			r.Spec.GetObjectKind().SetGroupVersionKind(gvk)
+		case !r.IsDelete() && r.Kind == ResourceTypeHTTPRoute:
+			r.Spec.GetObjectKind().SetGroupVersionKind(schema.FromAPIVersionAndKind(gatewayv1.GroupVersion.String(), HTTPRouteKind))
+		case !r.IsDelete() && r.Kind == ResourceTypeCertificate:
+			r.Spec.GetObjectKind().SetGroupVersionKind(certmanagerv1.SchemeGroupVersion.WithKind(CertificateKind))
 		case !r.IsDelete():
 			gvks, _, _ := s.ObjectKinds(r.Spec)
 			if len(gvks) > 0 {
@@ -3148,6 +4669,40 @@ func (in Resources) SetHashLabelAndAnnotations(hash string) {
 	}
 }
 
+// SetConfigHashAnnotation sets the config hash annotation on the Pod template of the StatefulSet
+// resources. If the hash is blank, any existing config hash annotation is removed.
+func (in Resources) SetConfigHashAnnotation(hash string) {
+	for _, r := range in.Items {
+		if r.IsDelete() || r.Kind != ResourceTypeStatefulSet {
+			continue
+		}
+		sts, ok := r.Spec.(*appsv1.StatefulSet)
+		if !ok {
+			continue
+		}
+		if hash == "" {
+			delete(sts.Spec.Template.Annotations, AnnotationConfigHash)
+			continue
+		}
+		if sts.Spec.Template.Annotations == nil {
+			sts.Spec.Template.Annotations = make(map[string]string)
+		}
+		sts.Spec.Template.Annotations[AnnotationConfigHash] = hash
+	}
+}
+
+// GetConfigHashAnnotation returns the config hash annotation on the Pod template of the named StatefulSet resource.
+func (in Resources) GetConfigHashAnnotation(name string) string {
+	r, found := in.GetResource(ResourceTypeStatefulSet, name)
+	if !found || r.IsDelete() {
+		return ""
+	}
+	if sts, ok := r.Spec.(*appsv1.StatefulSet); ok {
+		return sts.Spec.Template.Annotations[AnnotationConfigHash]
+	}
+	return ""
+}
+
 // Create the specified resource
 func (in Resources) Create(kind ResourceType, name string, mgr manager.Manager, logger logr.Logger) error {
 	logger.Info(fmt.Sprintf("Creating %s for deployment", kind))
@@ -0,0 +1,207 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package v1_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestStandardConditionsWhenReady(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createStatusTestDeployment(3, 2)
+	status := coh.CoherenceResourceStatus{}
+	status.SetCondition(deployment, coh.Condition{Type: coh.ConditionTypeCreated, Status: corev1.ConditionTrue})
+	g.Expect(status.ObservedGeneration).To(Equal(int64(2)))
+	assertCondition(g, status, coh.ConditionTypeAvailable, corev1.ConditionFalse, coh.ReasonReplicasNotReady)
+	assertCondition(g, status, coh.ConditionTypeProgressing, corev1.ConditionTrue, coh.ConditionReason(coh.ConditionTypeCreated))
+	assertCondition(g, status, coh.ConditionTypeDegraded, corev1.ConditionFalse, coh.ReasonAsExpected)
+	assertCondition(g, status, coh.ConditionTypeScalingBlocked, corev1.ConditionFalse, coh.ReasonNotBlocked)
+
+	deployment.Status = status
+	updated := status.Update(deployment, &appsv1.StatefulSetStatus{CurrentReplicas: 3, ReadyReplicas: 3, CurrentRevision: "1", UpdateRevision: "1"})
+	g.Expect(updated).To(BeTrue())
+	g.Expect(status.Phase).To(Equal(coh.ConditionTypeReady))
+	assertCondition(g, status, coh.ConditionTypeAvailable, corev1.ConditionTrue, coh.ReasonReplicasReady)
+	assertCondition(g, status, coh.ConditionTypeProgressing, corev1.ConditionFalse, coh.ConditionReason(coh.ConditionTypeReady))
+
+	// a status update with no changes is stable
+	deployment.Status = status
+	g.Expect(status.Update(deployment, &appsv1.StatefulSetStatus{CurrentReplicas: 3, ReadyReplicas: 3, CurrentRevision: "1", UpdateRevision: "1"})).To(BeFalse())
+}
+
+func TestStandardConditionsDuringRollingUpgrade(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createStatusTestDeployment(3, 5)
+	status := coh.CoherenceResourceStatus{}
+	status.Update(deployment, &appsv1.StatefulSetStatus{CurrentReplicas: 3, ReadyReplicas: 2, CurrentRevision: "1", UpdateRevision: "2"})
+	g.Expect(status.Phase).To(Equal(coh.ConditionTypeRollingUpgrade))
+	assertCondition(g, status, coh.ConditionTypeAvailable, corev1.ConditionTrue, coh.ConditionReason(coh.ConditionTypeRollingUpgrade))
+	assertCondition(g, status, coh.ConditionTypeProgressing, corev1.ConditionTrue, coh.ConditionReason(coh.ConditionTypeRollingUpgrade))
+
+	c := status.Conditions.GetCondition(coh.ConditionTypeProgressing)
+	g.Expect(c.ObservedGeneration).To(Equal(int64(5)))
+}
+
+func TestStandardConditionsWhenStopped(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createStatusTestDeployment(0, 1)
+	status := coh.CoherenceResourceStatus{}
+	status.Update(deployment, nil)
+	g.Expect(status.Phase).To(Equal(coh.ConditionTypeStopped))
+	assertCondition(g, status, coh.ConditionTypeAvailable, corev1.ConditionFalse, coh.ReasonStopped)
+	assertCondition(g, status, coh.ConditionTypeProgressing, corev1.ConditionFalse, coh.ConditionReason(coh.ConditionTypeStopped))
+}
+
+func TestStandardConditionsWhenDegraded(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createStatusTestDeployment(3, 1)
+	status := coh.CoherenceResourceStatus{}
+	status.Conditions.SetCondition(coh.Condition{Type: coh.ConditionTypeServicesEndangered, Status: corev1.ConditionTrue, Message: "services below NODE-SAFE"})
+	status.UpdateStandardConditions(deployment)
+	assertCondition(g, status, coh.ConditionTypeDegraded, corev1.ConditionTrue, coh.ConditionReason(coh.ConditionTypeServicesEndangered))
+	g.Expect(status.Conditions.GetCondition(coh.ConditionTypeDegraded).Message).To(Equal("services below NODE-SAFE"))
+
+	status.Phase = coh.ConditionTypeFailed
+	status.UpdateStandardConditions(deployment)
+	assertCondition(g, status, coh.ConditionTypeDegraded, corev1.ConditionTrue, coh.ConditionReason(coh.ConditionTypeFailed))
+	assertCondition(g, status, coh.ConditionTypeProgressing, corev1.ConditionFalse, coh.ConditionReason(coh.ConditionTypeFailed))
+}
+
+func TestScalingBlockedIsClearedWhenScaled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createStatusTestDeployment(3, 1)
+	status := coh.CoherenceResourceStatus{}
+	status.Update(deployment, &appsv1.StatefulSetStatus{CurrentReplicas: 2, ReadyReplicas: 2, CurrentRevision: "1", UpdateRevision: "1"})
+
+	// the condition set by the scaling process is kept while scaling
+	status.Conditions.SetCondition(coh.Condition{Type: coh.ConditionTypeScalingBlocked, Status: corev1.ConditionTrue, Reason: coh.ReasonNotStatusHA})
+	status.UpdateStandardConditions(deployment)
+	assertCondition(g, status, coh.ConditionTypeScalingBlocked, corev1.ConditionTrue, coh.ReasonNotStatusHA)
+
+	deployment.Status = status
+	status.Update(deployment, &appsv1.StatefulSetStatus{CurrentReplicas: 3, ReadyReplicas: 3, CurrentRevision: "1", UpdateRevision: "1"})
+	assertCondition(g, status, coh.ConditionTypeScalingBlocked, corev1.ConditionFalse, coh.ReasonNotBlocked)
+}
+
+func TestSetConditionDetectsObservedGenerationChange(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	conditions := coh.Conditions{}
+	g.Expect(conditions.SetCondition(coh.Condition{Type: coh.ConditionTypeAvailable, Status: corev1.ConditionTrue, ObservedGeneration: 1})).To(BeTrue())
+	g.Expect(conditions.SetCondition(coh.Condition{Type: coh.ConditionTypeAvailable, Status: corev1.ConditionTrue, ObservedGeneration: 1})).To(BeFalse())
+	g.Expect(conditions.SetCondition(coh.Condition{Type: coh.ConditionTypeAvailable, Status: corev1.ConditionTrue, ObservedGeneration: 2})).To(BeTrue())
+}
+
+func createStatusTestDeployment(replicas int32, generation int64) *coh.Coherence {
+	return &coh.Coherence{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "storage", Generation: generation},
+		Spec: coh.CoherenceStatefulSetResourceSpec{
+			CoherenceResourceSpec: coh.CoherenceResourceSpec{Replicas: ptr.To(replicas)},
+		},
+	}
+}
+
+func assertCondition(g *WithT, status coh.CoherenceResourceStatus, t coh.ConditionType, s corev1.ConditionStatus, reason coh.ConditionReason) {
+	c := status.Conditions.GetCondition(t)
+	g.Expect(c).NotTo(BeNil(), "missing condition %s", t)
+	g.Expect(c.Status).To(Equal(s), "condition %s status", t)
+	g.Expect(c.Reason).To(Equal(reason), "condition %s reason", t)
+}
+
+func TestAddHistoryIsBounded(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	status := coh.CoherenceResourceStatus{}
+	for i := 0; i < coh.MaxHistoryEntries+5; i++ {
+		entry := coh.NewHistoryEntry(coh.HistoryOperationScale, coh.HistoryResultSucceeded, "scaled",
+			map[string]string{"replicas": strconv.Itoa(i)})
+		g.Expect(status.AddHistory(entry)).To(BeTrue())
+	}
+	g.Expect(status.History).To(HaveLen(coh.MaxHistoryEntries))
+	// the oldest entries are removed
+	g.Expect(status.History[0].Inputs["replicas"]).To(Equal("5"))
+	g.Expect(status.History[coh.MaxHistoryEntries-1].Inputs["replicas"]).To(Equal(strconv.Itoa(coh.MaxHistoryEntries + 4)))
+}
+
+func TestAddHistoryIgnoresRepeatedEntry(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	status := coh.CoherenceResourceStatus{}
+	inputs := map[string]string{"currentReplicas": "3", "desiredReplicas": "2"}
+	g.Expect(status.AddHistory(coh.NewHistoryEntry(coh.HistoryOperationScale, coh.HistoryResultDeferred, "not StatusHA", inputs))).To(BeTrue())
+	g.Expect(status.AddHistory(coh.NewHistoryEntry(coh.HistoryOperationScale, coh.HistoryResultDeferred, "not StatusHA", inputs))).To(BeFalse())
+	g.Expect(status.AddHistory(coh.NewHistoryEntry(coh.HistoryOperationScale, coh.HistoryResultSucceeded, "scaled", inputs))).To(BeTrue())
+	g.Expect(status.History).To(HaveLen(2))
+}
+
+func TestIsRolledBack(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	status := coh.CoherenceResourceStatus{}
+	g.Expect(status.IsRolledBack(2)).To(BeFalse())
+
+	status.Conditions.SetCondition(coh.Condition{Type: coh.ConditionTypeRolledBack, Status: corev1.ConditionTrue, ObservedGeneration: 2})
+	g.Expect(status.IsRolledBack(2)).To(BeTrue())
+	// the resource has been updated since the rollback
+	g.Expect(status.IsRolledBack(3)).To(BeFalse())
+
+	status.Conditions.SetCondition(coh.Condition{Type: coh.ConditionTypeRolledBack, Status: corev1.ConditionFalse, ObservedGeneration: 2})
+	g.Expect(status.IsRolledBack(2)).To(BeFalse())
+}
+
+func TestSetApprovalIgnoresRepeatedDecision(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	status := coh.CoherenceResourceStatus{}
+	inputs := map[string]string{"currentReplicas": "3", "desiredReplicas": "2"}
+	deferred := coh.ApprovalStatus{Time: metav1.Now(), Action: coh.ApprovalActionScale, Inputs: inputs, Decision: coh.ApprovalDecisionDefer, Reason: "wait"}
+	g.Expect(status.SetApproval(deferred)).To(BeTrue())
+
+	repeat := deferred
+	repeat.Time = metav1.NewTime(deferred.Time.Add(time.Minute))
+	g.Expect(status.SetApproval(repeat)).To(BeFalse())
+	g.Expect(status.Approval.Time).To(Equal(deferred.Time))
+
+	approved := deferred
+	approved.Decision = coh.ApprovalDecisionApprove
+	g.Expect(status.SetApproval(approved)).To(BeTrue())
+	g.Expect(status.Approval.Decision).To(Equal(coh.ApprovalDecisionApprove))
+}
+
+func TestSetHALevelIgnoresRepeatedResult(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	status := coh.CoherenceResourceStatus{}
+	below := coh.HALevelStatus{Time: metav1.Now(), Required: coh.HALevelSiteSafe, Observed: "MACHINE_SAFE", Service: "PartitionedCache"}
+	g.Expect(status.SetHALevel(below)).To(BeTrue())
+	g.Expect(status.HALevel.Message()).To(Equal("service PartitionedCache is MACHINE_SAFE, below the required HA status level SITE_SAFE"))
+
+	repeat := below
+	repeat.Time = metav1.NewTime(below.Time.Add(time.Minute))
+	g.Expect(status.SetHALevel(repeat)).To(BeFalse())
+	g.Expect(status.HALevel.Time).To(Equal(below.Time))
+
+	safe := below
+	safe.Observed = "SITE_SAFE"
+	safe.Safe = true
+	g.Expect(status.SetHALevel(safe)).To(BeTrue())
+	g.Expect(status.HALevel.Message()).To(Equal("all partitioned services are at or above the required HA status level SITE_SAFE, the lowest is service PartitionedCache at SITE_SAFE"))
+}
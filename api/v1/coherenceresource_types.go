@@ -8,12 +8,16 @@ package v1
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/oracle/coherence-operator/pkg/operator"
+	"github.com/pkg/errors"
 	"golang.org/x/mod/semver"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -55,6 +59,81 @@ const (
 	ConditionTypeStopped        ConditionType = "Stopped"
 	ConditionTypeCompleted      ConditionType = "Completed"
 	ConditionTypeVersioned      ConditionType = "Versioned"
+	// ConditionTypeDrifted is the condition set when one or more secondary resources
+	// no longer match the desired state. This condition does not change the phase.
+	ConditionTypeDrifted ConditionType = "Drifted"
+	// ConditionTypeServicesEndangered is the condition set when one or more partitioned services
+	// in the cluster are below NODE-SAFE. This condition does not change the phase.
+	ConditionTypeServicesEndangered ConditionType = "ServicesEndangered"
+	// ConditionTypeAvailable is the standard condition that is true when the deployment has ready members.
+	// This condition does not change the phase.
+	ConditionTypeAvailable ConditionType = "Available"
+	// ConditionTypeProgressing is the standard condition that is true while the deployment is being created,
+	// scaled or upgraded. This condition does not change the phase.
+	ConditionTypeProgressing ConditionType = "Progressing"
+	// ConditionTypeDegraded is the standard condition that is true when the deployment has failed, or has
+	// endangered services. This condition does not change the phase.
+	ConditionTypeDegraded ConditionType = "Degraded"
+	// ConditionTypeScalingBlocked is the standard condition that is true when a scaling request is waiting
+	// for the cluster to be StatusHA, for services to be suspended, or for approval. This condition does not change the phase.
+	ConditionTypeScalingBlocked ConditionType = "ScalingBlocked"
+	// ConditionTypeCanaryFailed is the condition that is true when the canary Pods of a Canary rolling upgrade
+	// failed verification and the upgrade is being held. This condition does not change the phase.
+	ConditionTypeCanaryFailed ConditionType = "CanaryFailed"
+	// ConditionTypeRollingUpgradePaused is the condition that is true while rolling upgrades are paused.
+	// This condition does not change the phase.
+	ConditionTypeRollingUpgradePaused ConditionType = "RollingUpgradePaused"
+	// ConditionTypeRolledBack is the condition that is true when a failed rolling upgrade has been rolled back
+	// to the previous StatefulSet. The condition's observed generation is the generation that was rolled back.
+	// This condition does not change the phase.
+	ConditionTypeRolledBack ConditionType = "RolledBack"
+	// ConditionTypeWaitingForMaintenanceWindow is the condition that is true while a rolling upgrade or
+	// scale down is waiting for the next maintenance window to open. This condition does not change the phase.
+	ConditionTypeWaitingForMaintenanceWindow ConditionType = "WaitingForMaintenanceWindow"
+
+	// ReasonReplicasReady is the Available condition reason when all the replicas are ready.
+	ReasonReplicasReady ConditionReason = "ReplicasReady"
+	// ReasonReplicasNotReady is the Available condition reason when there are no ready replicas,
+	// or not all the replicas are ready and the deployment is not scaling or upgrading.
+	ReasonReplicasNotReady ConditionReason = "ReplicasNotReady"
+	// ReasonStopped is the Available condition reason when the deployment has been scaled to zero.
+	ReasonStopped ConditionReason = "Stopped"
+	// ReasonCompleted is the Available condition reason when a Job has completed.
+	ReasonCompleted ConditionReason = "Completed"
+	// ReasonAsExpected is the Degraded condition reason when the deployment is not degraded.
+	ReasonAsExpected ConditionReason = "AsExpected"
+	// ReasonNotBlocked is the ScalingBlocked condition reason when scaling is not blocked.
+	ReasonNotBlocked ConditionReason = "NotBlocked"
+	// ReasonNotStatusHA is the ScalingBlocked condition reason when scaling is waiting for the cluster to be StatusHA.
+	ReasonNotStatusHA ConditionReason = "NotStatusHA"
+	// ReasonSuspendFailed is the ScalingBlocked condition reason when scaling to zero is waiting for services to be suspended.
+	ReasonSuspendFailed ConditionReason = "SuspendFailed"
+	// ReasonCanaryVerified is the CanaryFailed condition reason when the canary Pods passed verification.
+	ReasonCanaryVerified ConditionReason = "CanaryVerified"
+	// ReasonCanaryNotStatusHA is the CanaryFailed condition reason when the scaling probe failed after upgrading the canary Pods.
+	ReasonCanaryNotStatusHA ConditionReason = "NotStatusHA"
+	// ReasonCanaryProbeFailed is the CanaryFailed condition reason when the verification probe failed for a canary Pod.
+	ReasonCanaryProbeFailed ConditionReason = "ProbeFailed"
+	// ReasonCanaryJobFailed is the CanaryFailed condition reason when the verification Job failed.
+	ReasonCanaryJobFailed ConditionReason = "JobFailed"
+	// ReasonPaused is the RollingUpgradePaused condition reason when the rollingUpdatePaused field is true.
+	ReasonPaused ConditionReason = "Paused"
+	// ReasonRollbackNotReady is the RolledBack condition reason when upgraded Pods were not ready within the ready timeout.
+	ReasonRollbackNotReady ConditionReason = "PodsNotReady"
+	// ReasonRollbackCrashLoopBackOff is the RolledBack condition reason when upgraded Pods were in CrashLoopBackOff.
+	ReasonRollbackCrashLoopBackOff ConditionReason = "CrashLoopBackOff"
+	// ReasonRollbackAcknowledged is the RolledBack condition reason when the Coherence resource has been
+	// updated after a rollback.
+	ReasonRollbackAcknowledged ConditionReason = "Acknowledged"
+	// ReasonApprovalDenied is the ScalingBlocked condition reason when the approval webhook denied a scaling step.
+	ReasonApprovalDenied ConditionReason = "ApprovalDenied"
+	// ReasonApprovalDeferred is the ScalingBlocked condition reason when the approval webhook deferred a scaling step.
+	ReasonApprovalDeferred ConditionReason = "ApprovalDeferred"
+	// ReasonOutsideMaintenanceWindow is the WaitingForMaintenanceWindow condition reason when no maintenance window is open.
+	ReasonOutsideMaintenanceWindow ConditionReason = "OutsideMaintenanceWindow"
+	// ReasonInvalidMaintenanceWindow is the WaitingForMaintenanceWindow condition reason when no maintenance window
+	// is open and one or more maintenance windows are invalid.
+	ReasonInvalidMaintenanceWindow ConditionReason = "InvalidMaintenanceWindow"
 
 	CoherenceTypeUnknown     CoherenceType = "Unknown"
 	CoherenceTypeStatefulSet CoherenceType = "StatefulSet"
@@ -124,6 +203,10 @@ func (in *Coherence) CreateKubernetesResources() (Resources, error) {
 
 	// Create the headless Service
 	res = append(res, in.Spec.CreateHeadlessService(in))
+	// Create the PodDisruptionBudget
+	if pdb, found := in.Spec.CreatePodDisruptionBudgetResource(in); found {
+		res = append(res, pdb)
+	}
 	// Create the StatefulSet
 	res = append(res, in.Spec.CreateStatefulSetResource(in))
 	return Resources{Items: res}, nil
@@ -170,11 +253,15 @@ func (in *Coherence) GetHeadlessServiceIPFamily() []corev1.IPFamily {
 // GetReplicas returns the number of replicas required for a deployment.
 // The Replicas field is a pointer and may be nil so this method will
 // return either the actual Replicas value or the default (DefaultReplicas const)
-// if the Replicas field is nil.
+// if the Replicas field is nil. If the deployment has been autoscaled the
+// autoscaled replicas are returned.
 func (in *Coherence) GetReplicas() int32 {
 	if in == nil {
 		return DefaultReplicas
 	}
+	if replicas, ok := in.GetAutoscaledReplicas(); ok {
+		return replicas
+	}
 	if in.Spec.Replicas == nil && in.Spec.InitialReplicas == nil {
 		if in.Status.Replicas > 0 {
 			return in.Status.Replicas
@@ -183,6 +270,19 @@ func (in *Coherence) GetReplicas() int32 {
 	return in.Spec.GetReplicas()
 }
 
+// GetAutoscaledReplicas returns the number of replicas the Operator has autoscaled the deployment to
+// and true, or false if autoscaling is not enabled, the deployment has not been autoscaled, or the
+// replicas field is zero, so the deployment is stopped.
+func (in *Coherence) GetAutoscaledReplicas() (int32, bool) {
+	if in == nil || !in.Spec.Scaling.GetAutoscale().IsEnabled() || in.Spec.GetReplicas() == 0 {
+		return 0, false
+	}
+	if in.Status.Autoscale == nil || in.Status.Autoscale.Replicas == nil {
+		return 0, false
+	}
+	return *in.Status.Autoscale.Replicas, true
+}
+
 // SetReplicas sets the number of replicas required for a deployment.
 func (in *Coherence) SetReplicas(replicas int32) {
 	if in != nil {
@@ -501,6 +601,11 @@ type CoherenceStatefulSetResourceSpec struct {
 	// The configuration to control safe scaling.
 	// +optional
 	Scaling *ScalingSpec `json:"scaling,omitempty"`
+	// The configuration of the PodDisruptionBudget the Operator creates for this deployment.
+	// If not set, a PodDisruptionBudget will be created with a default configuration
+	// derived from the number of replicas and whether the deployment is storage enabled.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
 	// The configuration of the probe used to signal that services must be suspended
 	// before a deployment is stopped.
 	// +optional
@@ -578,6 +683,7 @@ type CoherenceStatefulSetResourceSpec struct {
 	// If not set, the default is "UpgradeByPod"
 	// UpgradeByPod will perform a rolling upgrade one Pod at a time.
 	// UpgradeByNode will update all Pods on a Node at the same time.
+	// Canary will upgrade a number of canary Pods first and verify them before upgrading the rest.
 	// OnDelete will not automatically apply any updates, Pods must be manually
 	// deleted for updates to be applied to the restarted Pod.
 	// +optional
@@ -585,15 +691,89 @@ type CoherenceStatefulSetResourceSpec struct {
 	// The name of the Node label to use to group Pods during a rolling upgrade.
 	// This field ony applies if RollingUpdateStrategy is set to NodeLabel.
 	// If RollingUpdateStrategy is set to NodeLabel and this field is omitted then the
-	// rolling upgrade will be by Node. It is the users responsibility to ensure that
+	// rolling upgrade will be by Node, unless the Operator's validating web-hook is enabled, in which
+	// case the resource will be rejected. It is the users responsibility to ensure that
 	// Nodes actually have the label used for this field. The label should be
 	// one of the node labels used to set the Coherence site or rack value.
 	// +optional
 	RollingUpdateLabel *string `json:"rollingUpdateLabel,omitempty"`
+	// RollingUpdateCanary configures the canary Pods and their verification.
+	// This field ony applies if RollingUpdateStrategy is set to Canary.
+	// +optional
+	RollingUpdateCanary *CanaryUpgradeSpec `json:"rollingUpdateCanary,omitempty"`
+	// RollingUpdatePaused pauses rolling upgrades of the Pods at their current point.
+	// When true, an Operator managed upgrade will not upgrade any more Pods, and the partition
+	// of a StatefulSet rolling upgrade is frozen so that the StatefulSet will not upgrade any more Pods.
+	// Updates to the Coherence resource are still applied to the StatefulSet, but will not be rolled out.
+	// Setting this field to false, or removing it, resumes the upgrade from the same point.
+	// +optional
+	RollingUpdatePaused *bool `json:"rollingUpdatePaused,omitempty"`
+	// Rollback configures the automatic rollback of a failed rolling upgrade.
+	// When an upgraded Pod fails, the Operator patches the StatefulSet back to the previous
+	// version and sets the RolledBack condition. The Coherence resource spec is not changed,
+	// the rollback remains in place until the Coherence resource is next updated.
+	// +optional
+	Rollback *RollbackSpec `json:"rollback,omitempty"`
+	// ApprovalWebhook configures an external HTTP endpoint that must approve each disruptive step
+	// performed by the Operator, that is each batch of Pods deleted by an Operator managed rolling
+	// upgrade and each step of safe scaling. If not set, no approval is required.
+	// +optional
+	ApprovalWebhook *ApprovalWebhookSpec `json:"approvalWebhook,omitempty"`
+	// MaintenanceWindows are the recurring windows of time in which disruptive operations are allowed.
+	// If set, Operator managed rolling upgrades using the Node or NodeLabel strategies, and scaling down,
+	// only run while a window is open, otherwise they wait for the next window to open.
+	// Scaling down to zero is not delayed. If not set, disruptive operations run at any time.
+	// +listType=atomic
+	// +optional
+	MaintenanceWindows []MaintenanceWindowSpec `json:"maintenanceWindows,omitempty"`
 	// HeadlessServiceIpFamilies is the optional array of IP families that can be configured for
 	// the headless service used for the StatefulSet.
 	// +optional
 	HeadlessServiceIpFamilies []corev1.IPFamily `json:"headlessServiceIpFamilies,omitempty"`
+	// DriftPolicy controls what the Operator does when a secondary resource it manages, for example
+	// the StatefulSet or a Service, has been changed so that it no longer matches the desired state.
+	// If present, the value must be one of "Report", "Correct" or "Ignore".
+	// Report will set the Drifted condition in the Coherence resource status listing the changed fields.
+	// Correct will report the drift and patch the resource back to the desired state, a StatefulSet
+	// is patched using the same StatusHA checks as any other update.
+	// Ignore will not check for drift.
+	// If not set, the default is "Report".
+	// +kubebuilder:validation:Enum=Report;Correct;Ignore
+	// +optional
+	DriftPolicy *DriftPolicyType `json:"driftPolicy,omitempty"`
+	// MemberStatus configures how the Operator populates the members and services lists in the Coherence
+	// resource status using Coherence management over REST. Coherence management must be enabled for the
+	// members and services lists to be populated.
+	// +optional
+	MemberStatus *MemberStatusSpec `json:"memberStatus,omitempty"`
+}
+
+// DriftPolicyType is a string enumeration type that enumerates
+// the possible actions to take when a secondary resource has drifted
+// from its desired state.
+// +enum
+type DriftPolicyType string
+
+const (
+	// DriftPolicyReport reports drift in the Drifted status condition.
+	DriftPolicyReport DriftPolicyType = "Report"
+	// DriftPolicyCorrect reports drift and patches the drifted resources back to the desired state.
+	DriftPolicyCorrect DriftPolicyType = "Correct"
+	// DriftPolicyIgnore does not check for drift.
+	DriftPolicyIgnore DriftPolicyType = "Ignore"
+)
+
+// IsRollingUpdatePaused returns true if rolling upgrades are paused.
+func (in *CoherenceStatefulSetResourceSpec) IsRollingUpdatePaused() bool {
+	return in != nil && in.RollingUpdatePaused != nil && *in.RollingUpdatePaused
+}
+
+// GetDriftPolicy returns the drift policy, defaulting to DriftPolicyReport.
+func (in *CoherenceStatefulSetResourceSpec) GetDriftPolicy() DriftPolicyType {
+	if in == nil || in.DriftPolicy == nil {
+		return DriftPolicyReport
+	}
+	return *in.DriftPolicy
 }
 
 // RollingUpdateStrategyType is a string enumeration type that enumerates
@@ -613,8 +793,186 @@ const (
 	// UpgradeManual is equivalent to using "OnDelete" as a StatefulSet upgrade strategy.
 	// Updates are applied to Pods by the StatefulSet controller after they are manually killed.
 	UpgradeManual RollingUpdateStrategyType = "Manual"
+	// UpgradeCanary indicates that updates will be applied to a number of canary Pods first,
+	// the remaining Pods are only updated one at a time after the canary Pods pass verification.
+	UpgradeCanary RollingUpdateStrategyType = "Canary"
 )
 
+// CanaryUpgradeSpec configures the Canary rolling upgrade strategy.
+// The canary Pods are the Pods with the highest ordinals, they are upgraded one at a time.
+// When all the canary Pods are upgraded and ready the upgrade pauses, then the scaling probe
+// and the optional verification probe and Job are run. The remaining Pods are only upgraded
+// if verification passes, otherwise the upgrade is held with the CanaryFailed condition.
+type CanaryUpgradeSpec struct {
+	// Replicas is the number of canary Pods to upgrade before the rest of the deployment.
+	// The default is one.
+	// +kubebuilder:validation:Minimum:=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Pause is how long to wait after the canary Pods are upgraded and ready before they are verified.
+	// The default is one minute.
+	// +optional
+	Pause *metav1.Duration `json:"pause,omitempty"`
+	// Probe is an optional probe executed against each canary Pod to verify the upgrade.
+	// +optional
+	Probe *Probe `json:"probe,omitempty"`
+	// Job is an optional Job that is run to verify the upgrade.
+	// The upgrade continues when the Job succeeds and is held if the Job fails.
+	// +optional
+	Job *ActionJob `json:"job,omitempty"`
+}
+
+// GetReplicas returns the number of canary Pods for a deployment with the specified replicas.
+func (in *CanaryUpgradeSpec) GetReplicas(replicas int32) int32 {
+	canary := int32(1)
+	if in != nil && in.Replicas != nil && *in.Replicas > 0 {
+		canary = *in.Replicas
+	}
+	return min(canary, replicas)
+}
+
+// GetPause returns how long to wait after the canary Pods are ready before they are verified.
+func (in *CanaryUpgradeSpec) GetPause() time.Duration {
+	if in == nil {
+		return DefaultCanaryPause
+	}
+	return durationOrDefault(in.Pause, DefaultCanaryPause)
+}
+
+// RollbackSpec configures the automatic rollback of a failed rolling upgrade.
+// +k8s:openapi-gen=true
+type RollbackSpec struct {
+	// Enabled enables the automatic rollback of a failed rolling upgrade.
+	// If not set, the default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// ReadyTimeout is how long an upgraded Pod has to become ready before the upgrade is rolled back.
+	// The default is ten minutes.
+	// +optional
+	ReadyTimeout *metav1.Duration `json:"readyTimeout,omitempty"`
+	// CrashLoopBackOff controls whether the upgrade is rolled back as soon as a container
+	// in an upgraded Pod is in CrashLoopBackOff, without waiting for the ready timeout.
+	// If not set, the default is true.
+	// +optional
+	CrashLoopBackOff *bool `json:"crashLoopBackOff,omitempty"`
+}
+
+// IsEnabled returns true if automatic rollback is enabled.
+func (in *RollbackSpec) IsEnabled() bool {
+	return in != nil && (in.Enabled == nil || *in.Enabled)
+}
+
+// GetReadyTimeout returns how long an upgraded Pod has to become ready.
+func (in *RollbackSpec) GetReadyTimeout() time.Duration {
+	if in == nil {
+		return DefaultRollbackReadyTimeout
+	}
+	return durationOrDefault(in.ReadyTimeout, DefaultRollbackReadyTimeout)
+}
+
+// IsRollbackOnCrashLoopBackOff returns true if an upgrade is rolled back when an upgraded Pod is in CrashLoopBackOff.
+func (in *RollbackSpec) IsRollbackOnCrashLoopBackOff() bool {
+	return in != nil && (in.CrashLoopBackOff == nil || *in.CrashLoopBackOff)
+}
+
+// GetPodFailure returns the reason that an upgraded Pod has failed, or an empty reason if the Pod has not failed.
+// If the Pod has not failed and is not ready, the time remaining before it exceeds the ready timeout is also returned.
+func (in *RollbackSpec) GetPodFailure(pod corev1.Pod, now time.Time) (ConditionReason, time.Duration) {
+	if in.IsRollbackOnCrashLoopBackOff() {
+		statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+		for _, cs := range statuses {
+			if cs.State.Waiting != nil && cs.State.Waiting.Reason == "CrashLoopBackOff" {
+				return ReasonRollbackCrashLoopBackOff, 0
+			}
+		}
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue {
+			return "", 0
+		}
+	}
+	if wait := pod.CreationTimestamp.Add(in.GetReadyTimeout()).Sub(now); wait > 0 {
+		return "", wait
+	}
+	return ReasonRollbackNotReady, 0
+}
+
+// NextMaintenanceWindow returns the zero time if disruptive operations are allowed at the specified time,
+// either because no maintenance windows are configured or because a window is open, otherwise the time
+// that the next maintenance window opens. An invalid window never opens, so if no window is open an
+// error describing the invalid windows is also returned. If every window is invalid the zero time is
+// returned with the error, in which case disruptive operations are not allowed.
+func (in *CoherenceStatefulSetResourceSpec) NextMaintenanceWindow(now time.Time) (time.Time, error) {
+	var next time.Time
+	var invalid []string
+	for i := range in.MaintenanceWindows {
+		t, err := in.MaintenanceWindows[i].NextOpen(now)
+		switch {
+		case err != nil:
+			invalid = append(invalid, fmt.Sprintf("maintenanceWindows[%d]: %s", i, err.Error()))
+		case t.IsZero():
+			return t, nil
+		case next.IsZero() || t.Before(next):
+			next = t
+		}
+	}
+	if len(invalid) > 0 {
+		return next, errors.New(strings.Join(invalid, "; "))
+	}
+	return next, nil
+}
+
+// ApprovalWebhookSpec configures the external approval of rolling upgrade and scaling steps.
+// The Operator POSTs a JSON approval request describing the resource, the Pods and the planned
+// action to the URL, and expects a JSON response with a decision of Approve, Deny or Defer.
+// +k8s:openapi-gen=true
+type ApprovalWebhookSpec struct {
+	// URL is the URL of the approval endpoint.
+	URL string `json:"url"`
+	// Timeout is the timeout for a single approval request.
+	// The default is ten seconds.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// RetryAfter is how long to wait before requesting approval again when a step is deferred,
+	// or the endpoint could not be called. A response may specify its own retry time.
+	// The default is one minute.
+	// +optional
+	RetryAfter *metav1.Duration `json:"retryAfter,omitempty"`
+	// CABundle is a PEM encoded CA bundle used to verify the certificate of an https approval endpoint.
+	// If not set the system trust roots are used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+	// BearerTokenSecret is a key in a Secret, in the same namespace as the Coherence resource, containing
+	// a token that is sent as a bearer token in the Authorization header of each approval request.
+	// +optional
+	BearerTokenSecret *corev1.SecretKeySelector `json:"bearerTokenSecret,omitempty"`
+	// HTTPSOnly, when true, only allows the approval endpoint to be called using https,
+	// including any redirects. The default is false.
+	// +optional
+	HTTPSOnly *bool `json:"httpsOnly,omitempty"`
+}
+
+// GetTimeout returns the timeout for a single approval request.
+func (in *ApprovalWebhookSpec) GetTimeout() time.Duration {
+	if in == nil {
+		return DefaultApprovalTimeout
+	}
+	return durationOrDefault(in.Timeout, DefaultApprovalTimeout)
+}
+
+// GetRetryAfter returns how long to wait before requesting approval again after a step is deferred.
+func (in *ApprovalWebhookSpec) GetRetryAfter() time.Duration {
+	if in == nil {
+		return DefaultApprovalRetryAfter
+	}
+	return durationOrDefault(in.RetryAfter, DefaultApprovalRetryAfter)
+}
+
+// IsHTTPSOnly returns true if the approval endpoint may only be called using https.
+func (in *ApprovalWebhookSpec) IsHTTPSOnly() bool {
+	return in != nil && in.HTTPSOnly != nil && *in.HTTPSOnly
+}
+
 // CreateStatefulSetResource creates the deployment's StatefulSet resource.
 func (in *CoherenceStatefulSetResourceSpec) CreateStatefulSetResource(deployment *Coherence) Resource {
 	sts := in.CreateStatefulSet(deployment)
@@ -699,6 +1057,82 @@ func (in *CoherenceStatefulSetResourceSpec) CreateStatefulSet(deployment *Cohere
 	return sts
 }
 
+// CreatePodDisruptionBudgetResource creates the deployment's PodDisruptionBudget resource,
+// returning false if no PodDisruptionBudget is required.
+func (in *CoherenceStatefulSetResourceSpec) CreatePodDisruptionBudgetResource(deployment *Coherence) (Resource, bool) {
+	pdb, found := in.CreatePodDisruptionBudget(deployment)
+	if !found {
+		return Resource{}, false
+	}
+	return Resource{
+		Kind: ResourceTypePodDisruptionBudget,
+		Name: pdb.GetName(),
+		Spec: pdb,
+	}, true
+}
+
+// CreatePodDisruptionBudget creates the deployment's PodDisruptionBudget, returning false
+// if the PodDisruptionBudget is disabled or the deployment has no replicas.
+func (in *CoherenceStatefulSetResourceSpec) CreatePodDisruptionBudget(deployment *Coherence) (*policyv1.PodDisruptionBudget, bool) {
+	replicas := in.GetReplicas()
+	if replicas <= 0 || !in.PodDisruptionBudget.IsEnabled() {
+		return nil, false
+	}
+
+	labels := deployment.CreateGlobalLabels()
+	labels[LabelComponent] = LabelComponentPodDisruptionBudget
+
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   deployment.GetNamespace(),
+			Name:        deployment.GetName(),
+			Labels:      labels,
+			Annotations: deployment.CreateGlobalAnnotations(),
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: in.CreatePodSelectorLabels(deployment),
+			},
+		},
+	}
+
+	spec := in.PodDisruptionBudget
+	switch {
+	case spec != nil && spec.MinAvailable != nil:
+		pdb.Spec.MinAvailable = spec.MinAvailable
+	case spec != nil && spec.MaxUnavailable != nil:
+		pdb.Spec.MaxUnavailable = spec.MaxUnavailable
+	default:
+		pdb.Spec.MaxUnavailable = ptr.To(intstr.FromInt32(in.GetDefaultMaxUnavailable()))
+	}
+
+	if spec != nil {
+		pdb.Spec.UnhealthyPodEvictionPolicy = spec.UnhealthyPodEvictionPolicy
+	}
+
+	return pdb, true
+}
+
+// GetDefaultMaxUnavailable returns the default maxUnavailable value for the deployment's PodDisruptionBudget.
+// For a storage enabled deployment this is the backup count, so that no more members can be evicted
+// than the backup count tolerates. For a storage disabled deployment up to half the members may be evicted.
+// The value is always at least one, so that a PodDisruptionBudget never blocks a Node drain completely.
+func (in *CoherenceStatefulSetResourceSpec) GetDefaultMaxUnavailable() int32 {
+	var maxUnavailable int32
+	if in.Coherence.IsStorageEnabled() {
+		maxUnavailable = in.PodDisruptionBudget.GetBackupCount()
+	} else {
+		maxUnavailable = in.GetReplicas() / 2
+	}
+	if replicas := in.GetReplicas(); maxUnavailable >= replicas {
+		maxUnavailable = replicas - 1
+	}
+	if maxUnavailable < 1 {
+		maxUnavailable = 1
+	}
+	return maxUnavailable
+}
+
 // CheckHABeforeUpdate returns true if a StatusHA check should be made before updating a deployment.
 func (in *CoherenceStatefulSetResourceSpec) CheckHABeforeUpdate() bool {
 	return in.HABeforeUpdate == nil || *in.HABeforeUpdate
@@ -709,6 +1143,18 @@ func (in *CoherenceStatefulSetResourceSpec) IsSuspendServicesOnShutdown() bool {
 	return in.SuspendServicesOnShutdown == nil || *in.SuspendServicesOnShutdown
 }
 
+// IsAutoResumeService returns true if the specified service may be resumed by the Operator.
+// An entry for the service in AutoResumeServices overrides the value of ResumeServicesOnStartup.
+func (in *CoherenceStatefulSetResourceSpec) IsAutoResumeService(name string) bool {
+	if in == nil {
+		return true
+	}
+	if resume, found := in.AutoResumeServices[name]; found {
+		return resume
+	}
+	return in.ResumeServicesOnStartup == nil || *in.ResumeServicesOnStartup
+}
+
 // GetEffectiveScalingPolicy returns the scaling policy to be used.
 func (in *CoherenceStatefulSetResourceSpec) GetEffectiveScalingPolicy() ScalingPolicy {
 	if in == nil {
@@ -807,6 +1253,10 @@ type CoherenceResourceStatus struct {
 	//
 	// +optional
 	Phase ConditionType `json:"phase,omitempty"`
+	// ObservedGeneration is the most recent generation of the Coherence resource observed by the Operator.
+	// The standard Available, Progressing, Degraded and ScalingBlocked conditions reflect this generation.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// The name of the Coherence cluster that this deployment is part of.
 	// +optional
 	CoherenceCluster string `json:"coherenceCluster,omitempty"`
@@ -860,19 +1310,96 @@ type CoherenceResourceStatus struct {
 	// +patchMergeKey=pod
 	// +patchStrategy=merge
 	JobProbes []CoherenceJobProbeStatus `json:"jobProbes,omitempty"`
+	// LastScheduledSnapshotTime is the time that a scheduled snapshot was last started.
+	// +optional
+	LastScheduledSnapshotTime *metav1.Time `json:"lastScheduledSnapshotTime,omitempty"`
+	// LastSnapshotTime is the completion time of the most recent successful scheduled snapshot.
+	// +optional
+	LastSnapshotTime *metav1.Time `json:"lastSnapshotTime,omitempty"`
+	// LastSnapshot is the name of the most recent successful scheduled snapshot.
+	// +optional
+	LastSnapshot string `json:"lastSnapshot,omitempty"`
+	// SnapshotScheduleStartTime is the time that the Operator first observed the snapshot schedule.
+	// Schedule times before this time are never run, so adding a schedule to an existing Coherence
+	// resource does not take a snapshot for a schedule time that has already passed.
+	// +optional
+	SnapshotScheduleStartTime *metav1.Time `json:"snapshotScheduleStartTime,omitempty"`
+	// Autoscale is the status of metric driven scaling of the deployment.
+	// +optional
+	Autoscale *AutoscaleStatus `json:"autoscale,omitempty"`
+	// Members is the list of Coherence cluster members for the Pods of the deployment,
+	// obtained periodically from Coherence management over REST.
+	// +listType=map
+	// +listMapKey=pod
+	// +optional
+	Members []CoherenceMemberStatus `json:"members,omitempty"`
+	// Services is the list of partitioned services in the Coherence cluster with their HA status
+	// and partition distribution, obtained periodically from Coherence management over REST.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Services []CoherenceServiceStatus `json:"services,omitempty"`
+	// History is a bounded list of the most recent operations performed by the Operator on
+	// the deployment, such as scaling, rolling upgrade steps, service suspension, actions
+	// and error recovery, oldest first. Unlike events, the history does not expire.
+	// +listType=atomic
+	// +optional
+	History []HistoryEntry `json:"history,omitempty"`
+	// Approval is the most recent decision of the approval webhook for a rolling upgrade or scaling step.
+	// +optional
+	Approval *ApprovalStatus `json:"approval,omitempty"`
+	// HALevel is the result of the most recent check of the HA status level of the partitioned services
+	// against the required HA status level configured in the scaling spec.
+	// +optional
+	HALevel *HALevelStatus `json:"haLevel,omitempty"`
+}
+
+// AddHistory adds an entry to the history, removing the oldest entries if the history
+// has more than MaxHistoryEntries. An entry that repeats the most recent entry is not added,
+// so that an operation deferred on every reconcile is recorded once.
+// Returns true if the entry was added.
+func (in *CoherenceResourceStatus) AddHistory(entry HistoryEntry) bool {
+	if l := len(in.History); l > 0 && in.History[l-1].IsRepeatOf(entry) {
+		return false
+	}
+	in.History = append(in.History, entry)
+	if l := len(in.History); l > MaxHistoryEntries {
+		in.History = in.History[l-MaxHistoryEntries:]
+	}
+	return true
+}
+
+// SetApproval sets the most recent decision of the approval webhook.
+// A decision that repeats the current decision, ignoring the time, is not set.
+// Returns true if the decision was set.
+func (in *CoherenceResourceStatus) SetApproval(approval ApprovalStatus) bool {
+	if in.Approval != nil && in.Approval.IsRepeatOf(approval) {
+		return false
+	}
+	in.Approval = &approval
+	return true
+}
+
+// SetHALevel sets the result of the most recent HA status level check.
+// A result that repeats the current result, ignoring the time, is not set.
+// Returns true if the result was set.
+func (in *CoherenceResourceStatus) SetHALevel(level HALevelStatus) bool {
+	if in.HALevel != nil && in.HALevel.IsRepeatOf(level) {
+		return false
+	}
+	in.HALevel = &level
+	return true
 }
 
 // SetCondition sets the current Status Condition
 func (in *CoherenceResourceStatus) SetCondition(deployment CoherenceResource, c Condition) bool {
 	deployment.GetStatus().DeepCopyInto(in)
 	updated := in.ensureInitialized(deployment)
-	if in.Phase != "" && in.Phase == c.Type {
-		// already at the desired phase
-		return updated
+	if in.Phase == "" || in.Phase != c.Type {
+		// set the requested condition's type as the current phase
+		updated = in.setPhase(c.Type) || updated
 	}
-	// set the requested condition's type as the current phase
-	updated = in.setPhase(c.Type) || updated
-	return updated
+	return in.UpdateStandardConditions(deployment) || updated
 }
 
 // Update the status based on the condition of the StatefulSet status.
@@ -895,6 +1422,10 @@ func (in *CoherenceResourceStatus) Update(deployment *Coherence, sts *appsv1.Sta
 
 		if sts.CurrentRevision == sts.UpdateRevision {
 			// both revisions are the same so the StatefulSet is not updating
+			// and any held Canary upgrade has been completed or reverted
+			if in.Conditions.RemoveCondition(ConditionTypeCanaryFailed) {
+				updated = true
+			}
 			// If the current phase is not Ready check to see whether it should be ready.
 			if in.Phase != ConditionTypeReady && in.Replicas == in.ReadyReplicas && in.Replicas == in.CurrentReplicas {
 				updated = in.setPhase(ConditionTypeReady)
@@ -925,7 +1456,7 @@ func (in *CoherenceResourceStatus) Update(deployment *Coherence, sts *appsv1.Sta
 		}
 	}
 
-	return updated
+	return in.UpdateStandardConditions(deployment) || updated
 }
 
 // UpdateFromJob the status based on the condition of the Job status.
@@ -1024,6 +1555,76 @@ func (in *CoherenceResourceStatus) UpdateFromJob(deployment *CoherenceJob, jobSt
 		}
 	}
 
+	return in.UpdateStandardConditions(deployment) || updated
+}
+
+// IsRolledBack returns true if a failed rolling upgrade of the specified generation has been
+// rolled back, and the resource has not been updated since.
+func (in *CoherenceResourceStatus) IsRolledBack(generation int64) bool {
+	c := in.Conditions.GetCondition(ConditionTypeRolledBack)
+	return c != nil && c.IsTrue() && c.ObservedGeneration == generation
+}
+
+// UpdateStandardConditions sets the status observed generation to the generation of the deployment
+// and updates the standard Available, Progressing, Degraded and ScalingBlocked conditions from the
+// phase, replica counts and other conditions. Unlike the phase conditions, the standard conditions
+// always have a reason and record the observed generation, following the metav1.Condition semantics.
+// The ScalingBlocked condition is set by the scaling process, here it is only added if missing and
+// cleared once the deployment is no longer scaling.
+func (in *CoherenceResourceStatus) UpdateStandardConditions(deployment CoherenceResource) bool {
+	updated := false
+	if generation := deployment.GetGeneration(); in.ObservedGeneration != generation {
+		in.ObservedGeneration = generation
+		updated = true
+	}
+
+	available := Condition{Type: ConditionTypeAvailable, Status: corev1.ConditionFalse, Reason: ReasonReplicasNotReady}
+	switch {
+	case in.Phase == ConditionTypeCompleted:
+		available.Reason = ReasonCompleted
+	case in.Replicas == 0:
+		available.Reason = ReasonStopped
+	case in.ReadyReplicas >= in.Replicas:
+		available.Status = corev1.ConditionTrue
+		available.Reason = ReasonReplicasReady
+	case in.ReadyReplicas > 0 && (in.Phase == ConditionTypeScaling || in.Phase == ConditionTypeRollingUpgrade):
+		// a cluster with ready members is available while it is being scaled or upgraded
+		available.Status = corev1.ConditionTrue
+		available.Reason = ConditionReason(in.Phase)
+	}
+	available.Message = fmt.Sprintf("%d of %d replicas ready", in.ReadyReplicas, in.Replicas)
+
+	progressing := Condition{Type: ConditionTypeProgressing, Status: corev1.ConditionTrue, Reason: ConditionReason(in.Phase)}
+	switch in.Phase {
+	case ConditionTypeReady, ConditionTypeStopped, ConditionTypeCompleted, ConditionTypeFailed:
+		progressing.Status = corev1.ConditionFalse
+	case "":
+		progressing.Reason = ConditionReason(ConditionTypeInitialized)
+	}
+
+	degraded := Condition{Type: ConditionTypeDegraded, Status: corev1.ConditionFalse, Reason: ReasonAsExpected}
+	switch {
+	case in.Phase == ConditionTypeFailed:
+		degraded.Status = corev1.ConditionTrue
+		degraded.Reason = ConditionReason(ConditionTypeFailed)
+	case in.Conditions.IsTrueFor(ConditionTypeServicesEndangered):
+		degraded.Status = corev1.ConditionTrue
+		degraded.Reason = ConditionReason(ConditionTypeServicesEndangered)
+		degraded.Message = in.Conditions.GetCondition(ConditionTypeServicesEndangered).Message
+	}
+
+	blocked := Condition{Type: ConditionTypeScalingBlocked, Status: corev1.ConditionFalse, Reason: ReasonNotBlocked}
+	if c := in.Conditions.GetCondition(ConditionTypeScalingBlocked); c != nil && in.CurrentReplicas != in.Replicas {
+		// still scaling so keep the condition set by the scaling process
+		blocked = *c
+	}
+
+	for _, c := range []Condition{available, progressing, degraded, blocked} {
+		c.ObservedGeneration = in.ObservedGeneration
+		if in.Conditions.SetCondition(c) {
+			updated = true
+		}
+	}
 	return updated
 }
 
@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2020, 2025, Oracle and/or its affiliates.
+ * Copyright (c) 2020, 2026, Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * http://oss.oracle.com/licenses/upl.
  */
@@ -16,8 +16,10 @@ import (
 	"github.com/oracle/coherence-operator/pkg/operator"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 )
 
@@ -463,12 +465,168 @@ func (in *CoherenceResourceSpec) CreateKubernetesResources(d CoherenceResource)
 		res = append(res, in.CreateWKAService(d))
 	}
 
+	// Create the cert-manager Certificates for the SSL enabled endpoints
+	res = append(res, in.Coherence.CreateCertificateResources(d)...)
+
 	// Create the Services for each port (and optionally ServiceMonitors)
 	res = append(res, in.CreateServicesForPort(d)...)
 
+	// Create the NetworkPolicies
+	res = append(res, in.CreateNetworkPolicyResources(d)...)
+
 	return res
 }
 
+// CreateNetworkPolicyResources creates the deployment's ingress and egress NetworkPolicy resources.
+func (in *CoherenceResourceSpec) CreateNetworkPolicyResources(deployment CoherenceResource) []Resource {
+	var res []Resource
+	if ingress, found := in.CreateIngressNetworkPolicy(deployment); found {
+		res = append(res, Resource{Kind: ResourceTypeNetworkPolicy, Name: ingress.GetName(), Spec: ingress})
+	}
+	if egress, found := in.CreateEgressNetworkPolicy(deployment); found {
+		res = append(res, Resource{Kind: ResourceTypeNetworkPolicy, Name: egress.GetName(), Spec: egress})
+	}
+	return res
+}
+
+// CreateIngressNetworkPolicy creates the deployment's ingress NetworkPolicy, returning false
+// if NetworkPolicies are not enabled.
+func (in *CoherenceResourceSpec) CreateIngressNetworkPolicy(deployment CoherenceResource) (*networkingv1.NetworkPolicy, bool) {
+	spec := in.Network.GetNetworkPolicy()
+	if !spec.IsEnabled() {
+		return nil, false
+	}
+
+	tcp := corev1.ProtocolTCP
+	udp := corev1.ProtocolUDP
+	lp, adjust := in.Coherence.GetLocalPorts()
+	clusterPort := intstr.FromInt32(DefaultClusterPort)
+	localPort := intstr.FromInt32(lp)
+	var endPort *int32
+	if end, err := strconv.ParseInt(adjust, 10, 32); err == nil && int32(end) > lp {
+		endPort = ptr.To(int32(end))
+	}
+
+	// cluster members may connect to the Coherence cluster, unicast and IP monitor ports
+	clusterRule := networkingv1.NetworkPolicyIngressRule{
+		From: in.createClusterMemberPeers(deployment),
+		Ports: []networkingv1.NetworkPolicyPort{
+			{Protocol: &tcp, Port: ptr.To(intstr.FromInt32(7))},
+			{Protocol: &tcp, Port: &clusterPort},
+			{Protocol: &udp, Port: &clusterPort},
+			{Protocol: &tcp, Port: &localPort, EndPort: endPort},
+			{Protocol: &udp, Port: &localPort, EndPort: endPort},
+		},
+	}
+
+	// the Operator connects to the health port, and the management port to obtain autoscaling metrics
+	operatorRule := networkingv1.NetworkPolicyIngressRule{
+		From:  []networkingv1.NetworkPolicyPeer{spec.GetOperatorPeer()},
+		Ports: []networkingv1.NetworkPolicyPort{{Protocol: &tcp, Port: ptr.To(intstr.FromInt32(in.GetHealthPort()))}},
+	}
+	if in.Coherence.IsManagementEnabled() {
+		operatorRule.Ports = append(operatorRule.Ports, networkingv1.NetworkPolicyPort{
+			Protocol: &tcp,
+			Port:     ptr.To(intstr.FromInt32(in.Coherence.GetManagementPort())),
+		})
+	}
+
+	rules := []networkingv1.NetworkPolicyIngressRule{clusterRule, operatorRule}
+
+	// each additional port may be accessed from its configured sources
+	for _, port := range in.Ports {
+		protocol := port.GetProtocol()
+		rules = append(rules, networkingv1.NetworkPolicyIngressRule{
+			From: spec.GetPortSources(port.Name),
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &protocol, Port: ptr.To(intstr.FromInt32(port.GetPort(deployment)))},
+			},
+		})
+	}
+
+	rules = append(rules, spec.Ingress...)
+
+	np := in.createNetworkPolicy(deployment, NetworkPolicyIngressSuffix, networkingv1.PolicyTypeIngress)
+	np.Spec.Ingress = rules
+	return np, true
+}
+
+// CreateEgressNetworkPolicy creates the deployment's egress NetworkPolicy, returning false
+// if NetworkPolicies or egress NetworkPolicies are not enabled.
+func (in *CoherenceResourceSpec) CreateEgressNetworkPolicy(deployment CoherenceResource) (*networkingv1.NetworkPolicy, bool) {
+	spec := in.Network.GetNetworkPolicy()
+	if !spec.IsEgressEnabled() {
+		return nil, false
+	}
+
+	tcp := corev1.ProtocolTCP
+	udp := corev1.ProtocolUDP
+	dns := intstr.FromInt32(PortDNS)
+
+	rules := []networkingv1.NetworkPolicyEgressRule{
+		// cluster members may connect to any port on other members of the same cluster,
+		// the ports are restricted by the other members' ingress policies
+		{To: in.createClusterMemberPeers(deployment)},
+		// members call the Operator's REST endpoint to obtain site and rack information
+		{
+			To:    []networkingv1.NetworkPolicyPeer{spec.GetOperatorPeer()},
+			Ports: []networkingv1.NetworkPolicyPort{{Protocol: &tcp, Port: ptr.To(intstr.FromString(PortNameOperator))}},
+		},
+		// DNS lookups are required for WKA and Operator service names
+		{
+			Ports: []networkingv1.NetworkPolicyPort{{Protocol: &udp, Port: &dns}, {Protocol: &tcp, Port: &dns}},
+		},
+	}
+
+	rules = append(rules, spec.Egress...)
+
+	np := in.createNetworkPolicy(deployment, NetworkPolicyEgressSuffix, networkingv1.PolicyTypeEgress)
+	np.Spec.Egress = rules
+	return np, true
+}
+
+// createNetworkPolicy creates a NetworkPolicy of the specified type selecting the deployment's Pods.
+func (in *CoherenceResourceSpec) createNetworkPolicy(deployment CoherenceResource, suffix string, policyType networkingv1.PolicyType) *networkingv1.NetworkPolicy {
+	labels := deployment.CreateGlobalLabels()
+	labels[LabelComponent] = LabelComponentNetworkPolicy
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   deployment.GetNamespace(),
+			Name:        deployment.GetName() + suffix,
+			Labels:      labels,
+			Annotations: deployment.CreateGlobalAnnotations(),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: in.CreatePodSelectorLabels(deployment)},
+			PolicyTypes: []networkingv1.PolicyType{policyType},
+		},
+	}
+}
+
+// createClusterMemberPeers returns the NetworkPolicyPeers that select the members of the deployment's
+// Coherence cluster. If the deployment uses WKA from a deployment in a different namespace, the
+// cluster members in that namespace are also selected.
+func (in *CoherenceResourceSpec) createClusterMemberPeers(deployment CoherenceResource) []networkingv1.NetworkPolicyPeer {
+	selector := metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			LabelCoherenceCluster: deployment.GetCoherenceClusterName(),
+			LabelComponent:        LabelComponentCoherencePod,
+		},
+	}
+
+	peers := []networkingv1.NetworkPolicyPeer{{PodSelector: &selector}}
+	if in.Coherence != nil && in.Coherence.WKA != nil {
+		if ns := in.Coherence.WKA.Namespace; ns != "" && ns != deployment.GetNamespace() {
+			peers = append(peers, networkingv1.NetworkPolicyPeer{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{LabelNamespaceName: ns}},
+				PodSelector:       selector.DeepCopy(),
+			})
+		}
+	}
+	return peers
+}
+
 // FindPortServiceNames returns a map of the port names to the names of the Service used to expose those ports.
 func (in *CoherenceResourceSpec) FindPortServiceNames(deployment CoherenceResource) map[string]string {
 	m := make(map[string]string)
@@ -499,7 +657,7 @@ func (in *CoherenceResourceSpec) FindPortServiceName(name string, deployment Coh
 	return "", false
 }
 
-// CreateServicesForPort creates the Services for each port (and optionally ServiceMonitors)
+// CreateServicesForPort creates the Services for each port (and optionally ServiceMonitors, Ingresses and HTTPRoutes)
 func (in *CoherenceResourceSpec) CreateServicesForPort(deployment CoherenceResource) []Resource {
 	var resources []Resource
 
@@ -507,7 +665,7 @@ func (in *CoherenceResourceSpec) CreateServicesForPort(deployment CoherenceResou
 		return resources
 	}
 
-	// Create the Service and ServiceMonitor for each port
+	// Create the Service, ServiceMonitor, Ingress and HTTPRoute for each port
 	for _, p := range in.Ports {
 		service := p.CreateService(deployment)
 		if service != nil {
@@ -525,11 +683,37 @@ func (in *CoherenceResourceSpec) CreateServicesForPort(deployment CoherenceResou
 				Spec: sm,
 			})
 		}
+		ingress := p.CreateIngress(deployment)
+		if ingress != nil {
+			resources = append(resources, Resource{
+				Kind: ResourceTypeIngress,
+				Name: ingress.GetName(),
+				Spec: ingress,
+			})
+		}
+		route := p.CreateHTTPRoute(deployment)
+		if route != nil {
+			resources = append(resources, Resource{
+				Kind: ResourceTypeHTTPRoute,
+				Name: route.GetName(),
+				Spec: route,
+			})
+		}
 	}
 
 	return resources
 }
 
+// hasPort returns true if the deployment's "ports" list contains a port with the specified name.
+func (in *CoherenceResourceSpec) hasPort(name string) bool {
+	for _, port := range in.Ports {
+		if port.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 // CreatePodSelectorLabels creates the selector that can be used to match this deployment's Pods,
 // for example by Services or StatefulSets.
 func (in *CoherenceResourceSpec) CreatePodSelectorLabels(deployment CoherenceResource) map[string]string {
@@ -834,6 +1018,44 @@ func (in *CoherenceResourceSpec) replaceEnvVar(c *corev1.Container, name string,
 	}
 }
 
+// GetRestartOnChangeSecrets returns the sorted names of the Secrets mounted into the Pods
+// that should cause the Pods to be restarted when their content changes.
+func (in *CoherenceResourceSpec) GetRestartOnChangeSecrets() []string {
+	if in == nil {
+		return nil
+	}
+	names := sets.New[string]()
+	for _, sv := range in.SecretVolumes {
+		if sv.IsRestartOnChange() {
+			names.Insert(sv.Name)
+		}
+	}
+	if in.Coherence != nil {
+		if name, ok := in.Coherence.Management.GetRestartOnChangeSecret(); ok {
+			names.Insert(name)
+		}
+		if name, ok := in.Coherence.Metrics.GetRestartOnChangeSecret(); ok {
+			names.Insert(name)
+		}
+	}
+	return sets.List(names)
+}
+
+// GetRestartOnChangeConfigMaps returns the sorted names of the ConfigMaps mounted into the Pods
+// that should cause the Pods to be restarted when their content changes.
+func (in *CoherenceResourceSpec) GetRestartOnChangeConfigMaps() []string {
+	if in == nil {
+		return nil
+	}
+	names := sets.New[string]()
+	for _, cmv := range in.ConfigMapVolumes {
+		if cmv.IsRestartOnChange() {
+			names.Insert(cmv.Name)
+		}
+	}
+	return sets.List(names)
+}
+
 func (in *CoherenceResourceSpec) GetImagePullSecrets() []corev1.LocalObjectReference {
 	var secrets []corev1.LocalObjectReference
 
@@ -0,0 +1,220 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package v1
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultRestoreTimeout is the default maximum time allowed for a restore to complete.
+	DefaultRestoreTimeout = 30 * time.Minute
+
+	RestorePhasePending    RestorePhase = "Pending"
+	RestorePhaseInProgress RestorePhase = "InProgress"
+	RestorePhaseCompleted  RestorePhase = "Completed"
+	RestorePhaseFailed     RestorePhase = "Failed"
+
+	// ConditionTypeSnapshotRetrieved is the condition recording the outcome of retrieving an archived snapshot.
+	ConditionTypeSnapshotRetrieved ConditionType = "SnapshotRetrieved"
+	// ConditionTypeServicesSuspended is the condition recording the outcome of suspending services.
+	ConditionTypeServicesSuspended ConditionType = "ServicesSuspended"
+	// ConditionTypeSnapshotRecovered is the condition recording the outcome of recovering the snapshot.
+	ConditionTypeSnapshotRecovered ConditionType = "SnapshotRecovered"
+	// ConditionTypeServicesResumed is the condition recording the outcome of resuming services.
+	ConditionTypeServicesResumed ConditionType = "ServicesResumed"
+
+	RestoreReasonInProgress ConditionReason = "InProgress"
+	RestoreReasonSucceeded  ConditionReason = "Succeeded"
+	RestoreReasonSkipped    ConditionReason = "Skipped"
+	RestoreReasonFailed     ConditionReason = "Failed"
+)
+
+// RestoreSteps is the ordered list of condition types for the steps of a restore.
+var RestoreSteps = []ConditionType{
+	ConditionTypeSnapshotRetrieved,
+	ConditionTypeServicesSuspended,
+	ConditionTypeSnapshotRecovered,
+	ConditionTypeServicesResumed,
+}
+
+// RestorePhase is the phase of a restore.
+type RestorePhase string
+
+// IsFinished returns true if the phase is either Completed or Failed.
+func (in RestorePhase) IsFinished() bool {
+	return in == RestorePhaseCompleted || in == RestorePhaseFailed
+}
+
+// ----- CoherenceRestore type ----------------------------------------------------------------------
+
+// CoherenceRestore is the schema for the CoherenceRestore API. A CoherenceRestore
+// recovers one or more services in a Coherence deployment from a Coherence persistence
+// snapshot. The services are suspended, the snapshot is recovered and then the services
+// are resumed. The outcome of each step is recorded in the status conditions.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=coherencerestore,scope=Namespaced,shortName=cohrestore,categories=coherence
+// +kubebuilder:printcolumn:name="Coherence",type="string",JSONPath=".spec.coherence",description="The name of the Coherence resource to restore"
+// +kubebuilder:printcolumn:name="Snapshot",type="string",JSONPath=".spec.snapshotName",description="The name of the Coherence snapshot to restore"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="The status of this restore"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type CoherenceRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CoherenceRestoreSpec   `json:"spec,omitempty"`
+	Status CoherenceRestoreStatus `json:"status,omitempty"`
+}
+
+// ----- CoherenceRestoreList type ------------------------------------------------------------------
+
+// +kubebuilder:object:root=true
+
+// CoherenceRestoreList is a list of CoherenceRestore resources.
+type CoherenceRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CoherenceRestore `json:"items"`
+}
+
+// ----- CoherenceRestoreSpec type ------------------------------------------------------------------
+
+// CoherenceRestoreSpec defines the desired state of a CoherenceRestore.
+// Changing the spec of a failed, or completed, restore will cause the restore to be retried.
+// +k8s:openapi-gen=true
+type CoherenceRestoreSpec struct {
+	// Coherence is the name of the Coherence resource, in the same namespace as
+	// this CoherenceRestore, that will be restored.
+	// The Coherence resource must have Coherence management over REST enabled.
+	// +kubebuilder:validation:MinLength=1
+	Coherence string `json:"coherence"`
+	// SnapshotName is the name of the Coherence snapshot to recover.
+	// +kubebuilder:validation:MinLength=1
+	SnapshotName string `json:"snapshotName"`
+	// Services is the list of names of the persistent Coherence services to recover.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Services []string `json:"services"`
+	// Archived, when true, retrieves the snapshot from the archiver configured for each
+	// service before the snapshot is recovered.
+	// +optional
+	Archived *bool `json:"archived,omitempty"`
+	// Timeout is the maximum amount of time allowed for the restore to complete,
+	// after which the restore is marked as failed. The default is 30 minutes.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// IsArchived returns true if the snapshot should be retrieved from the archiver before it is recovered.
+func (in *CoherenceRestoreSpec) IsArchived() bool {
+	return in != nil && notNilBool(in.Archived)
+}
+
+// GetTimeout returns the maximum amount of time allowed for the restore to complete.
+func (in *CoherenceRestoreSpec) GetTimeout() time.Duration {
+	if in == nil || in.Timeout == nil || in.Timeout.Duration <= 0 {
+		return DefaultRestoreTimeout
+	}
+	return in.Timeout.Duration
+}
+
+// ----- CoherenceRestoreStatus type ----------------------------------------------------------------
+
+// CoherenceRestoreStatus defines the observed state of a CoherenceRestore.
+type CoherenceRestoreStatus struct {
+	// Phase is a high-level summary of the restore.
+	// There are four possible phase values:
+	//
+	// Pending:    The restore has not yet been started.
+	// InProgress: The restore is in progress.
+	// Completed:  The restore completed successfully.
+	// Failed:     The restore failed.
+	//
+	// +optional
+	Phase RestorePhase `json:"phase,omitempty"`
+	// Message is a human-readable message describing the current state of the restore.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// StartTime is the time the restore was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the restore completed or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// ObservedGeneration is the CoherenceRestore generation that this status applies to.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions records the outcome of each step of the restore, one of
+	// SnapshotRetrieved, ServicesSuspended, SnapshotRecovered or ServicesResumed.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions Conditions `json:"conditions,omitempty"`
+	// ResumedServices is the list of services resumed by the Operator after the snapshot was recovered.
+	// +listType=atomic
+	// +optional
+	ResumedServices []string `json:"resumedServices,omitempty"`
+	// SuspendedServices is the list of services left suspended after the snapshot was recovered
+	// because auto-resume is disabled for them in the Coherence resource.
+	// +listType=atomic
+	// +optional
+	SuspendedServices []string `json:"suspendedServices,omitempty"`
+}
+
+// GetStep returns the condition type of the first step of the restore that has not
+// yet succeeded or been skipped, or an empty string if all steps are finished.
+func (in *CoherenceRestoreStatus) GetStep() ConditionType {
+	if in == nil {
+		return ""
+	}
+	for _, step := range RestoreSteps {
+		c := in.Conditions.GetCondition(step)
+		if c == nil || !c.IsTrue() {
+			return step
+		}
+	}
+	return ""
+}
+
+// SetStepInProgress records that a step of the restore is in progress.
+func (in *CoherenceRestoreStatus) SetStepInProgress(step ConditionType, message string) {
+	in.setStep(step, corev1.ConditionFalse, RestoreReasonInProgress, message)
+}
+
+// SetStepSucceeded records that a step of the restore succeeded.
+func (in *CoherenceRestoreStatus) SetStepSucceeded(step ConditionType, message string) {
+	in.setStep(step, corev1.ConditionTrue, RestoreReasonSucceeded, message)
+}
+
+// SetStepSkipped records that a step of the restore was not required.
+func (in *CoherenceRestoreStatus) SetStepSkipped(step ConditionType, message string) {
+	in.setStep(step, corev1.ConditionTrue, RestoreReasonSkipped, message)
+}
+
+// SetStepFailed records that a step of the restore failed, which fails the whole restore.
+func (in *CoherenceRestoreStatus) SetStepFailed(step ConditionType, message string) {
+	in.setStep(step, corev1.ConditionFalse, RestoreReasonFailed, message)
+	in.Phase = RestorePhaseFailed
+	in.Message = message
+}
+
+func (in *CoherenceRestoreStatus) setStep(step ConditionType, status corev1.ConditionStatus, reason ConditionReason, message string) {
+	if in == nil {
+		return
+	}
+	in.Conditions.SetCondition(Condition{
+		Type:    step,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCoherenceRestoreDefaultTimeout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	spec := coh.CoherenceRestoreSpec{}
+	g.Expect(spec.GetTimeout()).To(Equal(coh.DefaultRestoreTimeout))
+
+	spec.Timeout = &metav1.Duration{Duration: time.Minute}
+	g.Expect(spec.GetTimeout()).To(Equal(time.Minute))
+}
+
+func TestCoherenceRestoreStatusSteps(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	status := coh.CoherenceRestoreStatus{Phase: coh.RestorePhaseInProgress}
+	g.Expect(status.GetStep()).To(Equal(coh.ConditionTypeSnapshotRetrieved))
+
+	status.SetStepSkipped(coh.ConditionTypeSnapshotRetrieved, "not archived")
+	g.Expect(status.GetStep()).To(Equal(coh.ConditionTypeServicesSuspended))
+
+	status.SetStepInProgress(coh.ConditionTypeServicesSuspended, "suspending")
+	g.Expect(status.GetStep()).To(Equal(coh.ConditionTypeServicesSuspended))
+
+	status.SetStepSucceeded(coh.ConditionTypeServicesSuspended, "suspended")
+	g.Expect(status.GetStep()).To(Equal(coh.ConditionTypeSnapshotRecovered))
+
+	status.SetStepFailed(coh.ConditionTypeSnapshotRecovered, "recover failed")
+	g.Expect(status.GetStep()).To(Equal(coh.ConditionTypeSnapshotRecovered))
+	g.Expect(status.Phase).To(Equal(coh.RestorePhaseFailed))
+	g.Expect(status.Message).To(Equal("recover failed"))
+
+	c := status.Conditions.GetCondition(coh.ConditionTypeSnapshotRecovered)
+	g.Expect(c).NotTo(BeNil())
+	g.Expect(c.IsFalse()).To(BeTrue())
+	g.Expect(c.Reason).To(Equal(coh.RestoreReasonFailed))
+}
+
+func TestIsAutoResumeService(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	spec := coh.CoherenceStatefulSetResourceSpec{}
+	g.Expect(spec.IsAutoResumeService("foo")).To(BeTrue())
+
+	spec.ResumeServicesOnStartup = boolPtr(false)
+	g.Expect(spec.IsAutoResumeService("foo")).To(BeFalse())
+
+	spec.AutoResumeServices = map[string]bool{"foo": true, "bar": false}
+	g.Expect(spec.IsAutoResumeService("foo")).To(BeTrue())
+	g.Expect(spec.IsAutoResumeService("bar")).To(BeFalse())
+	g.Expect(spec.IsAutoResumeService("baz")).To(BeFalse())
+
+	spec.ResumeServicesOnStartup = nil
+	g.Expect(spec.IsAutoResumeService("bar")).To(BeFalse())
+	g.Expect(spec.IsAutoResumeService("baz")).To(BeTrue())
+}
@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package v1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultSnapshotTimeout is the default maximum time allowed for a snapshot to complete.
+	DefaultSnapshotTimeout = 30 * time.Minute
+	// DefaultSnapshotRetentionCount is the default number of scheduled snapshots to keep.
+	DefaultSnapshotRetentionCount = 7
+
+	SnapshotPhasePending    SnapshotPhase = "Pending"
+	SnapshotPhaseInProgress SnapshotPhase = "InProgress"
+	SnapshotPhaseArchiving  SnapshotPhase = "Archiving"
+	SnapshotPhaseCompleted  SnapshotPhase = "Completed"
+	SnapshotPhaseFailed     SnapshotPhase = "Failed"
+)
+
+// SnapshotPhase is the phase of a snapshot, or of a single service within a snapshot.
+type SnapshotPhase string
+
+// IsFinished returns true if the phase is either Completed or Failed.
+func (in SnapshotPhase) IsFinished() bool {
+	return in == SnapshotPhaseCompleted || in == SnapshotPhaseFailed
+}
+
+// ----- CoherenceSnapshot type ---------------------------------------------------------------------
+
+// CoherenceSnapshot is the schema for the CoherenceSnapshot API. A CoherenceSnapshot
+// creates a Coherence persistence snapshot of one or more services in a Coherence
+// deployment and reports the progress of the snapshot for each service.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=coherencesnapshot,scope=Namespaced,shortName=cohsnap,categories=coherence
+// +kubebuilder:printcolumn:name="Coherence",type="string",JSONPath=".spec.coherence",description="The name of the Coherence resource to snapshot"
+// +kubebuilder:printcolumn:name="Snapshot",type="string",JSONPath=".status.snapshotName",description="The name of the Coherence snapshot"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="The status of this snapshot"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type CoherenceSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CoherenceSnapshotSpec   `json:"spec,omitempty"`
+	Status CoherenceSnapshotStatus `json:"status,omitempty"`
+}
+
+// GetSnapshotName returns the name of the Coherence snapshot to create.
+func (in *CoherenceSnapshot) GetSnapshotName() string {
+	if in == nil {
+		return ""
+	}
+	if in.Spec.SnapshotName != nil && *in.Spec.SnapshotName != "" {
+		return *in.Spec.SnapshotName
+	}
+	return in.Name
+}
+
+// ----- CoherenceSnapshotList type -----------------------------------------------------------------
+
+// +kubebuilder:object:root=true
+
+// CoherenceSnapshotList is a list of CoherenceSnapshot resources.
+type CoherenceSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CoherenceSnapshot `json:"items"`
+}
+
+// ----- CoherenceSnapshotSpec type -----------------------------------------------------------------
+
+// CoherenceSnapshotSpec defines the desired state of a CoherenceSnapshot.
+// +k8s:openapi-gen=true
+type CoherenceSnapshotSpec struct {
+	// Coherence is the name of the Coherence resource, in the same namespace as
+	// this CoherenceSnapshot, that the snapshot will be taken from.
+	// The Coherence resource must have Coherence management over REST enabled.
+	// +kubebuilder:validation:MinLength=1
+	Coherence string `json:"coherence"`
+	// Services is the list of names of the persistent Coherence services to snapshot.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Services []string `json:"services"`
+	// SnapshotName is the name of the Coherence snapshot to create.
+	// If not set, the name of the CoherenceSnapshot resource is used.
+	// +optional
+	SnapshotName *string `json:"snapshotName,omitempty"`
+	// Archive, when true, archives the snapshot using the archiver configured for each
+	// service after the snapshot has been created.
+	// +optional
+	Archive *bool `json:"archive,omitempty"`
+	// RemoveOnDelete, when true, removes the snapshot, and any archived copy of the snapshot,
+	// from the Coherence cluster when this CoherenceSnapshot is deleted.
+	// +optional
+	RemoveOnDelete *bool `json:"removeOnDelete,omitempty"`
+	// Timeout is the maximum amount of time allowed for the snapshot of all services to complete,
+	// after which any incomplete services are marked as failed. The default is 30 minutes.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// IsArchive returns true if the snapshot should be archived.
+func (in *CoherenceSnapshotSpec) IsArchive() bool {
+	return in != nil && notNilBool(in.Archive)
+}
+
+// IsRemoveOnDelete returns true if the snapshot should be removed when the CoherenceSnapshot is deleted.
+func (in *CoherenceSnapshotSpec) IsRemoveOnDelete() bool {
+	return in != nil && notNilBool(in.RemoveOnDelete)
+}
+
+// GetTimeout returns the maximum amount of time allowed for the snapshot to complete.
+func (in *CoherenceSnapshotSpec) GetTimeout() time.Duration {
+	if in == nil || in.Timeout == nil || in.Timeout.Duration <= 0 {
+		return DefaultSnapshotTimeout
+	}
+	return in.Timeout.Duration
+}
+
+// ----- CoherenceSnapshotStatus type ---------------------------------------------------------------
+
+// CoherenceSnapshotStatus defines the observed state of a CoherenceSnapshot.
+type CoherenceSnapshotStatus struct {
+	// Phase is a high-level summary of the snapshot.
+	// There are four possible phase values:
+	//
+	// Pending:    The snapshot has not yet been started.
+	// InProgress: The snapshot of one or more services is in progress.
+	// Completed:  The snapshot of all services completed successfully.
+	// Failed:     The snapshot of one or more services failed.
+	//
+	// +optional
+	Phase SnapshotPhase `json:"phase,omitempty"`
+	// SnapshotName is the name of the Coherence snapshot.
+	// +optional
+	SnapshotName string `json:"snapshotName,omitempty"`
+	// Message is a human-readable message describing the current state of the snapshot.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// StartTime is the time the snapshot was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the snapshot completed or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// ObservedGeneration is the CoherenceSnapshot generation that this status applies to.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Services is the status of the snapshot of each service.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Services []SnapshotServiceStatus `json:"services,omitempty"`
+}
+
+// GetServiceStatus returns the status for the specified service, or nil if there is no status for the service.
+func (in *CoherenceSnapshotStatus) GetServiceStatus(name string) *SnapshotServiceStatus {
+	if in == nil {
+		return nil
+	}
+	for i := range in.Services {
+		if in.Services[i].Name == name {
+			return &in.Services[i]
+		}
+	}
+	return nil
+}
+
+// UpdatePhase sets the overall phase from the phases of the individual services.
+// The snapshot is in progress while any service is not finished, failed if any service failed,
+// otherwise completed.
+func (in *CoherenceSnapshotStatus) UpdatePhase() {
+	if in == nil {
+		return
+	}
+	phase := SnapshotPhaseCompleted
+	for _, s := range in.Services {
+		switch {
+		case !s.Phase.IsFinished():
+			in.Phase = SnapshotPhaseInProgress
+			return
+		case s.Phase == SnapshotPhaseFailed:
+			phase = SnapshotPhaseFailed
+		}
+	}
+	in.Phase = phase
+}
+
+// SnapshotServiceStatus is the status of the snapshot of a single Coherence service.
+type SnapshotServiceStatus struct {
+	// Name is the name of the Coherence service.
+	Name string `json:"name"`
+	// Phase is the phase of the snapshot for this service, one of
+	// Pending, InProgress, Archiving, Completed or Failed.
+	// +optional
+	Phase SnapshotPhase `json:"phase,omitempty"`
+	// Archived is true if the snapshot has been archived for this service.
+	// +optional
+	Archived bool `json:"archived,omitempty"`
+	// Message is a human-readable message describing the state of the snapshot for this service.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// LastUpdateTime is the time the status of this service was last updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+	// Snapshots is the list of snapshots that exist for this service in the Coherence cluster.
+	// +listType=atomic
+	// +optional
+	Snapshots []string `json:"snapshots,omitempty"`
+}
+
+// SetPhase updates the phase and message of the service status.
+func (in *SnapshotServiceStatus) SetPhase(phase SnapshotPhase, message string) {
+	if in == nil {
+		return
+	}
+	now := metav1.Now()
+	in.Phase = phase
+	in.Message = message
+	in.LastUpdateTime = &now
+}
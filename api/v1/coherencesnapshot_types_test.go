@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestCoherenceSnapshotDefaultSnapshotName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	snapshot := &coh.CoherenceSnapshot{ObjectMeta: metav1.ObjectMeta{Name: "test"}}
+	g.Expect(snapshot.GetSnapshotName()).To(Equal("test"))
+
+	snapshot.Spec.SnapshotName = ptr.To("backup")
+	g.Expect(snapshot.GetSnapshotName()).To(Equal("backup"))
+}
+
+func TestCoherenceSnapshotDefaultTimeout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	spec := coh.CoherenceSnapshotSpec{}
+	g.Expect(spec.GetTimeout()).To(Equal(coh.DefaultSnapshotTimeout))
+
+	spec.Timeout = &metav1.Duration{Duration: time.Minute}
+	g.Expect(spec.GetTimeout()).To(Equal(time.Minute))
+}
+
+func TestCoherenceSnapshotStatusUpdatePhase(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	status := coh.CoherenceSnapshotStatus{
+		Services: []coh.SnapshotServiceStatus{
+			{Name: "one", Phase: coh.SnapshotPhaseCompleted},
+			{Name: "two", Phase: coh.SnapshotPhaseArchiving},
+		},
+	}
+	status.UpdatePhase()
+	g.Expect(status.Phase).To(Equal(coh.SnapshotPhaseInProgress))
+
+	status.GetServiceStatus("two").SetPhase(coh.SnapshotPhaseCompleted, "done")
+	status.UpdatePhase()
+	g.Expect(status.Phase).To(Equal(coh.SnapshotPhaseCompleted))
+
+	status.GetServiceStatus("one").SetPhase(coh.SnapshotPhaseFailed, "failed")
+	status.UpdatePhase()
+	g.Expect(status.Phase).To(Equal(coh.SnapshotPhaseFailed))
+	g.Expect(status.GetServiceStatus("three")).To(BeNil())
+}
+
+func TestSnapshotScheduleRetention(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	sched := &coh.SnapshotScheduleSpec{Schedule: "0 2 * * *"}
+	g.Expect(sched.GetRetentionCount()).To(Equal(coh.DefaultSnapshotRetentionCount))
+	g.Expect(sched.GetRetentionAge()).To(BeZero())
+
+	sched.RetentionAge = &metav1.Duration{Duration: 48 * time.Hour}
+	g.Expect(sched.GetRetentionCount()).To(BeZero())
+	g.Expect(sched.GetRetentionAge()).To(Equal(48 * time.Hour))
+
+	sched.RetentionCount = ptr.To(int32(3))
+	g.Expect(sched.GetRetentionCount()).To(Equal(3))
+}
+
+func TestSnapshotScheduleParseSchedule(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	sched := &coh.SnapshotScheduleSpec{Schedule: "0 2 * * *", TimeZone: ptr.To("Europe/London")}
+	schedule, loc, err := sched.ParseSchedule()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(loc.String()).To(Equal("Europe/London"))
+
+	start := time.Date(2026, time.January, 10, 12, 0, 0, 0, loc)
+	g.Expect(schedule.Next(start)).To(Equal(time.Date(2026, time.January, 11, 2, 0, 0, 0, loc)))
+
+	sched.TimeZone = ptr.To("Not/AZone")
+	_, _, err = sched.ParseSchedule()
+	g.Expect(err).To(HaveOccurred())
+}
@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2020, 2022, Oracle and/or its affiliates.
+ * Copyright (c) 2020, 2026, Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * http://oss.oracle.com/licenses/upl.
  */
@@ -48,6 +48,9 @@ type Condition struct {
 	Reason             ConditionReason        `json:"reason,omitempty"`
 	Message            string                 `json:"message,omitempty"`
 	LastTransitionTime metav1.Time            `json:"lastTransitionTime,omitempty"`
+	// ObservedGeneration is the generation of the resource that the condition was set from.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 // IsTrue Condition whether the condition status is "True".
@@ -132,7 +135,8 @@ func (in *Conditions) SetCondition(newCond Condition) bool {
 			}
 			changed := condition.Status != newCond.Status ||
 				condition.Reason != newCond.Reason ||
-				condition.Message != newCond.Message
+				condition.Message != newCond.Message ||
+				condition.ObservedGeneration != newCond.ObservedGeneration
 			(*in)[i] = newCond
 			return changed
 		}
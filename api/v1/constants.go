@@ -1,12 +1,16 @@
 /*
- * Copyright (c) 2020, 2025, Oracle and/or its affiliates.
+ * Copyright (c) 2020, 2026, Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * http://oss.oracle.com/licenses/upl.
  */
 
 package v1
 
-import "github.com/oracle/coherence-operator/pkg/operator"
+import (
+	"time"
+
+	"github.com/oracle/coherence-operator/pkg/operator"
+)
 
 const (
 	// DefaultReplicas is the default number of replicas that will be created for a deployment if no value is specified in the spec
@@ -39,6 +43,8 @@ const (
 	LabelVersion = "version"
 	// LabelCoherenceHash is the label for the Coherence resource spec hash
 	LabelCoherenceHash = "coherence-hash"
+	// LabelCanaryRevision is the label containing the StatefulSet revision verified by a Canary upgrade verification Job
+	LabelCanaryRevision = "coherenceCanaryRevision"
 
 	// LabelComponentCoherenceStatefulSet is the component label value for a Coherence StatefulSet resource
 	LabelComponentCoherenceStatefulSet = "coherence"
@@ -52,6 +58,24 @@ const (
 	LabelComponentPortService = "coherence-service"
 	// LabelComponentPortServiceMonitor is the component label value for a Coherence ServiceMonitor
 	LabelComponentPortServiceMonitor = "coherence-service-monitor"
+	// LabelComponentPodDisruptionBudget is the component label value for a Coherence PodDisruptionBudget
+	LabelComponentPodDisruptionBudget = "coherence-pdb"
+	// LabelComponentNetworkPolicy is the component label value for a Coherence NetworkPolicy
+	LabelComponentNetworkPolicy = "coherence-network-policy"
+	// LabelComponentPortIngress is the component label value for a Coherence Ingress
+	LabelComponentPortIngress = "coherence-ingress"
+	// LabelComponentPortHTTPRoute is the component label value for a Coherence HTTPRoute
+	LabelComponentPortHTTPRoute = "coherence-http-route"
+	// LabelComponentCertificate is the component label value for a Coherence cert-manager Certificate
+	LabelComponentCertificate = "coherence-certificate"
+	// LabelOperatorName is the label used to select the Operator Pods
+	LabelOperatorName = "app.kubernetes.io/name"
+	// LabelOperatorNameValue is the value of the label used to select the Operator Pods
+	LabelOperatorNameValue = "coherence-operator"
+	// LabelNamespaceName is the label Kubernetes applies to a Namespace containing its name
+	LabelNamespaceName = "kubernetes.io/metadata.name"
+	// LabelComponentScheduledSnapshot is the component label value for a scheduled CoherenceSnapshot
+	LabelComponentScheduledSnapshot = "coherence-scheduled-snapshot"
 	// LabelComponentWKA is the component label value for a Coherence WKA Service
 	LabelComponentWKA = "coherenceWkaService"
 	// LabelCoherenceStore is the component label value for a Coherence state storage Secret
@@ -64,6 +88,15 @@ const (
 	AnnotationFeatureSuspend = "com.oracle.coherence.operator/feature.suspend"
 	// AnnotationOperatorVersion is the Operator version annotations
 	AnnotationOperatorVersion = "com.oracle.coherence.operator/version"
+	// AnnotationConfigHash is the Pod annotation containing the hash of the content of the
+	// Secrets and ConfigMaps mounted into the Pods.
+	AnnotationConfigHash = "com.oracle.coherence.operator/config-hash"
+	// AnnotationRolledBackRevision is the StatefulSet annotation containing the revision of the failed
+	// rolling upgrade that was rolled back.
+	AnnotationRolledBackRevision = "com.oracle.coherence.operator/rolled-back-revision"
+	// AnnotationPrePausePartition is the StatefulSet annotation containing the rolling upgrade partition
+	// from before rolling upgrades were paused, which is restored when they are resumed.
+	AnnotationPrePausePartition = "com.oracle.coherence.operator/pre-pause-partition"
 	// AnnotationIstioConfig is the Istio config annotation applied to Pods.
 	AnnotationIstioConfig = "proxy.istio.io/config"
 	// DefaultIstioConfigAnnotationValue is the default for the istio config annotation.
@@ -112,6 +145,15 @@ const (
 	// VolumeMountPathMetricsCerts is the metrics certs volume mount
 	VolumeMountPathMetricsCerts = VolumeMountRoot + "/coherence/certs/metrics"
 
+	// CertificateSecretSuffix is the suffix added to the name of a cert-manager Certificate Secret
+	CertificateSecretSuffix = "-tls"
+	// CertificatePasswordFile is the name of the file containing the cert-manager key store password
+	CertificatePasswordFile = "keystore-password"
+	// KeyStoreTypePKCS12 is the PKCS12 Java key store type
+	KeyStoreTypePKCS12 = "PKCS12"
+	// KeyStoreTypeJKS is the JKS Java key store type
+	KeyStoreTypeJKS = "JKS"
+
 	// RunnerCommand is the start command for the runner
 	RunnerCommand = VolumeMountPathUtils + "/runner"
 
@@ -131,6 +173,11 @@ const (
 	// ServiceMonitorGroupVersion is the Prometheus ServiceMonitor resource API group version
 	ServiceMonitorGroupVersion = ServiceMonitorGroup + "/" + ServiceMonitorVersion
 
+	// HTTPRouteKind is the Gateway API HTTPRoute resource API Kind
+	HTTPRouteKind = "HTTPRoute"
+	// CertificateKind is the cert-manager Certificate resource API Kind
+	CertificateKind = "Certificate"
+
 	// PortNameCoherence is the name of the Coherence port
 	PortNameCoherence = "coherence"
 	// PortNameCoherenceLocal is the name of the Coherence local port
@@ -145,6 +192,15 @@ const (
 	PortNameManagement = "management"
 	// PortNameMetrics is the name of the Coherence metrics port
 	PortNameMetrics = "metrics"
+	// PortNameOperator is the name of the Operator REST port
+	PortNameOperator = "operator"
+	// PortDNS is the port used for DNS lookups
+	PortDNS int32 = 53
+
+	// NetworkPolicyIngressSuffix is the suffix appended to a deployment name to give the ingress NetworkPolicy name
+	NetworkPolicyIngressSuffix = "-ingress"
+	// NetworkPolicyEgressSuffix is the suffix appended to a deployment name to give the egress NetworkPolicy name
+	NetworkPolicyEgressSuffix = "-egress"
 
 	// AppProtocolTcp is the appProtocol value for ports that use tcp
 	AppProtocolTcp = "tcp"
@@ -194,6 +250,34 @@ const (
 	// OperatorConfigDirSuffix is the suffix to append to the utils directory to locate the Operator config directory.
 	OperatorConfigDirSuffix = "/config"
 
+	// DefaultAutoscaleTolerance is the default percentage that an autoscaling metric can differ from its target
+	DefaultAutoscaleTolerance int32 = 10
+	// DefaultAutoscaleInterval is the default interval between evaluations of autoscaling metrics
+	DefaultAutoscaleInterval = time.Minute
+	// DefaultAutoscaleScaleUpCooldown is the default minimum time between scaling and a subsequent scale up
+	DefaultAutoscaleScaleUpCooldown = 3 * time.Minute
+	// DefaultAutoscaleScaleDownCooldown is the default minimum time between scaling and a subsequent scale down
+	DefaultAutoscaleScaleDownCooldown = 10 * time.Minute
+	// DefaultMemberStatusInterval is the default interval between updates of the members in the Coherence resource status
+	DefaultMemberStatusInterval = time.Minute
+	// MinMemberStatusInterval is the minimum interval between updates of the members in the Coherence resource status
+	MinMemberStatusInterval = 10 * time.Second
+	// DefaultCanaryPause is the default time to wait after the canary Pods of a Canary upgrade are ready before verifying them
+	DefaultCanaryPause = time.Minute
+	// DefaultRollbackReadyTimeout is the default time an upgraded Pod has to become ready before a rolling upgrade is rolled back
+	DefaultRollbackReadyTimeout = 10 * time.Minute
+	// DefaultApprovalTimeout is the default timeout for a request to an approval webhook
+	DefaultApprovalTimeout = 10 * time.Second
+	// DefaultApprovalRetryAfter is the default time to wait before requesting approval again after a step is deferred
+	DefaultApprovalRetryAfter = time.Minute
+
+	// SnapshotArchiverS3 is the id of the S3 snapshot archiver configured in the Operator's Coherence override file
+	SnapshotArchiverS3 = "coherence-operator-s3"
+	// DefaultArchiverAccessKey is the default key of the access key in the snapshot archiver credentials Secret
+	DefaultArchiverAccessKey = "accessKey"
+	// DefaultArchiverSecretKey is the default key of the secret key in the snapshot archiver credentials Secret
+	DefaultArchiverSecretKey = "secretKey"
+
 	// FileNamePattern is a formatting pattern for a directory separator and file name
 	FileNamePattern = "%s%c%s"
 	// ArgumentFileNamePattern is a formatting pattern for a JDK argument fle name: directory separator and file name
@@ -249,6 +333,13 @@ const (
 	EnvVarCohPersistenceMode       = "COHERENCE_DISTRIBUTED_PERSISTENCE_MODE"
 	EnvVarCohPersistenceDir        = "COHERENCE_DISTRIBUTED_PERSISTENCE_BASE_DIR"
 	EnvVarCohSnapshotDir           = "COHERENCE_DISTRIBUTED_PERSISTENCE_SNAPSHOT_DIR"
+	EnvVarCohArchiverEndpoint      = "COHERENCE_ARCHIVER_S3_ENDPOINT"
+	EnvVarCohArchiverBucket        = "COHERENCE_ARCHIVER_S3_BUCKET"
+	EnvVarCohArchiverPrefix        = "COHERENCE_ARCHIVER_S3_PREFIX"
+	EnvVarCohArchiverRegion        = "COHERENCE_ARCHIVER_S3_REGION"
+	EnvVarCohArchiverPathStyle     = "COHERENCE_ARCHIVER_S3_PATH_STYLE"
+	EnvVarCohArchiverAccessKey     = "COHERENCE_ARCHIVER_S3_ACCESS_KEY"
+	EnvVarCohArchiverSecretKey     = "COHERENCE_ARCHIVER_S3_SECRET_KEY"
 	EnvVarCohTracingRatio          = "COHERENCE_TRACING_RATIO"
 	EnvVarCohMgmtPrefix            = "COHERENCE_MANAGEMENT"
 	EnvVarCohMetricsPrefix         = "COHERENCE_METRICS"
@@ -333,11 +424,16 @@ const (
 	SysPropCoherenceTTL                     = "coherence.ttl"
 	SysPropCoherenceWKA                     = "coherence.wka"
 
-	SysPropOperatorForceExit     = "coherence.operator.force.exit"
-	SysPropOperatorHealthEnabled = "coherence.operator.health.enabled"
-	SysPropOperatorHealthPort    = "coherence.operator.health.port"
-	SysPropOperatorIdentity      = "coherence.operator.identity"
-	SysPropOperatorOverride      = "coherence.k8s.override"
+	SysPropOperatorArchiverEndpoint  = "coherence.operator.archiver.s3.endpoint"
+	SysPropOperatorArchiverBucket    = "coherence.operator.archiver.s3.bucket"
+	SysPropOperatorArchiverPrefix    = "coherence.operator.archiver.s3.prefix"
+	SysPropOperatorArchiverRegion    = "coherence.operator.archiver.s3.region"
+	SysPropOperatorArchiverPathStyle = "coherence.operator.archiver.s3.path.style"
+	SysPropOperatorForceExit         = "coherence.operator.force.exit"
+	SysPropOperatorHealthEnabled     = "coherence.operator.health.enabled"
+	SysPropOperatorHealthPort        = "coherence.operator.health.port"
+	SysPropOperatorIdentity          = "coherence.operator.identity"
+	SysPropOperatorOverride          = "coherence.k8s.override"
 
 	SysPropSpringLoaderMain = "loader.main"
 	SysPropSpringLoaderPath = "loader.path"
@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package v1_test
+
+import (
+	"strconv"
+	"testing"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func createTestCertManagerSpec() *coh.SSLCertManagerSpec {
+	return &coh.SSLCertManagerSpec{
+		IssuerRef: cmmeta.IssuerReference{Name: "ca-issuer", Kind: "ClusterIssuer"},
+		PasswordSecretRef: cmmeta.SecretKeySelector{
+			LocalObjectReference: cmmeta.LocalObjectReference{Name: "keystore-pass"},
+			Key:                  "password",
+		},
+	}
+}
+
+func TestCreateCertificateNotCreatedWithoutCertManager(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Coherence: &coh.CoherenceSpec{
+			Management: &coh.PortSpecWithSSL{
+				Enabled: ptr.To(true),
+				SSL:     &coh.SSLSpec{Enabled: ptr.To(true), Secrets: ptr.To("ssl-secret")},
+			},
+		},
+	})
+
+	res := assertResourceCreation(t, deployment)
+	g.Expect(res.GetResourcesOfKind(coh.ResourceTypeCertificate)).To(BeEmpty())
+}
+
+func TestCreateCertificateNotCreatedWhenSSLDisabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Coherence: &coh.CoherenceSpec{
+			Management: &coh.PortSpecWithSSL{
+				Enabled: ptr.To(true),
+				SSL:     &coh.SSLSpec{Enabled: ptr.To(false), CertManager: createTestCertManagerSpec()},
+			},
+		},
+	})
+
+	res := assertResourceCreation(t, deployment)
+	g.Expect(res.GetResourcesOfKind(coh.ResourceTypeCertificate)).To(BeEmpty())
+}
+
+func TestCreateCertificateForManagement(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cm := createTestCertManagerSpec()
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Coherence: &coh.CoherenceSpec{
+			Management: &coh.PortSpecWithSSL{
+				Enabled: ptr.To(true),
+				SSL:     &coh.SSLSpec{Enabled: ptr.To(true), CertManager: cm},
+			},
+		},
+	})
+
+	res := assertResourceCreation(t, deployment)
+	name := deployment.GetName() + "-management-tls"
+	r, found := res.GetResource(coh.ResourceTypeCertificate, name)
+	g.Expect(found).To(BeTrue())
+
+	cert := r.Spec.(*certmanagerv1.Certificate)
+	g.Expect(cert.GetNamespace()).To(Equal(deployment.GetNamespace()))
+	g.Expect(cert.GetLabels()[coh.LabelComponent]).To(Equal(coh.LabelComponentCertificate))
+	g.Expect(cert.Spec.SecretName).To(Equal(name))
+	g.Expect(cert.Spec.IssuerRef).To(Equal(cm.IssuerRef))
+	g.Expect(cert.Spec.DNSNames).To(Equal([]string{
+		deployment.GetName() + "-management",
+		deployment.GetName() + "-management." + deployment.GetNamespace(),
+		deployment.GetName() + "-management." + deployment.GetNamespace() + ".svc",
+		"*." + deployment.GetHeadlessServiceName() + "." + deployment.GetNamespace() + ".svc",
+	}))
+	g.Expect(cert.Spec.Keystores.JKS).To(BeNil())
+	g.Expect(cert.Spec.Keystores.PKCS12).To(Equal(&certmanagerv1.PKCS12Keystore{
+		Create:            true,
+		Profile:           certmanagerv1.Modern2023PKCS12Profile,
+		PasswordSecretRef: cm.PasswordSecretRef,
+	}))
+}
+
+func TestCreateCertificateForMetricsWithJKS(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cm := createTestCertManagerSpec()
+	cm.KeyStoreType = ptr.To(coh.KeyStoreTypeJKS)
+	cm.SecretName = ptr.To("metrics-certs")
+	cm.DNSNames = []string{"metrics.example.com"}
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Coherence: &coh.CoherenceSpec{
+			Metrics: &coh.PortSpecWithSSL{
+				Enabled: ptr.To(true),
+				SSL:     &coh.SSLSpec{Enabled: ptr.To(true), CertManager: cm},
+			},
+		},
+	})
+
+	res := assertResourceCreation(t, deployment)
+	r, found := res.GetResource(coh.ResourceTypeCertificate, "metrics-certs")
+	g.Expect(found).To(BeTrue())
+
+	cert := r.Spec.(*certmanagerv1.Certificate)
+	g.Expect(cert.Spec.SecretName).To(Equal("metrics-certs"))
+	g.Expect(cert.Spec.DNSNames).To(Equal([]string{"metrics.example.com"}))
+	g.Expect(cert.Spec.Keystores.PKCS12).To(BeNil())
+	g.Expect(cert.Spec.Keystores.JKS).To(Equal(&certmanagerv1.JKSKeystore{Create: true, PasswordSecretRef: cm.PasswordSecretRef}))
+}
+
+func TestCreateStatefulSetWithCoherenceManagementWithCertManager(t *testing.T) {
+	cm := createTestCertManagerSpec()
+	spec := coh.CoherenceResourceSpec{
+		Coherence: &coh.CoherenceSpec{
+			Management: &coh.PortSpecWithSSL{
+				Enabled: ptr.To(true),
+				SSL: &coh.SSLSpec{
+					Enabled:           ptr.To(true),
+					RequireClientCert: ptr.To(true),
+					CertManager:       cm,
+				},
+			},
+		},
+	}
+
+	deployment := createTestDeployment(spec)
+	stsExpected := createMinimalExpectedStatefulSet(deployment)
+	addEnvVarsToAll(stsExpected,
+		corev1.EnvVar{Name: "COHERENCE_MANAGEMENT_SSL_CERTS", Value: coh.VolumeMountPathManagementCerts},
+		corev1.EnvVar{Name: "COHERENCE_MANAGEMENT_ENABLED", Value: "true"},
+		corev1.EnvVar{Name: "COHERENCE_MANAGEMENT_PORT", Value: strconv.FormatInt(int64(coh.DefaultManagementPort), 10)},
+		corev1.EnvVar{Name: "COHERENCE_MANAGEMENT_SSL_ENABLED", Value: "true"},
+		corev1.EnvVar{Name: "COHERENCE_MANAGEMENT_SSL_KEYSTORE", Value: certmanagerv1.PKCS12SecretKey},
+		corev1.EnvVar{Name: "COHERENCE_MANAGEMENT_SSL_KEYSTORE_PASSWORD_FILE", Value: coh.CertificatePasswordFile},
+		corev1.EnvVar{Name: "COHERENCE_MANAGEMENT_SSL_KEY_PASSWORD_FILE", Value: coh.CertificatePasswordFile},
+		corev1.EnvVar{Name: "COHERENCE_MANAGEMENT_SSL_KEYSTORE_TYPE", Value: coh.KeyStoreTypePKCS12},
+		corev1.EnvVar{Name: "COHERENCE_MANAGEMENT_SSL_TRUSTSTORE", Value: certmanagerv1.PKCS12TruststoreKey},
+		corev1.EnvVar{Name: "COHERENCE_MANAGEMENT_SSL_TRUSTSTORE_PASSWORD_FILE", Value: coh.CertificatePasswordFile},
+		corev1.EnvVar{Name: "COHERENCE_MANAGEMENT_SSL_TRUSTSTORE_TYPE", Value: coh.KeyStoreTypePKCS12},
+		corev1.EnvVar{Name: "COHERENCE_MANAGEMENT_SSL_REQUIRE_CLIENT_CERT", Value: "true"})
+
+	stsExpected.Spec.Template.Spec.Containers[0].VolumeMounts = append(stsExpected.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      coh.VolumeNameManagementSSL,
+		MountPath: coh.VolumeMountPathManagementCerts,
+		ReadOnly:  true,
+	})
+	stsExpected.Spec.Template.Spec.Volumes = append(stsExpected.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: coh.VolumeNameManagementSSL,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				DefaultMode: int32Ptr(0777),
+				Sources: []corev1.VolumeProjection{
+					{
+						Secret: &corev1.SecretProjection{
+							LocalObjectReference: corev1.LocalObjectReference{Name: deployment.GetName() + "-management-tls"},
+						},
+					},
+					{
+						Secret: &corev1.SecretProjection{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "keystore-pass"},
+							Items:                []corev1.KeyToPath{{Key: "password", Path: coh.CertificatePasswordFile}},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	assertStatefulSetCreation(t, deployment, stsExpected)
+}
+
+func TestCertManagerSSLEnvVarsWithOverridesAndNoTrustStore(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cm := createTestCertManagerSpec()
+	cm.KeyStoreType = ptr.To(coh.KeyStoreTypeJKS)
+	cm.TrustStore = ptr.To(false)
+	ssl := &coh.SSLSpec{Enabled: ptr.To(true), KeyPasswordFile: ptr.To("key-pass.txt"), CertManager: cm}
+
+	envVars := ssl.CreateEnvVars("TEST", "/certs")
+	g.Expect(envVars).To(ConsistOf(
+		corev1.EnvVar{Name: "TEST_SSL_ENABLED", Value: "true"},
+		corev1.EnvVar{Name: "TEST_SSL_CERTS", Value: "/certs"},
+		corev1.EnvVar{Name: "TEST_SSL_KEYSTORE", Value: certmanagerv1.JKSSecretKey},
+		corev1.EnvVar{Name: "TEST_SSL_KEYSTORE_PASSWORD_FILE", Value: coh.CertificatePasswordFile},
+		corev1.EnvVar{Name: "TEST_SSL_KEY_PASSWORD_FILE", Value: "key-pass.txt"},
+		corev1.EnvVar{Name: "TEST_SSL_KEYSTORE_TYPE", Value: coh.KeyStoreTypeJKS},
+	))
+	// the original spec is not modified
+	g.Expect(ssl.KeyStore).To(BeNil())
+}
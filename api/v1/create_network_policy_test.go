@@ -0,0 +1,185 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package v1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+func TestCreateNetworkPolicyNotEnabledByDefault(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{})
+	_, found := deployment.Spec.CreateIngressNetworkPolicy(deployment)
+	g.Expect(found).To(BeFalse())
+	_, found = deployment.Spec.CreateEgressNetworkPolicy(deployment)
+	g.Expect(found).To(BeFalse())
+
+	res := assertResourceCreation(t, deployment)
+	g.Expect(res.GetResourcesOfKind(coh.ResourceTypeNetworkPolicy)).To(BeEmpty())
+}
+
+func TestCreateNetworkPolicyWhenDisabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Network: &coh.NetworkSpec{NetworkPolicy: &coh.NetworkPolicySpec{Enabled: ptr.To(false)}},
+	})
+	res := assertResourceCreation(t, deployment)
+	g.Expect(res.GetResourcesOfKind(coh.ResourceTypeNetworkPolicy)).To(BeEmpty())
+}
+
+func TestCreateNetworkPolicyIsInDeploymentResources(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Network: &coh.NetworkSpec{NetworkPolicy: &coh.NetworkPolicySpec{}},
+	})
+	res := assertResourceCreation(t, deployment)
+	_, found := res.GetResource(coh.ResourceTypeNetworkPolicy, deployment.GetName()+coh.NetworkPolicyIngressSuffix)
+	g.Expect(found).To(BeTrue())
+	_, found = res.GetResource(coh.ResourceTypeNetworkPolicy, deployment.GetName()+coh.NetworkPolicyEgressSuffix)
+	g.Expect(found).To(BeTrue())
+}
+
+func TestCreateIngressNetworkPolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tcp := corev1.ProtocolTCP
+	udp := corev1.ProtocolUDP
+	source := networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"clients": "true"}},
+	}
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Ports: []coh.NamedPortSpec{
+			{Name: "extend", Port: 20000},
+			{Name: "metrics"},
+		},
+		Network: &coh.NetworkSpec{
+			NetworkPolicy: &coh.NetworkPolicySpec{
+				From:  []networkingv1.NetworkPolicyPeer{source},
+				Ports: []coh.NetworkPolicyPortSpec{{Name: "metrics"}},
+			},
+		},
+	})
+
+	np, found := deployment.Spec.CreateIngressNetworkPolicy(deployment)
+	g.Expect(found).To(BeTrue())
+	g.Expect(np.GetName()).To(Equal(deployment.GetName() + coh.NetworkPolicyIngressSuffix))
+	g.Expect(np.GetLabels()[coh.LabelComponent]).To(Equal(coh.LabelComponentNetworkPolicy))
+	g.Expect(np.Spec.PodSelector.MatchLabels).To(Equal(deployment.Spec.CreatePodSelectorLabels(deployment)))
+	g.Expect(np.Spec.PolicyTypes).To(Equal([]networkingv1.PolicyType{networkingv1.PolicyTypeIngress}))
+	g.Expect(np.Spec.Egress).To(BeEmpty())
+	g.Expect(len(np.Spec.Ingress)).To(Equal(4))
+
+	// cluster members
+	cluster := np.Spec.Ingress[0]
+	g.Expect(cluster.From).To(HaveLen(1))
+	g.Expect(cluster.From[0].PodSelector.MatchLabels).To(Equal(map[string]string{
+		coh.LabelCoherenceCluster: deployment.GetCoherenceClusterName(),
+		coh.LabelComponent:        coh.LabelComponentCoherencePod,
+	}))
+	g.Expect(cluster.Ports).To(ContainElement(networkingv1.NetworkPolicyPort{
+		Protocol: &udp,
+		Port:     ptr.To(intstr.FromInt32(coh.DefaultUnicastPort)),
+		EndPort:  ptr.To(coh.DefaultUnicastPortAdjust),
+	}))
+
+	// the Operator
+	operator := np.Spec.Ingress[1]
+	g.Expect(operator.From).To(HaveLen(1))
+	g.Expect(operator.From[0].PodSelector.MatchLabels).To(Equal(map[string]string{coh.LabelOperatorName: coh.LabelOperatorNameValue}))
+	g.Expect(operator.Ports).To(Equal([]networkingv1.NetworkPolicyPort{
+		{Protocol: &tcp, Port: ptr.To(intstr.FromInt32(coh.DefaultHealthPort))},
+	}))
+
+	// the extend port uses the default sources
+	g.Expect(np.Spec.Ingress[2].From).To(Equal([]networkingv1.NetworkPolicyPeer{source}))
+	g.Expect(np.Spec.Ingress[2].Ports).To(Equal([]networkingv1.NetworkPolicyPort{
+		{Protocol: &tcp, Port: ptr.To(intstr.FromInt32(20000))},
+	}))
+
+	// the metrics port is overridden to allow any source
+	g.Expect(np.Spec.Ingress[3].From).To(BeEmpty())
+	g.Expect(np.Spec.Ingress[3].Ports).To(Equal([]networkingv1.NetworkPolicyPort{
+		{Protocol: &tcp, Port: ptr.To(intstr.FromInt32(coh.DefaultMetricsPort))},
+	}))
+}
+
+func TestCreateIngressNetworkPolicyWithManagementEnabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Coherence: &coh.CoherenceSpec{Management: &coh.PortSpecWithSSL{Enabled: ptr.To(true)}},
+		Network:   &coh.NetworkSpec{NetworkPolicy: &coh.NetworkPolicySpec{}},
+	})
+
+	np, found := deployment.Spec.CreateIngressNetworkPolicy(deployment)
+	g.Expect(found).To(BeTrue())
+	tcp := corev1.ProtocolTCP
+	g.Expect(np.Spec.Ingress[1].Ports).To(ContainElement(networkingv1.NetworkPolicyPort{
+		Protocol: &tcp,
+		Port:     ptr.To(intstr.FromInt32(coh.DefaultManagementPort)),
+	}))
+}
+
+func TestCreateEgressNetworkPolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	extra := networkingv1.NetworkPolicyEgressRule{
+		To: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8"}}},
+	}
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Coherence: &coh.CoherenceSpec{WKA: &coh.CoherenceWKASpec{Deployment: "storage", Namespace: "data"}},
+		Network: &coh.NetworkSpec{
+			NetworkPolicy: &coh.NetworkPolicySpec{Egress: []networkingv1.NetworkPolicyEgressRule{extra}},
+		},
+	})
+
+	np, found := deployment.Spec.CreateEgressNetworkPolicy(deployment)
+	g.Expect(found).To(BeTrue())
+	g.Expect(np.GetName()).To(Equal(deployment.GetName() + coh.NetworkPolicyEgressSuffix))
+	g.Expect(np.Spec.PolicyTypes).To(Equal([]networkingv1.PolicyType{networkingv1.PolicyTypeEgress}))
+	g.Expect(len(np.Spec.Egress)).To(Equal(4))
+
+	// cluster members in this namespace and the WKA namespace
+	cluster := np.Spec.Egress[0]
+	g.Expect(cluster.Ports).To(BeEmpty())
+	g.Expect(cluster.To).To(HaveLen(2))
+	g.Expect(cluster.To[1].NamespaceSelector.MatchLabels).To(Equal(map[string]string{coh.LabelNamespaceName: "data"}))
+
+	// the Operator REST port
+	g.Expect(np.Spec.Egress[1].Ports[0].Port).To(Equal(ptr.To(intstr.FromString(coh.PortNameOperator))))
+
+	// DNS
+	g.Expect(np.Spec.Egress[2].To).To(BeEmpty())
+	g.Expect(np.Spec.Egress[2].Ports).To(HaveLen(2))
+
+	g.Expect(np.Spec.Egress[3]).To(Equal(extra))
+}
+
+func TestCreateEgressNetworkPolicyWhenEgressDisabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Network: &coh.NetworkSpec{NetworkPolicy: &coh.NetworkPolicySpec{EgressEnabled: ptr.To(false)}},
+	})
+
+	_, found := deployment.Spec.CreateIngressNetworkPolicy(deployment)
+	g.Expect(found).To(BeTrue())
+	_, found = deployment.Spec.CreateEgressNetworkPolicy(deployment)
+	g.Expect(found).To(BeFalse())
+}
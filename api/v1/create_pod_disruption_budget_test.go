@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package v1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+func TestCreatePodDisruptionBudgetForMinimalDeployment(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestCoherenceDeployment(coh.CoherenceStatefulSetResourceSpec{})
+	pdb, found := deployment.Spec.CreatePodDisruptionBudget(deployment)
+	g.Expect(found).To(BeTrue())
+	g.Expect(pdb.GetName()).To(Equal(deployment.GetName()))
+	g.Expect(pdb.GetLabels()[coh.LabelComponent]).To(Equal(coh.LabelComponentPodDisruptionBudget))
+	g.Expect(pdb.Spec.Selector.MatchLabels).To(Equal(deployment.Spec.CreatePodSelectorLabels(deployment)))
+	g.Expect(pdb.Spec.MinAvailable).To(BeNil())
+	// storage enabled with the default backup count of one
+	g.Expect(pdb.Spec.MaxUnavailable).To(Equal(ptr.To(intstr.FromInt32(1))))
+}
+
+func TestCreatePodDisruptionBudgetIsInDeploymentResources(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestCoherenceDeployment(coh.CoherenceStatefulSetResourceSpec{})
+	res := assertResourceCreation(t, deployment)
+	_, found := res.GetResource(coh.ResourceTypePodDisruptionBudget, deployment.GetName())
+	g.Expect(found).To(BeTrue())
+}
+
+func TestCreatePodDisruptionBudgetWhenDisabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestCoherenceDeployment(coh.CoherenceStatefulSetResourceSpec{
+		PodDisruptionBudget: &coh.PodDisruptionBudgetSpec{Enabled: ptr.To(false)},
+	})
+	_, found := deployment.Spec.CreatePodDisruptionBudget(deployment)
+	g.Expect(found).To(BeFalse())
+
+	res := assertResourceCreation(t, deployment)
+	g.Expect(res.GetResourcesOfKind(coh.ResourceTypePodDisruptionBudget)).To(BeEmpty())
+}
+
+func TestCreatePodDisruptionBudgetWithZeroReplicas(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{Replicas: ptr.To(int32(0))})
+	_, found := deployment.Spec.CreatePodDisruptionBudget(deployment)
+	g.Expect(found).To(BeFalse())
+}
+
+func TestCreatePodDisruptionBudgetWithBackupCount(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestCoherenceDeployment(coh.CoherenceStatefulSetResourceSpec{
+		CoherenceResourceSpec: coh.CoherenceResourceSpec{Replicas: ptr.To(int32(6))},
+		PodDisruptionBudget:   &coh.PodDisruptionBudgetSpec{BackupCount: ptr.To(int32(2))},
+	})
+	pdb, found := deployment.Spec.CreatePodDisruptionBudget(deployment)
+	g.Expect(found).To(BeTrue())
+	g.Expect(pdb.Spec.MaxUnavailable).To(Equal(ptr.To(intstr.FromInt32(2))))
+}
+
+func TestCreatePodDisruptionBudgetWithBackupCountGreaterThanReplicas(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestCoherenceDeployment(coh.CoherenceStatefulSetResourceSpec{
+		CoherenceResourceSpec: coh.CoherenceResourceSpec{Replicas: ptr.To(int32(2))},
+		PodDisruptionBudget:   &coh.PodDisruptionBudgetSpec{BackupCount: ptr.To(int32(3))},
+	})
+	pdb, found := deployment.Spec.CreatePodDisruptionBudget(deployment)
+	g.Expect(found).To(BeTrue())
+	g.Expect(pdb.Spec.MaxUnavailable).To(Equal(ptr.To(intstr.FromInt32(1))))
+}
+
+func TestCreatePodDisruptionBudgetForStorageDisabledDeployment(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Replicas:  ptr.To(int32(6)),
+		Coherence: &coh.CoherenceSpec{StorageEnabled: ptr.To(false)},
+	})
+	pdb, found := deployment.Spec.CreatePodDisruptionBudget(deployment)
+	g.Expect(found).To(BeTrue())
+	g.Expect(pdb.Spec.MaxUnavailable).To(Equal(ptr.To(intstr.FromInt32(3))))
+}
+
+func TestCreatePodDisruptionBudgetWithMinAvailable(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	minAvailable := intstr.FromString("50%")
+	policy := policyv1.AlwaysAllow
+	deployment := createTestCoherenceDeployment(coh.CoherenceStatefulSetResourceSpec{
+		PodDisruptionBudget: &coh.PodDisruptionBudgetSpec{
+			MinAvailable:               &minAvailable,
+			UnhealthyPodEvictionPolicy: &policy,
+		},
+	})
+	pdb, found := deployment.Spec.CreatePodDisruptionBudget(deployment)
+	g.Expect(found).To(BeTrue())
+	g.Expect(pdb.Spec.MinAvailable).To(Equal(&minAvailable))
+	g.Expect(pdb.Spec.MaxUnavailable).To(BeNil())
+	g.Expect(pdb.Spec.UnhealthyPodEvictionPolicy).To(Equal(&policy))
+}
+
+func TestCreatePodDisruptionBudgetWithMaxUnavailable(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	maxUnavailable := intstr.FromInt32(2)
+	deployment := createTestCoherenceDeployment(coh.CoherenceStatefulSetResourceSpec{
+		PodDisruptionBudget: &coh.PodDisruptionBudgetSpec{MaxUnavailable: &maxUnavailable},
+	})
+	pdb, found := deployment.Spec.CreatePodDisruptionBudget(deployment)
+	g.Expect(found).To(BeTrue())
+	g.Expect(pdb.Spec.MaxUnavailable).To(Equal(&maxUnavailable))
+}
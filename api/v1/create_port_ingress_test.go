@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package v1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestCreatePortIngressNotCreatedByDefault(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Ports: []coh.NamedPortSpec{{Name: "rest", Port: 8080}},
+	})
+
+	res := assertResourceCreation(t, deployment)
+	g.Expect(res.GetResourcesOfKind(coh.ResourceTypeIngress)).To(BeEmpty())
+	g.Expect(res.GetResourcesOfKind(coh.ResourceTypeHTTPRoute)).To(BeEmpty())
+}
+
+func TestCreatePortIngressWhenDisabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Ports: []coh.NamedPortSpec{
+			{
+				Name:      "rest",
+				Port:      8080,
+				Ingress:   &coh.PortIngressSpec{Enabled: ptr.To(false)},
+				HTTPRoute: &coh.PortHTTPRouteSpec{Enabled: ptr.To(false)},
+			},
+		},
+	})
+
+	res := assertResourceCreation(t, deployment)
+	g.Expect(res.GetResourcesOfKind(coh.ResourceTypeIngress)).To(BeEmpty())
+	g.Expect(res.GetResourcesOfKind(coh.ResourceTypeHTTPRoute)).To(BeEmpty())
+}
+
+func TestCreatePortIngress(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tls := networkingv1.IngressTLS{Hosts: []string{"rest.example.com"}, SecretName: "rest-tls"}
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Ports: []coh.NamedPortSpec{
+			{
+				Name: "rest",
+				Port: 8080,
+				Ingress: &coh.PortIngressSpec{
+					IngressClassName: ptr.To("nginx"),
+					Annotations:      map[string]string{"nginx.ingress.kubernetes.io/ssl-redirect": "true"},
+					Labels:           map[string]string{"one": "1"},
+					Hosts:            []string{"rest.example.com", "api.example.com"},
+					Path:             ptr.To("/api"),
+					TLS:              []networkingv1.IngressTLS{tls},
+				},
+			},
+		},
+	})
+
+	res := assertResourceCreation(t, deployment)
+	svcName := deployment.GetName() + "-rest"
+	r, found := res.GetResource(coh.ResourceTypeIngress, svcName)
+	g.Expect(found).To(BeTrue())
+
+	ingress := r.Spec.(*networkingv1.Ingress)
+	g.Expect(ingress.GetNamespace()).To(Equal(deployment.GetNamespace()))
+	g.Expect(ingress.GetLabels()[coh.LabelComponent]).To(Equal(coh.LabelComponentPortIngress))
+	g.Expect(ingress.GetLabels()[coh.LabelPort]).To(Equal("rest"))
+	g.Expect(ingress.GetLabels()["one"]).To(Equal("1"))
+	g.Expect(ingress.GetAnnotations()["nginx.ingress.kubernetes.io/ssl-redirect"]).To(Equal("true"))
+	g.Expect(ingress.Spec.IngressClassName).To(Equal(ptr.To("nginx")))
+	g.Expect(ingress.Spec.TLS).To(Equal([]networkingv1.IngressTLS{tls}))
+	g.Expect(ingress.Spec.Rules).To(HaveLen(2))
+	g.Expect(ingress.Spec.Rules[0].Host).To(Equal("rest.example.com"))
+	g.Expect(ingress.Spec.Rules[1].Host).To(Equal("api.example.com"))
+
+	path := ingress.Spec.Rules[0].HTTP.Paths[0]
+	g.Expect(path.Path).To(Equal("/api"))
+	g.Expect(path.PathType).To(Equal(ptr.To(networkingv1.PathTypePrefix)))
+	g.Expect(path.Backend.Service.Name).To(Equal(svcName))
+	g.Expect(path.Backend.Service.Port.Number).To(Equal(int32(8080)))
+}
+
+func TestCreatePortIngressWithoutHosts(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Ports: []coh.NamedPortSpec{
+			{
+				Name:    "management",
+				Service: &coh.ServiceSpec{Name: ptr.To("mgmt"), Port: ptr.To(int32(80))},
+				Ingress: &coh.PortIngressSpec{Name: ptr.To("mgmt-ingress")},
+			},
+		},
+	})
+
+	ingress := deployment.Spec.Ports[0].CreateIngress(deployment)
+	g.Expect(ingress).NotTo(BeNil())
+	g.Expect(ingress.GetName()).To(Equal("mgmt-ingress"))
+	g.Expect(ingress.Spec.Rules).To(HaveLen(1))
+	g.Expect(ingress.Spec.Rules[0].Host).To(BeEmpty())
+
+	path := ingress.Spec.Rules[0].HTTP.Paths[0]
+	g.Expect(path.Path).To(Equal("/"))
+	g.Expect(path.Backend.Service.Name).To(Equal("mgmt"))
+	g.Expect(path.Backend.Service.Port.Number).To(Equal(int32(80)))
+}
+
+func TestCreatePortHTTPRoute(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	parent := gatewayv1.ParentReference{Name: "gateway", SectionName: ptr.To(gatewayv1.SectionName("https"))}
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Ports: []coh.NamedPortSpec{
+			{
+				Name: "rest",
+				Port: 8080,
+				HTTPRoute: &coh.PortHTTPRouteSpec{
+					ParentRefs: []gatewayv1.ParentReference{parent},
+					Hostnames:  []gatewayv1.Hostname{"rest.example.com"},
+				},
+			},
+		},
+	})
+
+	res := assertResourceCreation(t, deployment)
+	svcName := deployment.GetName() + "-rest"
+	r, found := res.GetResource(coh.ResourceTypeHTTPRoute, svcName)
+	g.Expect(found).To(BeTrue())
+
+	route := r.Spec.(*gatewayv1.HTTPRoute)
+	g.Expect(route.GetLabels()[coh.LabelComponent]).To(Equal(coh.LabelComponentPortHTTPRoute))
+	g.Expect(route.GetLabels()[coh.LabelPort]).To(Equal("rest"))
+	g.Expect(route.Spec.ParentRefs).To(Equal([]gatewayv1.ParentReference{parent}))
+	g.Expect(route.Spec.Hostnames).To(Equal([]gatewayv1.Hostname{"rest.example.com"}))
+	g.Expect(route.Spec.Rules).To(HaveLen(1))
+
+	rule := route.Spec.Rules[0]
+	g.Expect(rule.Matches).To(Equal([]gatewayv1.HTTPRouteMatch{
+		{Path: &gatewayv1.HTTPPathMatch{Type: ptr.To(gatewayv1.PathMatchPathPrefix), Value: ptr.To("/")}},
+	}))
+	g.Expect(rule.BackendRefs).To(HaveLen(1))
+	g.Expect(rule.BackendRefs[0].Name).To(Equal(gatewayv1.ObjectName(svcName)))
+	g.Expect(rule.BackendRefs[0].Port).To(Equal(ptr.To(gatewayv1.PortNumber(8080))))
+}
+
+func TestCreatePortIngressWhenServiceDisabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{
+		Ports: []coh.NamedPortSpec{
+			{
+				Name:      "rest",
+				Port:      8080,
+				Service:   &coh.ServiceSpec{Enabled: ptr.To(false)},
+				Ingress:   &coh.PortIngressSpec{},
+				HTTPRoute: &coh.PortHTTPRouteSpec{ParentRefs: []gatewayv1.ParentReference{{Name: "gateway"}}},
+			},
+		},
+	})
+
+	g.Expect(deployment.Spec.Ports[0].CreateIngress(deployment)).To(BeNil())
+	g.Expect(deployment.Spec.Ports[0].CreateHTTPRoute(deployment)).To(BeNil())
+}
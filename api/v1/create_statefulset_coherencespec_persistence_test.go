@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2020, 2025, Oracle and/or its affiliates.
+ * Copyright (c) 2020, 2026, Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * http://oss.oracle.com/licenses/upl.
  */
@@ -498,6 +498,53 @@ func TestCreateStatefulSetWithPersistenceAndSnapshotPVC(t *testing.T) {
 	assertStatefulSetCreation(t, deployment, stsExpected)
 }
 
+func TestCreateStatefulSetWithPersistenceArchiver(t *testing.T) {
+	spec := coh.CoherenceResourceSpec{
+		Coherence: &coh.CoherenceSpec{
+			Persistence: &coh.PersistenceSpec{
+				Archiver: &coh.SnapshotArchiverSpec{
+					Endpoint:          "http://minio:9000",
+					Bucket:            "snapshots",
+					Prefix:            ptr.To("test"),
+					CredentialsSecret: "minio-credentials",
+					SecretKey:         ptr.To("password"),
+				},
+			},
+		},
+	}
+
+	// Create the test deployment
+	deployment := createTestDeployment(spec)
+	// Create expected StatefulSet
+	stsExpected := createMinimalExpectedStatefulSet(deployment)
+	addEnvVarsToAll(stsExpected,
+		corev1.EnvVar{Name: coh.EnvVarCohArchiverEndpoint, Value: "http://minio:9000"},
+		corev1.EnvVar{Name: coh.EnvVarCohArchiverBucket, Value: "snapshots"},
+		corev1.EnvVar{Name: coh.EnvVarCohArchiverPathStyle, Value: "true"},
+		corev1.EnvVar{Name: coh.EnvVarCohArchiverPrefix, Value: "test"},
+		corev1.EnvVar{
+			Name: coh.EnvVarCohArchiverAccessKey,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "minio-credentials"},
+					Key:                  coh.DefaultArchiverAccessKey,
+				},
+			},
+		},
+		corev1.EnvVar{
+			Name: coh.EnvVarCohArchiverSecretKey,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "minio-credentials"},
+					Key:                  "password",
+				},
+			},
+		})
+
+	// assert that the StatefulSet is as expected
+	assertStatefulSetCreation(t, deployment, stsExpected)
+}
+
 func createResources(spec coh.CoherenceResourceSpec) (*appsv1.StatefulSet, *coh.Coherence) {
 	// Create the test deployment
 	deployment := createTestDeployment(spec)
@@ -30,7 +30,8 @@ var (
 func addKnownTypes(scheme *runtime.Scheme) error {
 	// Registering the root API objects here keeps scheme setup explicit for this group/version,
 	// which replaces the deprecated controller-runtime object-registration helper.
-	scheme.AddKnownTypes(GroupVersion, &Coherence{}, &CoherenceList{}, &CoherenceJob{}, &CoherenceJobList{})
+	scheme.AddKnownTypes(GroupVersion, &Coherence{}, &CoherenceList{}, &CoherenceJob{}, &CoherenceJobList{},
+		&CoherenceSnapshot{}, &CoherenceSnapshotList{}, &CoherenceRestore{}, &CoherenceRestoreList{})
 	// AddToGroupVersion records the API metadata so serialized objects keep the expected
 	// coherence.oracle.com/v1 identity after the registration path changes.
 	metav1.AddToGroupVersion(scheme, GroupVersion)
@@ -47,6 +47,26 @@ func TestAddToSchemeRegistersCoherenceAPITypes(t *testing.T) {
 			kind:     "CoherenceJobList",
 			expected: &coh.CoherenceJobList{},
 		},
+		{
+			name:     "coherence snapshot",
+			kind:     "CoherenceSnapshot",
+			expected: &coh.CoherenceSnapshot{},
+		},
+		{
+			name:     "coherence snapshot list",
+			kind:     "CoherenceSnapshotList",
+			expected: &coh.CoherenceSnapshotList{},
+		},
+		{
+			name:     "coherence restore",
+			kind:     "CoherenceRestore",
+			expected: &coh.CoherenceRestore{},
+		},
+		{
+			name:     "coherence restore list",
+			kind:     "CoherenceRestoreList",
+			expected: &coh.CoherenceRestoreList{},
+		},
 	}
 
 	for _, tt := range tests {
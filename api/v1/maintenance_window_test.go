@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestMaintenanceWindowNextOpen(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// open from 22:00 to 02:00 every Saturday
+	window := coh.MaintenanceWindowSpec{Schedule: "0 22 * * 6", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: ptr.To("UTC")}
+
+	// Friday evening, the window opens the next day
+	friday := time.Date(2026, time.October, 16, 18, 30, 0, 0, time.UTC)
+	next, err := window.NextOpen(friday)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(next).To(BeTemporally("==", time.Date(2026, time.October, 17, 22, 0, 0, 0, time.UTC)))
+
+	// inside the window, including after midnight
+	for _, now := range []time.Time{
+		time.Date(2026, time.October, 17, 22, 0, 0, 0, time.UTC),
+		time.Date(2026, time.October, 18, 1, 59, 0, 0, time.UTC),
+	} {
+		next, err = window.NextOpen(now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(next.IsZero()).To(BeTrue(), now.String())
+	}
+
+	// the window has closed, it opens again the next week
+	next, err = window.NextOpen(time.Date(2026, time.October, 18, 2, 0, 0, 0, time.UTC))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(next).To(BeTemporally("==", time.Date(2026, time.October, 24, 22, 0, 0, 0, time.UTC)))
+}
+
+func TestMaintenanceWindowNextOpenWithTimeZone(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	window := coh.MaintenanceWindowSpec{Schedule: "0 22 * * *", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: ptr.To("America/New_York")}
+
+	// 22:30 in New York is 02:30 UTC, during daylight saving time
+	next, err := window.NextOpen(time.Date(2026, time.October, 17, 2, 30, 0, 0, time.UTC))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(next.IsZero()).To(BeTrue())
+
+	window.TimeZone = ptr.To("Not/AZone")
+	_, err = window.NextOpen(time.Now())
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestNextMaintenanceWindow(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Date(2026, time.October, 16, 18, 30, 0, 0, time.UTC)
+	spec := coh.CoherenceStatefulSetResourceSpec{}
+	next, err := spec.NextMaintenanceWindow(now)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(next.IsZero()).To(BeTrue())
+
+	spec.MaintenanceWindows = []coh.MaintenanceWindowSpec{
+		{Schedule: "0 22 * * 6", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: ptr.To("UTC")},
+		{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: 2 * time.Hour}, TimeZone: ptr.To("UTC")},
+	}
+	// the earliest window to open is used
+	next, err = spec.NextMaintenanceWindow(now)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(next).To(BeTemporally("==", time.Date(2026, time.October, 17, 2, 0, 0, 0, time.UTC)))
+
+	// any open window allows disruptive operations
+	next, err = spec.NextMaintenanceWindow(time.Date(2026, time.October, 17, 3, 0, 0, 0, time.UTC))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(next.IsZero()).To(BeTrue())
+}
+
+func TestNextMaintenanceWindowWithInvalidWindows(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Date(2026, time.October, 16, 18, 30, 0, 0, time.UTC)
+	spec := coh.CoherenceStatefulSetResourceSpec{
+		MaintenanceWindows: []coh.MaintenanceWindowSpec{
+			{Schedule: "not a schedule", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+		},
+	}
+
+	// an invalid window never opens, so disruptive operations are not allowed
+	next, err := spec.NextMaintenanceWindow(now)
+	g.Expect(err).To(MatchError(ContainSubstring(`maintenanceWindows[0]: invalid maintenance window schedule "not a schedule"`)))
+	g.Expect(next.IsZero()).To(BeTrue())
+
+	// a valid window that is closed opens next, the invalid window is still reported
+	spec.MaintenanceWindows = append(spec.MaintenanceWindows,
+		coh.MaintenanceWindowSpec{Schedule: "0 2 * * *", Duration: metav1.Duration{Duration: 2 * time.Hour}, TimeZone: ptr.To("UTC")})
+	next, err = spec.NextMaintenanceWindow(now)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(next).To(BeTemporally("==", time.Date(2026, time.October, 17, 2, 0, 0, 0, time.UTC)))
+
+	// a valid window that is open allows disruptive operations
+	next, err = spec.NextMaintenanceWindow(time.Date(2026, time.October, 17, 3, 0, 0, 0, time.UTC))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(next.IsZero()).To(BeTrue())
+}
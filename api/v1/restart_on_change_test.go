@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package v1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestRestartOnChangeSecretsAndConfigMaps(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	spec := coh.CoherenceResourceSpec{
+		SecretVolumes: []coh.SecretVolumeSpec{
+			{Name: "secret-b", MountPath: "/b"},
+			{Name: "secret-a", MountPath: "/a"},
+			{Name: "secret-c", MountPath: "/c", RestartOnChange: ptr.To(false)},
+		},
+		ConfigMapVolumes: []coh.ConfigMapVolumeSpec{
+			{Name: "cm-a", MountPath: "/cm-a", RestartOnChange: ptr.To(true)},
+			{Name: "cm-b", MountPath: "/cm-b", RestartOnChange: ptr.To(false)},
+		},
+		Coherence: &coh.CoherenceSpec{
+			Management: &coh.PortSpecWithSSL{
+				Enabled: ptr.To(true),
+				SSL:     &coh.SSLSpec{Enabled: ptr.To(true), Secrets: ptr.To("mgmt-ssl")},
+			},
+			Metrics: &coh.PortSpecWithSSL{
+				Enabled: ptr.To(true),
+				SSL:     &coh.SSLSpec{Enabled: ptr.To(true), Secrets: ptr.To("metrics-ssl"), RestartOnChange: ptr.To(false)},
+			},
+		},
+	}
+
+	g.Expect(spec.GetRestartOnChangeSecrets()).To(Equal([]string{"mgmt-ssl", "secret-a", "secret-b"}))
+	g.Expect(spec.GetRestartOnChangeConfigMaps()).To(Equal([]string{"cm-a"}))
+}
+
+func TestRestartOnChangeIgnoresDisabledSSL(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	spec := coh.CoherenceResourceSpec{
+		Coherence: &coh.CoherenceSpec{
+			Management: &coh.PortSpecWithSSL{
+				Enabled: ptr.To(false),
+				SSL:     &coh.SSLSpec{Enabled: ptr.To(true), Secrets: ptr.To("mgmt-ssl")},
+			},
+			Metrics: &coh.PortSpecWithSSL{
+				Enabled: ptr.To(true),
+				SSL:     &coh.SSLSpec{Enabled: ptr.To(false), Secrets: ptr.To("metrics-ssl")},
+			},
+		},
+	}
+
+	g.Expect(spec.GetRestartOnChangeSecrets()).To(BeEmpty())
+	g.Expect(spec.GetRestartOnChangeConfigMaps()).To(BeEmpty())
+}
+
+func TestSetConfigHashAnnotation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createTestDeployment(coh.CoherenceResourceSpec{})
+	res, err := deployment.CreateKubernetesResources()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	res.SetConfigHashAnnotation("foo")
+	g.Expect(res.GetConfigHashAnnotation(deployment.Name)).To(Equal("foo"))
+	r, found := res.GetResource(coh.ResourceTypeStatefulSet, deployment.Name)
+	g.Expect(found).To(BeTrue())
+	sts := r.Spec.(*appsv1.StatefulSet)
+	g.Expect(sts.Spec.Template.Annotations[coh.AnnotationConfigHash]).To(Equal("foo"))
+
+	// a blank hash removes the annotation
+	res.SetConfigHashAnnotation("")
+	g.Expect(res.GetConfigHashAnnotation(deployment.Name)).To(BeEmpty())
+	g.Expect(sts.Spec.Template.Annotations).NotTo(HaveKey(coh.AnnotationConfigHash))
+}
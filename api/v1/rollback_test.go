@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestRollbackSpecDefaults(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var nilSpec *coh.RollbackSpec
+	g.Expect(nilSpec.IsEnabled()).To(BeFalse())
+	g.Expect(nilSpec.GetReadyTimeout()).To(Equal(coh.DefaultRollbackReadyTimeout))
+
+	spec := &coh.RollbackSpec{}
+	g.Expect(spec.IsEnabled()).To(BeTrue())
+	g.Expect(spec.IsRollbackOnCrashLoopBackOff()).To(BeTrue())
+
+	spec = &coh.RollbackSpec{Enabled: ptr.To(false), CrashLoopBackOff: ptr.To(false), ReadyTimeout: &metav1.Duration{Duration: time.Minute}}
+	g.Expect(spec.IsEnabled()).To(BeFalse())
+	g.Expect(spec.IsRollbackOnCrashLoopBackOff()).To(BeFalse())
+	g.Expect(spec.GetReadyTimeout()).To(Equal(time.Minute))
+}
+
+func TestRollbackSpecGetPodFailure(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Now()
+	spec := &coh.RollbackSpec{ReadyTimeout: &metav1.Duration{Duration: 5 * time.Minute}}
+
+	ready := rollbackTestPod(now.Add(-time.Hour), corev1.ConditionTrue)
+	reason, wait := spec.GetPodFailure(ready, now)
+	g.Expect(reason).To(BeEmpty())
+	g.Expect(wait).To(BeZero())
+
+	starting := rollbackTestPod(now.Add(-time.Minute), corev1.ConditionFalse)
+	reason, wait = spec.GetPodFailure(starting, now)
+	g.Expect(reason).To(BeEmpty())
+	g.Expect(wait).To(Equal(4 * time.Minute))
+
+	notReady := rollbackTestPod(now.Add(-10*time.Minute), corev1.ConditionFalse)
+	reason, _ = spec.GetPodFailure(notReady, now)
+	g.Expect(reason).To(Equal(coh.ReasonRollbackNotReady))
+
+	crashing := rollbackTestPod(now.Add(-time.Minute), corev1.ConditionFalse)
+	crashing.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{Name: coh.ContainerNameCoherence, State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+	}
+	reason, _ = spec.GetPodFailure(crashing, now)
+	g.Expect(reason).To(Equal(coh.ReasonRollbackCrashLoopBackOff))
+
+	spec.CrashLoopBackOff = ptr.To(false)
+	reason, wait = spec.GetPodFailure(crashing, now)
+	g.Expect(reason).To(BeEmpty())
+	g.Expect(wait).To(Equal(4 * time.Minute))
+}
+
+func rollbackTestPod(created time.Time, ready corev1.ConditionStatus) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "storage-0", CreationTimestamp: metav1.NewTime(created)},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+		},
+	}
+}
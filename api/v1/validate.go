@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2020, 2025, Oracle and/or its affiliates.
+ * Copyright (c) 2020, 2026, Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * http://oss.oracle.com/licenses/upl.
  */
@@ -7,6 +7,9 @@
 package v1
 
 import (
+	"fmt"
+	"net/url"
+
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -53,3 +56,217 @@ func ValidateJobUpdate(job, oldJob *batchv1.Job) field.ErrorList {
 	}
 	return allErrs
 }
+
+// ValidateCoherenceCreate validates a new Coherence resource.
+func ValidateCoherenceCreate(deployment *Coherence) field.ErrorList {
+	if deployment == nil {
+		return nil
+	}
+	specPath := field.NewPath("spec")
+	allErrs := ValidateCoherenceResourceSpec(&deployment.Spec.CoherenceResourceSpec, specPath)
+	allErrs = append(allErrs, validateStatefulSetResourceSpec(&deployment.Spec, specPath)...)
+	return allErrs
+}
+
+// ValidateCoherenceUpdate validates an update to an existing Coherence resource.
+// As well as validating the new spec, the StatefulSet that would be created from
+// the new spec is validated against the StatefulSet created from the old spec.
+func ValidateCoherenceUpdate(deployment, oldDeployment *Coherence) field.ErrorList {
+	allErrs := ValidateCoherenceCreate(deployment)
+	if deployment == nil || oldDeployment == nil {
+		return allErrs
+	}
+
+	if !apiequality.Semantic.DeepEqual(deployment.Spec.VolumeClaimTemplates, oldDeployment.Spec.VolumeClaimTemplates) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "volumeClaimTemplates"), "updates to volumeClaimTemplates are forbidden"))
+		// the StatefulSet check below would fail for the same reason, so there is no need to run it
+		return allErrs
+	}
+
+	sts := deployment.Spec.CreateStatefulSet(deployment)
+	oldSts := oldDeployment.Spec.CreateStatefulSet(oldDeployment)
+	return append(allErrs, ValidateStatefulSetUpdate(&sts, &oldSts)...)
+}
+
+// ValidateCoherenceJobCreate validates a new CoherenceJob resource.
+func ValidateCoherenceJobCreate(job *CoherenceJob) field.ErrorList {
+	if job == nil {
+		return nil
+	}
+	return ValidateCoherenceResourceSpec(&job.Spec.CoherenceResourceSpec, field.NewPath("spec"))
+}
+
+// ValidateCoherenceJobUpdate validates an update to an existing CoherenceJob resource.
+// As well as validating the new spec, the Job that would be created from
+// the new spec is validated against the Job created from the old spec.
+func ValidateCoherenceJobUpdate(job, oldJob *CoherenceJob) field.ErrorList {
+	allErrs := ValidateCoherenceJobCreate(job)
+	if job == nil || oldJob == nil {
+		return allErrs
+	}
+
+	j := job.Spec.CreateJob(job)
+	oldJ := oldJob.Spec.CreateJob(oldJob)
+	return append(allErrs, ValidateJobUpdate(&j, &oldJ)...)
+}
+
+// ValidateCoherenceResourceSpec validates the fields common to both Coherence and CoherenceJob resources.
+func ValidateCoherenceResourceSpec(spec *CoherenceResourceSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil {
+		return allErrs
+	}
+
+	if spec.Replicas != nil && *spec.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("replicas"), *spec.Replicas, "must be greater than or equal to 0"))
+	}
+	if spec.InitialReplicas != nil && *spec.InitialReplicas < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("initialReplicas"), *spec.InitialReplicas, "must be greater than or equal to 0"))
+	}
+
+	if spec.Coherence != nil {
+		cohPath := path.Child("coherence")
+		if spec.Coherence.Management != nil {
+			allErrs = append(allErrs, validateSSLSpec(spec.Coherence.Management.SSL, cohPath.Child("management", "ssl"))...)
+		}
+		if spec.Coherence.Metrics != nil {
+			allErrs = append(allErrs, validateSSLSpec(spec.Coherence.Metrics.SSL, cohPath.Child("metrics", "ssl"))...)
+		}
+	}
+
+	for i, port := range spec.Ports {
+		portPath := path.Child("ports").Index(i)
+		if (port.Ingress.IsEnabled() || port.HTTPRoute.IsEnabled()) && !port.Service.IsEnabled() {
+			allErrs = append(allErrs, field.Invalid(portPath.Child("service", "enabled"), false,
+				"the Service must be enabled to expose the port using an Ingress or HTTPRoute"))
+		}
+		if port.HTTPRoute.IsEnabled() && len(port.HTTPRoute.ParentRefs) == 0 {
+			allErrs = append(allErrs, field.Required(portPath.Child("httpRoute", "parentRefs"),
+				"at least one parent Gateway reference must be set"))
+		}
+	}
+
+	if np := spec.Network.GetNetworkPolicy(); np != nil {
+		for i, port := range np.Ports {
+			if !spec.hasPort(port.Name) {
+				allErrs = append(allErrs, field.NotFound(path.Child("network", "networkPolicy", "ports").Index(i).Child("name"), port.Name))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateSSLSpec validates the cert-manager configuration of an SSLSpec.
+func validateSSLSpec(ssl *SSLSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if ssl == nil || ssl.CertManager == nil {
+		return allErrs
+	}
+
+	cmPath := path.Child("certManager")
+	if ssl.Secrets != nil && *ssl.Secrets != "" {
+		allErrs = append(allErrs, field.Forbidden(cmPath, "certManager cannot be set when secrets is set"))
+	}
+	if ssl.CertManager.IssuerRef.Name == "" {
+		allErrs = append(allErrs, field.Required(cmPath.Child("issuerRef", "name"), "the name of the Issuer must be set"))
+	}
+	if ssl.CertManager.PasswordSecretRef.Name == "" {
+		allErrs = append(allErrs, field.Required(cmPath.Child("passwordSecretRef", "name"), "the name of the password Secret must be set"))
+	}
+	if ssl.CertManager.PasswordSecretRef.Key == "" {
+		allErrs = append(allErrs, field.Required(cmPath.Child("passwordSecretRef", "key"), "the password Secret key must be set"))
+	}
+	return allErrs
+}
+
+// validateStatefulSetResourceSpec validates the fields specific to a Coherence resource.
+func validateStatefulSetResourceSpec(spec *CoherenceStatefulSetResourceSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.RollingUpdateStrategy != nil {
+		strategyPath := path.Child("rollingUpdateStrategy")
+		switch *spec.RollingUpdateStrategy {
+		case UpgradeByPod, UpgradeByNode, UpgradeManual, UpgradeCanary:
+		case UpgradeByNodeLabel:
+			if spec.RollingUpdateLabel == nil || *spec.RollingUpdateLabel == "" {
+				allErrs = append(allErrs, field.Required(path.Child("rollingUpdateLabel"),
+					fmt.Sprintf("rollingUpdateLabel must be set when rollingUpdateStrategy is %s", UpgradeByNodeLabel)))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(strategyPath, *spec.RollingUpdateStrategy,
+				[]RollingUpdateStrategyType{UpgradeByPod, UpgradeByNode, UpgradeByNodeLabel, UpgradeManual, UpgradeCanary}))
+		}
+	}
+
+	if canary := spec.RollingUpdateCanary; canary != nil && canary.Replicas != nil && *canary.Replicas < 1 {
+		allErrs = append(allErrs, field.Invalid(path.Child("rollingUpdateCanary", "replicas"), *canary.Replicas,
+			"the number of canary replicas must be at least one"))
+	}
+
+	if webhook := spec.ApprovalWebhook; webhook != nil {
+		urlPath := path.Child("approvalWebhook", "url")
+		if webhook.URL == "" {
+			allErrs = append(allErrs, field.Required(urlPath, "the URL of the approval webhook must be set"))
+		} else if u, err := url.Parse(webhook.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(urlPath, webhook.URL, "the URL of the approval webhook must be an absolute http or https URL"))
+		} else if webhook.IsHTTPSOnly() && u.Scheme != "https" {
+			allErrs = append(allErrs, field.Invalid(urlPath, webhook.URL, "the URL of the approval webhook must be an https URL when httpsOnly is true"))
+		}
+		if token := webhook.BearerTokenSecret; token != nil && (token.Name == "" || token.Key == "") {
+			allErrs = append(allErrs, field.Invalid(path.Child("approvalWebhook", "bearerTokenSecret"), token.Name,
+				"the name and key of the bearer token Secret must be set"))
+		}
+	}
+
+	for i := range spec.MaintenanceWindows {
+		window := &spec.MaintenanceWindows[i]
+		windowPath := path.Child("maintenanceWindows").Index(i)
+		if _, _, err := window.ParseSchedule(); err != nil {
+			allErrs = append(allErrs, field.Invalid(windowPath.Child("schedule"), window.Schedule, err.Error()))
+		}
+		if window.Duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(windowPath.Child("duration"), window.Duration.Duration.String(),
+				"the duration of a maintenance window must be greater than zero"))
+		}
+	}
+
+	if sched := spec.Coherence.GetPersistenceSpec().GetSnapshotSchedule(); sched != nil {
+		if _, _, err := sched.ParseSchedule(); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("coherence", "persistence", "snapshotSchedule"), sched.Schedule, err.Error()))
+		}
+	}
+
+	if as := spec.Scaling.GetAutoscale(); as != nil {
+		asPath := path.Child("scaling", "autoscale")
+		if as.MinReplicas > as.MaxReplicas {
+			allErrs = append(allErrs, field.Invalid(asPath.Child("maxReplicas"), as.MaxReplicas,
+				"maxReplicas must be greater than or equal to minReplicas"))
+		}
+		if as.HeapUtilization == nil && as.CacheEntriesPerMember == nil {
+			allErrs = append(allErrs, field.Required(asPath,
+				"at least one of heapUtilization or cacheEntriesPerMember must be set"))
+		}
+	}
+
+	if scaling := spec.Scaling; scaling != nil {
+		if scaling.RequiredHALevel != nil && scaling.RequiredHALevel.StatusCode() == 0 {
+			allErrs = append(allErrs, field.NotSupported(path.Child("scaling", "requiredHALevel"), *scaling.RequiredHALevel,
+				[]HALevel{HALevelNodeSafe, HALevelMachineSafe, HALevelRackSafe, HALevelSiteSafe}))
+		} else if scaling.RequiredHALevel != nil && !spec.Coherence.IsManagementEnabled() {
+			allErrs = append(allErrs, field.Invalid(path.Child("scaling", "requiredHALevel"), *scaling.RequiredHALevel,
+				"Coherence management over REST must be enabled to check the HA status level"))
+		}
+		if scaling.MaxBatchSize != nil && *scaling.MaxBatchSize < 1 {
+			allErrs = append(allErrs, field.Invalid(path.Child("scaling", "maxBatchSize"), *scaling.MaxBatchSize,
+				"maxBatchSize must be greater than zero"))
+		}
+	}
+
+	if pdb := spec.PodDisruptionBudget; pdb != nil && pdb.MinAvailable != nil && pdb.MaxUnavailable != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("podDisruptionBudget", "maxUnavailable"), pdb.MaxUnavailable.String(),
+			"minAvailable and maxUnavailable cannot both be set"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,418 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestValidateCoherenceCreateWithValidSpec(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(BeEmpty())
+}
+
+func TestValidateCoherenceCreateWithNegativeReplicas(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	deployment.Spec.Replicas = ptr.To(int32(-1))
+
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Type).To(Equal(field.ErrorTypeInvalid))
+	g.Expect(errs[0].Field).To(Equal("spec.replicas"))
+}
+
+func TestValidateCoherenceCreateWithNegativeInitialReplicas(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	deployment.Spec.InitialReplicas = ptr.To(int32(-1))
+
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Field).To(Equal("spec.initialReplicas"))
+}
+
+func TestValidateCoherenceCreateWithNodeLabelStrategyAndNoLabel(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	strategy := coh.UpgradeByNodeLabel
+	deployment.Spec.RollingUpdateStrategy = &strategy
+
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Type).To(Equal(field.ErrorTypeRequired))
+	g.Expect(errs[0].Field).To(Equal("spec.rollingUpdateLabel"))
+}
+
+func TestValidateCoherenceCreateWithNodeLabelStrategyAndLabel(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	strategy := coh.UpgradeByNodeLabel
+	deployment.Spec.RollingUpdateStrategy = &strategy
+	deployment.Spec.RollingUpdateLabel = ptr.To("topology.kubernetes.io/zone")
+
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(BeEmpty())
+}
+
+func TestValidateCoherenceCreateWithInvalidStrategy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	strategy := coh.RollingUpdateStrategyType("Foo")
+	deployment.Spec.RollingUpdateStrategy = &strategy
+
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Type).To(Equal(field.ErrorTypeNotSupported))
+	g.Expect(errs[0].Field).To(Equal("spec.rollingUpdateStrategy"))
+}
+
+func TestValidateCoherenceCreateWithCanaryStrategy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	deployment.Spec.RollingUpdateStrategy = ptr.To(coh.UpgradeCanary)
+	deployment.Spec.RollingUpdateCanary = &coh.CanaryUpgradeSpec{Replicas: ptr.To(int32(2))}
+
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(BeEmpty())
+}
+
+func TestValidateCoherenceCreateWithInvalidCanaryReplicas(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	deployment.Spec.RollingUpdateStrategy = ptr.To(coh.UpgradeCanary)
+	deployment.Spec.RollingUpdateCanary = &coh.CanaryUpgradeSpec{Replicas: ptr.To(int32(0))}
+
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Type).To(Equal(field.ErrorTypeInvalid))
+	g.Expect(errs[0].Field).To(Equal("spec.rollingUpdateCanary.replicas"))
+}
+
+func TestValidateCoherenceCreateWithApprovalWebhook(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	deployment.Spec.ApprovalWebhook = &coh.ApprovalWebhookSpec{URL: "https://approvals.example.com/coherence"}
+	g.Expect(coh.ValidateCoherenceCreate(deployment)).To(BeEmpty())
+
+	deployment.Spec.ApprovalWebhook = &coh.ApprovalWebhookSpec{URL: "approvals.example.com"}
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Type).To(Equal(field.ErrorTypeInvalid))
+	g.Expect(errs[0].Field).To(Equal("spec.approvalWebhook.url"))
+
+	deployment.Spec.ApprovalWebhook = &coh.ApprovalWebhookSpec{}
+	errs = coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Type).To(Equal(field.ErrorTypeRequired))
+
+	deployment.Spec.ApprovalWebhook = &coh.ApprovalWebhookSpec{URL: "http://approvals.example.com/coherence", HTTPSOnly: ptr.To(true)}
+	errs = coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Field).To(Equal("spec.approvalWebhook.url"))
+
+	deployment.Spec.ApprovalWebhook = &coh.ApprovalWebhookSpec{
+		URL:               "https://approvals.example.com/coherence",
+		BearerTokenSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "approval-token"}},
+	}
+	errs = coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Field).To(Equal("spec.approvalWebhook.bearerTokenSecret"))
+}
+
+func TestValidateCoherenceCreateWithMaintenanceWindows(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	deployment.Spec.MaintenanceWindows = []coh.MaintenanceWindowSpec{
+		{Schedule: "0 22 * * 6", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: ptr.To("Europe/London")},
+	}
+	g.Expect(coh.ValidateCoherenceCreate(deployment)).To(BeEmpty())
+
+	deployment.Spec.MaintenanceWindows = append(deployment.Spec.MaintenanceWindows,
+		coh.MaintenanceWindowSpec{Schedule: "every saturday"})
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(2))
+	g.Expect(errs[0].Field).To(Equal("spec.maintenanceWindows[1].schedule"))
+	g.Expect(errs[1].Field).To(Equal("spec.maintenanceWindows[1].duration"))
+}
+
+func TestValidateCoherenceUpdateWithAllowedChanges(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	original := createValidationTestCoherence()
+	updated := original.DeepCopy()
+	updated.Spec.Replicas = ptr.To(int32(5))
+	updated.Spec.Image = ptr.To("oracle/coherence-ce:2.0.0")
+
+	errs := coh.ValidateCoherenceUpdate(updated, original)
+	g.Expect(errs).To(BeEmpty())
+}
+
+func TestValidateCoherenceUpdateWithChangedVolumeClaimTemplates(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	original := createValidationTestCoherence()
+	updated := original.DeepCopy()
+	updated.Spec.VolumeClaimTemplates = []coh.PersistentVolumeClaim{
+		{
+			Metadata: coh.PersistentVolumeClaimObjectMeta{Name: "data"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			},
+		},
+	}
+
+	errs := coh.ValidateCoherenceUpdate(updated, original)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Type).To(Equal(field.ErrorTypeForbidden))
+	g.Expect(errs[0].Field).To(Equal("spec.volumeClaimTemplates"))
+}
+
+func TestValidateCoherenceJobCreateWithNegativeReplicas(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	job := createValidationTestCoherenceJob()
+	job.Spec.Replicas = ptr.To(int32(-1))
+
+	errs := coh.ValidateCoherenceJobCreate(job)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Field).To(Equal("spec.replicas"))
+}
+
+func TestValidateCoherenceJobUpdateWithAllowedChanges(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	original := createValidationTestCoherenceJob()
+	updated := original.DeepCopy()
+	updated.Spec.Replicas = ptr.To(int32(5))
+
+	errs := coh.ValidateCoherenceJobUpdate(updated, original)
+	g.Expect(errs).To(BeEmpty())
+}
+
+func TestValidateCoherenceCreateWithMinAvailableAndMaxUnavailable(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	minAvailable := intstr.FromInt32(1)
+	maxUnavailable := intstr.FromInt32(1)
+	deployment.Spec.PodDisruptionBudget = &coh.PodDisruptionBudgetSpec{
+		MinAvailable:   &minAvailable,
+		MaxUnavailable: &maxUnavailable,
+	}
+
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Field).To(Equal("spec.podDisruptionBudget.maxUnavailable"))
+}
+
+func TestValidateCoherenceCreateWithInvalidSnapshotSchedule(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	deployment.Spec.Coherence = &coh.CoherenceSpec{
+		Persistence: &coh.PersistenceSpec{
+			SnapshotSchedule: &coh.SnapshotScheduleSpec{
+				Schedule: "not a schedule",
+				Services: []string{"PartitionedCache"},
+			},
+		},
+	}
+
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Field).To(Equal("spec.coherence.persistence.snapshotSchedule"))
+
+	deployment.Spec.Coherence.Persistence.SnapshotSchedule.Schedule = "0 2 * * *"
+	g.Expect(coh.ValidateCoherenceCreate(deployment)).To(BeEmpty())
+}
+
+func createValidationTestCoherence() *coh.Coherence {
+	return &coh.Coherence{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-ns",
+			Name:      "test",
+		},
+		Spec: coh.CoherenceStatefulSetResourceSpec{
+			CoherenceResourceSpec: coh.CoherenceResourceSpec{
+				Replicas: ptr.To(int32(3)),
+			},
+		},
+	}
+}
+
+func createValidationTestCoherenceJob() *coh.CoherenceJob {
+	return &coh.CoherenceJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-ns",
+			Name:      "test",
+		},
+		Spec: coh.CoherenceJobResourceSpec{
+			CoherenceResourceSpec: coh.CoherenceResourceSpec{
+				Replicas: ptr.To(int32(3)),
+			},
+		},
+	}
+}
+
+func TestValidateCoherenceCreateWithInvalidAutoscale(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	deployment.Spec.Scaling = &coh.ScalingSpec{
+		Autoscale: &coh.AutoscaleSpec{
+			MinReplicas: 5,
+			MaxReplicas: 3,
+		},
+	}
+
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(2))
+	g.Expect(errs[0].Field).To(Equal("spec.scaling.autoscale.maxReplicas"))
+	g.Expect(errs[1].Field).To(Equal("spec.scaling.autoscale"))
+
+	deployment.Spec.Scaling.Autoscale.MaxReplicas = 10
+	deployment.Spec.Scaling.Autoscale.HeapUtilization = ptr.To(int32(70))
+	g.Expect(coh.ValidateCoherenceCreate(deployment)).To(BeEmpty())
+}
+
+func TestValidateCoherenceCreateWithInvalidSafeBatchScaling(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	deployment.Spec.Scaling = &coh.ScalingSpec{
+		Policy:          ptr.To(coh.SafeBatchScaling),
+		RequiredHALevel: ptr.To(coh.HALevel("ZONE_SAFE")),
+		MaxBatchSize:    ptr.To(int32(0)),
+	}
+
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(2))
+	g.Expect(errs[0].Field).To(Equal("spec.scaling.requiredHALevel"))
+	g.Expect(errs[1].Field).To(Equal("spec.scaling.maxBatchSize"))
+
+	deployment.Spec.Scaling.RequiredHALevel = ptr.To(coh.HALevelSiteSafe)
+	deployment.Spec.Scaling.MaxBatchSize = ptr.To(int32(5))
+	errs = coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Field).To(Equal("spec.scaling.requiredHALevel"))
+	g.Expect(errs[0].Detail).To(ContainSubstring("management over REST must be enabled"))
+
+	deployment.Spec.Coherence = &coh.CoherenceSpec{Management: &coh.PortSpecWithSSL{Enabled: ptr.To(true)}}
+	g.Expect(coh.ValidateCoherenceCreate(deployment)).To(BeEmpty())
+}
+
+func TestValidateCoherenceCreateWithUnknownNetworkPolicyPort(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	deployment.Spec.Ports = []coh.NamedPortSpec{{Name: "extend", Port: 20000}}
+	deployment.Spec.Network = &coh.NetworkSpec{
+		NetworkPolicy: &coh.NetworkPolicySpec{
+			Ports: []coh.NetworkPolicyPortSpec{{Name: "extend"}, {Name: "grpc"}},
+		},
+	}
+
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Field).To(Equal("spec.network.networkPolicy.ports[1].name"))
+
+	deployment.Spec.Ports = append(deployment.Spec.Ports, coh.NamedPortSpec{Name: "grpc", Port: 1408})
+	g.Expect(coh.ValidateCoherenceCreate(deployment)).To(BeEmpty())
+}
+
+func TestValidateCoherenceCreateWithPortIngressAndServiceDisabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	deployment.Spec.Ports = []coh.NamedPortSpec{
+		{
+			Name:    "rest",
+			Port:    8080,
+			Service: &coh.ServiceSpec{Enabled: ptr.To(false)},
+			Ingress: &coh.PortIngressSpec{},
+		},
+	}
+
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Field).To(Equal("spec.ports[0].service.enabled"))
+
+	deployment.Spec.Ports[0].Service = nil
+	g.Expect(coh.ValidateCoherenceCreate(deployment)).To(BeEmpty())
+}
+
+func TestValidateCoherenceCreateWithPortHTTPRouteWithoutParentRefs(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	deployment.Spec.Ports = []coh.NamedPortSpec{
+		{Name: "rest", Port: 8080, HTTPRoute: &coh.PortHTTPRouteSpec{}},
+	}
+
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Field).To(Equal("spec.ports[0].httpRoute.parentRefs"))
+
+	deployment.Spec.Ports[0].HTTPRoute.ParentRefs = []gatewayv1.ParentReference{{Name: "gateway"}}
+	g.Expect(coh.ValidateCoherenceCreate(deployment)).To(BeEmpty())
+}
+
+func TestValidateCoherenceCreateWithCertManager(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := createValidationTestCoherence()
+	deployment.Spec.Coherence = &coh.CoherenceSpec{
+		Management: &coh.PortSpecWithSSL{
+			Enabled: ptr.To(true),
+			SSL: &coh.SSLSpec{
+				Enabled:     ptr.To(true),
+				Secrets:     ptr.To("ssl-secret"),
+				CertManager: &coh.SSLCertManagerSpec{},
+			},
+		},
+	}
+
+	errs := coh.ValidateCoherenceCreate(deployment)
+	g.Expect(errs).To(HaveLen(4))
+	g.Expect(errs[0].Field).To(Equal("spec.coherence.management.ssl.certManager"))
+	g.Expect(errs[1].Field).To(Equal("spec.coherence.management.ssl.certManager.issuerRef.name"))
+	g.Expect(errs[2].Field).To(Equal("spec.coherence.management.ssl.certManager.passwordSecretRef.name"))
+	g.Expect(errs[3].Field).To(Equal("spec.coherence.management.ssl.certManager.passwordSecretRef.key"))
+
+	ssl := deployment.Spec.Coherence.Management.SSL
+	ssl.Secrets = nil
+	ssl.CertManager.IssuerRef = cmmeta.IssuerReference{Name: "issuer"}
+	ssl.CertManager.PasswordSecretRef = cmmeta.SecretKeySelector{
+		LocalObjectReference: cmmeta.LocalObjectReference{Name: "keystore-pass"},
+		Key:                  "password",
+	}
+	g.Expect(coh.ValidateCoherenceCreate(deployment)).To(BeEmpty())
+}
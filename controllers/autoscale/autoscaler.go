@@ -0,0 +1,320 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+// Package autoscale contains the metric driven autoscaler for Coherence resources.
+package autoscale
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/reconciler"
+	"github.com/oracle/coherence-operator/pkg/management"
+	"github.com/oracle/coherence-operator/pkg/probe"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// MetricHeapUtilization is the name of the heap utilization metric.
+	MetricHeapUtilization = "HeapUtilization"
+	// MetricCacheEntriesPerMember is the name of the cache entries per member metric.
+	MetricCacheEntriesPerMember = "CacheEntriesPerMember"
+)
+
+// Metrics are the metric values used to calculate the recommended replicas of a deployment.
+type Metrics struct {
+	// HeapUtilization is the average heap utilization percentage of the members of the deployment, or nil if not known.
+	HeapUtilization *int64
+	// CacheEntries is the total number of cache entries, or nil if not known.
+	CacheEntries *int64
+}
+
+// Result is the result of reconciling the autoscaling of a Coherence resource.
+type Result struct {
+	// Status is the autoscale status to set on the Coherence resource.
+	Status *coh.AutoscaleStatus
+	// RequeueAfter is the time until the metrics should be evaluated again.
+	RequeueAfter time.Duration
+}
+
+// Autoscaler scales Coherence resources based on metrics obtained from Coherence management over REST.
+// The Autoscaler sets the replicas to scale to in the autoscale status of the Coherence resource, the
+// replicas in the spec are not changed. The StatefulSet is then scaled to the replicas in the status
+// using the scaling policy of the deployment, so safe scaling still applies.
+type Autoscaler struct {
+	Client        client.Client
+	Log           logr.Logger
+	EventRecorder events.EventRecorder
+	// HTTPClient is the optional http client used to call Coherence management over REST.
+	HTTPClient *http.Client
+}
+
+// ReconcileAutoscale evaluates the autoscaling metrics of a Coherence resource and returns the autoscale
+// status to set, which contains the replicas to scale the resource to.
+func (a *Autoscaler) ReconcileAutoscale(ctx context.Context, deployment *coh.Coherence) Result {
+	spec := deployment.Spec.Scaling.GetAutoscale()
+	if spec == nil {
+		return Result{}
+	}
+
+	current := deployment.GetReplicas()
+	status := deployment.Status.Autoscale.DeepCopy()
+	if status == nil {
+		status = &coh.AutoscaleStatus{}
+	}
+	result := Result{Status: status, RequeueAfter: spec.GetInterval()}
+
+	if current == 0 {
+		// the deployment has been stopped so there is nothing to scale, when it is
+		// started again it starts with the replicas in the spec
+		status.RecommendedReplicas = 0
+		status.Replicas = nil
+		status.Message = "the deployment is stopped"
+		result.RequeueAfter = 0
+		return result
+	}
+	if !spec.IsEnabled() {
+		// only recommendations are made, the deployment is scaled to the replicas in the spec
+		status.Replicas = nil
+	}
+
+	now := time.Now()
+	if status.LastEvaluationTime != nil {
+		// the metrics are only evaluated once per interval, updating the status more often
+		// would cause the resource to be continually reconciled
+		if next := status.LastEvaluationTime.Add(spec.GetInterval()).Sub(now); next > 0 {
+			result.RequeueAfter = next
+			return result
+		}
+	}
+	status.LastEvaluationTime = &metav1.Time{Time: now}
+
+	if deployment.Status.Phase != coh.ConditionTypeReady || deployment.Status.ReadyReplicas != current {
+		// wait until any scaling or upgrade has completed before evaluating the metrics
+		status.Message = fmt.Sprintf("waiting for %d replicas to be ready", current)
+		return result
+	}
+
+	metrics, err := a.fetchMetrics(ctx, deployment, spec)
+	if err != nil {
+		a.Log.Info("Failed to obtain autoscaling metrics", "Namespace", deployment.Namespace, "Name", deployment.Name, "Error", err.Error())
+		status.Message = fmt.Sprintf("failed to obtain metrics: %s", err.Error())
+		return result
+	}
+
+	recommended, metricStatus := Recommend(spec, current, metrics)
+	status.RecommendedReplicas = recommended
+	status.Metrics = metricStatus
+
+	switch {
+	case recommended == current:
+		status.Message = fmt.Sprintf("the deployment has the recommended %d replicas", current)
+		return result
+	case !spec.IsEnabled():
+		status.Message = fmt.Sprintf("autoscaling is disabled, recommended scaling from %d to %d replicas", current, recommended)
+		return result
+	}
+
+	// scaling into the minimum and maximum replicas range is not subject to the cooldown
+	if spec.ClampReplicas(current) == current {
+		if remaining := CooldownRemaining(spec, status.LastScaleTime, current, recommended, now); remaining > 0 {
+			status.Message = fmt.Sprintf("scaling from %d to %d replicas is delayed for %s by the cooldown",
+				current, recommended, remaining.Round(time.Second))
+			if remaining < result.RequeueAfter {
+				result.RequeueAfter = remaining
+			}
+			return result
+		}
+	}
+
+	// the StatefulSet is scaled to the replicas in the status once the status is updated
+	a.Log.Info("Autoscaling Coherence resource", "Namespace", deployment.Namespace, "Name", deployment.Name,
+		"Current", current, "Replicas", recommended)
+	status.Replicas = ptr.To(recommended)
+	status.LastScaleTime = &metav1.Time{Time: now}
+	status.Message = fmt.Sprintf("scaled from %d to %d replicas", current, recommended)
+	a.EventRecorder.Eventf(deployment, nil, coreV1.EventTypeNormal, reconciler.EventReasonScaling, "Autoscale",
+		"autoscaling from %d to %d replicas %s", current, recommended, describeMetrics(metricStatus))
+	return result
+}
+
+// fetchMetrics obtains the metrics required by the autoscale targets from Coherence management over REST.
+func (a *Autoscaler) fetchMetrics(ctx context.Context, deployment *coh.Coherence, spec *coh.AutoscaleSpec) (Metrics, error) {
+	var metrics Metrics
+
+	sts := &appsv1.StatefulSet{}
+	if err := a.Client.Get(ctx, types.NamespacedName{Namespace: deployment.Namespace, Name: deployment.Name}, sts); err != nil {
+		return metrics, errors.Wrapf(err, "getting StatefulSet %s", deployment.Name)
+	}
+
+	p := probe.CoherenceProbe{Client: a.Client}
+	host, port, err := p.GetManagementHostAndPort(ctx, deployment, sts)
+	if err != nil {
+		return metrics, err
+	}
+
+	// the metrics are obtained inline in the reconcile, so a single short request is made,
+	// if it fails the metrics are evaluated again at the next interval
+	cl := management.GetHTTPClient(a.HTTPClient, management.ReconcileRequestTimeout)
+
+	if spec.HeapUtilization != nil {
+		members, status, err := management.GetMembers(cl, host, port, management.SingleAttempt())
+		if err = management.CheckResponse("get cluster members", status, err); err != nil {
+			return metrics, err
+		}
+		metrics.HeapUtilization = HeapUtilization(deployment.Name, members)
+	}
+
+	if spec.CacheEntriesPerMember != nil {
+		caches, status, err := management.GetCaches(cl, host, port, management.SingleAttempt())
+		if err = management.CheckResponse("get caches", status, err); err != nil {
+			return metrics, err
+		}
+		metrics.CacheEntries = ptr.To(CacheEntries(caches, spec.Caches))
+	}
+
+	return metrics, nil
+}
+
+// Recommend returns the recommended replicas for a deployment with the current replicas and metrics,
+// along with the status of each metric. The recommended replicas is the largest of the replicas
+// required to meet each target, limited to the minimum and maximum replicas.
+func Recommend(spec *coh.AutoscaleSpec, current int32, metrics Metrics) (int32, []coh.AutoscaleMetricStatus) {
+	var statuses []coh.AutoscaleMetricStatus
+	tolerance := spec.GetTolerance()
+	recommended := int32(0)
+
+	if spec.HeapUtilization != nil && metrics.HeapUtilization != nil {
+		target := int64(*spec.HeapUtilization)
+		replicas := replicasForRatio(current, float64(*metrics.HeapUtilization)/float64(target), tolerance)
+		statuses = append(statuses, coh.AutoscaleMetricStatus{
+			Name:     MetricHeapUtilization,
+			Current:  *metrics.HeapUtilization,
+			Target:   target,
+			Replicas: replicas,
+		})
+		recommended = max(recommended, replicas)
+	}
+
+	if spec.CacheEntriesPerMember != nil && metrics.CacheEntries != nil && current > 0 {
+		target := *spec.CacheEntriesPerMember
+		perMember := *metrics.CacheEntries / int64(current)
+		replicas := replicasForRatio(current, float64(*metrics.CacheEntries)/float64(target*int64(current)), tolerance)
+		statuses = append(statuses, coh.AutoscaleMetricStatus{
+			Name:     MetricCacheEntriesPerMember,
+			Current:  perMember,
+			Target:   target,
+			Replicas: replicas,
+		})
+		recommended = max(recommended, replicas)
+	}
+
+	if len(statuses) == 0 {
+		// no metrics were available so recommend the current replicas
+		recommended = current
+	}
+	return spec.ClampReplicas(recommended), statuses
+}
+
+// CooldownRemaining returns how long scaling from the current to the desired replicas must wait
+// for the scale up or scale down cooldown since the deployment was last scaled.
+func CooldownRemaining(spec *coh.AutoscaleSpec, lastScale *metav1.Time, current, desired int32, now time.Time) time.Duration {
+	if lastScale == nil || desired == current {
+		return 0
+	}
+	cooldown := spec.GetScaleDownCooldown()
+	if desired > current {
+		cooldown = spec.GetScaleUpCooldown()
+	}
+	remaining := lastScale.Add(cooldown).Sub(now)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// HeapUtilization returns the average heap utilization percentage of the members of a deployment,
+// or nil if there are no members of the deployment with heap information.
+func HeapUtilization(deployment string, members *management.MembersData) *int64 {
+	if members == nil {
+		return nil
+	}
+	var total, count int64
+	for _, m := range members.Items {
+		if !isMemberOf(deployment, m.MemberName) || m.MemoryMaxMB <= 0 {
+			continue
+		}
+		used := int64(m.MemoryMaxMB - m.MemoryAvailableMB)
+		total += used * 100 / int64(m.MemoryMaxMB)
+		count++
+	}
+	if count == 0 {
+		return nil
+	}
+	return ptr.To(total / count)
+}
+
+// CacheEntries returns the total number of entries in the back tier of the caches with the specified
+// names, or of all caches if no names are specified.
+func CacheEntries(caches *management.CachesData, names []string) int64 {
+	if caches == nil {
+		return 0
+	}
+	var total int64
+	for _, c := range caches.Items {
+		if c.Tier != "" && c.Tier != "back" {
+			continue
+		}
+		if len(names) == 0 || slices.Contains(names, c.Name) {
+			total += c.Size
+		}
+	}
+	return total
+}
+
+// replicasForRatio returns the replicas required for a ratio of the current to the target metric value.
+func replicasForRatio(current int32, ratio, tolerance float64) int32 {
+	if math.Abs(ratio-1.0) <= tolerance {
+		return current
+	}
+	return int32(math.Ceil(float64(current) * ratio))
+}
+
+// isMemberOf returns true if a Coherence member name is the name of a Pod in a deployment.
+func isMemberOf(deployment, member string) bool {
+	ordinal, found := strings.CutPrefix(member, deployment+"-")
+	if !found {
+		return false
+	}
+	_, err := strconv.Atoi(ordinal)
+	return err == nil
+}
+
+func describeMetrics(statuses []coh.AutoscaleMetricStatus) string {
+	var parts []string
+	for _, s := range statuses {
+		parts = append(parts, fmt.Sprintf("%s=%d (target %d)", s.Name, s.Current, s.Target))
+	}
+	if len(parts) == 0 {
+		return "to the minimum and maximum replicas range"
+	}
+	return "for " + strings.Join(parts, ", ")
+}
@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package autoscale_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/autoscale"
+	"github.com/oracle/coherence-operator/pkg/fakes/stubs"
+	"github.com/oracle/coherence-operator/pkg/management"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+)
+
+func TestRecommendWithHeapUtilization(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	spec := &coh.AutoscaleSpec{MinReplicas: 2, MaxReplicas: 10, HeapUtilization: ptr.To(int32(60))}
+
+	// within the tolerance the current replicas is recommended
+	replicas, metrics := autoscale.Recommend(spec, 4, autoscale.Metrics{HeapUtilization: ptr.To(int64(64))})
+	g.Expect(replicas).To(Equal(int32(4)))
+	g.Expect(metrics).To(Equal([]coh.AutoscaleMetricStatus{
+		{Name: autoscale.MetricHeapUtilization, Current: 64, Target: 60, Replicas: 4},
+	}))
+
+	// above the target the replicas are scaled up in proportion
+	replicas, _ = autoscale.Recommend(spec, 4, autoscale.Metrics{HeapUtilization: ptr.To(int64(90))})
+	g.Expect(replicas).To(Equal(int32(6)))
+
+	// below the target the replicas are scaled down in proportion
+	replicas, _ = autoscale.Recommend(spec, 4, autoscale.Metrics{HeapUtilization: ptr.To(int64(30))})
+	g.Expect(replicas).To(Equal(int32(2)))
+
+	// the recommendation is limited to the minimum and maximum replicas
+	replicas, _ = autoscale.Recommend(spec, 4, autoscale.Metrics{HeapUtilization: ptr.To(int64(5))})
+	g.Expect(replicas).To(Equal(int32(2)))
+	replicas, _ = autoscale.Recommend(spec, 8, autoscale.Metrics{HeapUtilization: ptr.To(int64(99))})
+	g.Expect(replicas).To(Equal(int32(10)))
+}
+
+func TestRecommendUsesLargestReplicas(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	spec := &coh.AutoscaleSpec{
+		MinReplicas:           1,
+		MaxReplicas:           20,
+		HeapUtilization:       ptr.To(int32(50)),
+		CacheEntriesPerMember: ptr.To(int64(1000)),
+	}
+
+	metrics := autoscale.Metrics{HeapUtilization: ptr.To(int64(75)), CacheEntries: ptr.To(int64(8000))}
+	replicas, status := autoscale.Recommend(spec, 4, metrics)
+	g.Expect(replicas).To(Equal(int32(8)))
+	g.Expect(status).To(Equal([]coh.AutoscaleMetricStatus{
+		{Name: autoscale.MetricHeapUtilization, Current: 75, Target: 50, Replicas: 6},
+		{Name: autoscale.MetricCacheEntriesPerMember, Current: 2000, Target: 1000, Replicas: 8},
+	}))
+}
+
+func TestRecommendWithoutMetrics(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	spec := &coh.AutoscaleSpec{MinReplicas: 3, MaxReplicas: 5, HeapUtilization: ptr.To(int32(50))}
+	replicas, status := autoscale.Recommend(spec, 4, autoscale.Metrics{})
+	g.Expect(replicas).To(Equal(int32(4)))
+	g.Expect(status).To(BeEmpty())
+
+	replicas, _ = autoscale.Recommend(spec, 1, autoscale.Metrics{})
+	g.Expect(replicas).To(Equal(int32(3)))
+}
+
+func TestCooldownRemaining(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	spec := &coh.AutoscaleSpec{
+		ScaleUpCooldown:   &metav1.Duration{Duration: time.Minute},
+		ScaleDownCooldown: &metav1.Duration{Duration: 10 * time.Minute},
+	}
+	now := time.Now()
+	last := &metav1.Time{Time: now.Add(-2 * time.Minute)}
+
+	g.Expect(autoscale.CooldownRemaining(spec, nil, 3, 5, now)).To(BeZero())
+	g.Expect(autoscale.CooldownRemaining(spec, last, 3, 3, now)).To(BeZero())
+	g.Expect(autoscale.CooldownRemaining(spec, last, 3, 5, now)).To(BeZero())
+	g.Expect(autoscale.CooldownRemaining(spec, last, 5, 3, now)).To(Equal(8 * time.Minute))
+}
+
+func TestHeapUtilization(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	members := &management.MembersData{
+		Items: []management.MemberData{
+			{MemberName: "storage-0", MemoryMaxMB: 1000, MemoryAvailableMB: 400},
+			{MemberName: "storage-1", MemoryMaxMB: 1000, MemoryAvailableMB: 800},
+			{MemberName: "storage-proxy-0", MemoryMaxMB: 1000, MemoryAvailableMB: 0},
+			{MemberName: "web-0", MemoryMaxMB: 1000, MemoryAvailableMB: 0},
+		},
+	}
+
+	g.Expect(autoscale.HeapUtilization("storage", members)).To(Equal(ptr.To(int64(40))))
+	g.Expect(autoscale.HeapUtilization("other", members)).To(BeNil())
+	g.Expect(autoscale.HeapUtilization("storage", nil)).To(BeNil())
+}
+
+func TestCacheEntries(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	caches := &management.CachesData{
+		Items: []management.CacheData{
+			{Name: "orders", Service: "PartitionedCache", Tier: "back", Size: 100},
+			{Name: "orders", Service: "PartitionedCache", Tier: "front", Size: 10},
+			{Name: "customers", Service: "PartitionedCache", Tier: "back", Size: 50},
+			{Name: "replicated", Service: "ReplicatedCache", Size: 5},
+		},
+	}
+
+	g.Expect(autoscale.CacheEntries(caches, nil)).To(Equal(int64(155)))
+	g.Expect(autoscale.CacheEntries(caches, []string{"orders"})).To(Equal(int64(100)))
+	g.Expect(autoscale.CacheEntries(nil, nil)).To(BeZero())
+}
+
+func TestReconcileAutoscaleSetsReplicasInStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	server := stubs.NewManagementServer(t)
+	server.SetResponse(stubs.ManagementMembersPath, http.StatusOK,
+		`{"items": [{"memberName": "storage-0", "memoryMaxMB": 1000, "memoryAvailableMB": 100}]}`)
+
+	deployment, sts, pod := stubs.NewManagedCoherence(server)
+	deployment.Spec.Scaling = &coh.ScalingSpec{Autoscale: &coh.AutoscaleSpec{
+		MinReplicas:     1,
+		MaxReplicas:     4,
+		HeapUtilization: ptr.To(int32(45)),
+	}}
+	deployment.Status.Phase = coh.ConditionTypeReady
+	deployment.Status.ReadyReplicas = 1
+
+	c := stubs.NewClient(deployment, sts, pod)
+	recorder := events.NewFakeRecorder(10)
+	a := &autoscale.Autoscaler{Client: c, Log: logr.Discard(), EventRecorder: recorder}
+
+	r := a.ReconcileAutoscale(ctx, deployment)
+	g.Expect(r.Status).NotTo(BeNil())
+	g.Expect(r.Status.RecommendedReplicas).To(Equal(int32(2)))
+	g.Expect(r.Status.Replicas).To(Equal(ptr.To(int32(2))))
+	g.Expect(r.Status.LastScaleTime).NotTo(BeNil())
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("autoscaling from 1 to 2 replicas")))
+	// a single management request is made inline in the reconcile
+	g.Expect(server.Requests(stubs.ManagementMembersPath)).To(Equal(1))
+
+	// the replicas in the spec are not changed, the autoscaled replicas in the status take precedence
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	g.Expect(latest.Spec.Replicas).To(Equal(ptr.To(int32(1))))
+	deployment.Status.Autoscale = r.Status
+	g.Expect(deployment.GetReplicas()).To(Equal(int32(2)))
+
+	// when autoscaling is disabled the deployment is scaled back to the replicas in the spec
+	deployment.Spec.Scaling.Autoscale.Enabled = ptr.To(false)
+	r = a.ReconcileAutoscale(ctx, deployment)
+	g.Expect(r.Status.Replicas).To(BeNil())
+	deployment.Status.Autoscale = r.Status
+	g.Expect(deployment.GetReplicas()).To(Equal(int32(1)))
+}
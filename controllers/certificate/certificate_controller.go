@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package certificate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/reconciler"
+	"github.com/oracle/coherence-operator/pkg/clients"
+	"github.com/oracle/coherence-operator/pkg/utils"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// The name of this controller. This is used in events, log messages, etc.
+	controllerName = "controllers.Certificate"
+)
+
+// blank assignment to verify that ReconcileCertificate implements reconcile.Reconciler.
+// If the `reconcile.Reconciler` API was to change then we'd get a compile error here.
+var _ reconcile.Reconciler = &ReconcileCertificate{}
+
+// NewCertificateReconciler returns a new cert-manager Certificate reconciler.
+func NewCertificateReconciler(mgr manager.Manager, cs clients.ClientSet) reconciler.SecondaryResourceReconciler {
+	return NewNamedCertificateReconciler(mgr, cs, controllerName)
+}
+
+// NewNamedCertificateReconciler returns a new cert-manager Certificate reconciler.
+func NewNamedCertificateReconciler(mgr manager.Manager, cs clients.ClientSet, name string) reconciler.SecondaryResourceReconciler {
+	r := &ReconcileCertificate{
+		ReconcileSecondaryResource: reconciler.ReconcileSecondaryResource{
+			Kind:      coh.ResourceTypeCertificate,
+			Template:  &certmanagerv1.Certificate{},
+			SkipWatch: true,
+		},
+	}
+
+	r.SetCommonReconciler(name, mgr, cs)
+	// Certificate is a custom resource, so it does not support strategic merge patches
+	r.GetPatcher().SetPatchType(types.MergePatchType)
+	return r
+}
+
+// ReconcileCertificate reconciles the cert-manager Certificates for a Coherence resource.
+// cert-manager is optional, so the Certificates are only reconciled if the
+// cert-manager Certificate CRD is installed in the cluster.
+type ReconcileCertificate struct {
+	reconciler.ReconcileSecondaryResource
+}
+
+func (in *ReconcileCertificate) GetReconciler() reconcile.Reconciler { return in }
+
+// Reconcile reads that state of the Certificates for a deployment and makes changes based on the
+// state read and the desired state based on the parent Coherence resource.
+func (in *ReconcileCertificate) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := in.GetLog().WithValues("Namespace", request.Namespace, "Name", request.Name, "Kind", in.Kind.Name())
+	logger.Info("Starting reconcile")
+
+	// Attempt to lock the requested resource. If the resource is locked then another
+	// request for the same resource is already in progress so requeue this one.
+	if ok := in.Lock(request); !ok {
+		return reconcile.Result{RequeueAfter: time.Second * 10}, nil
+	}
+	// Make sure that the request is unlocked when this method exits
+	defer in.Unlock(request)
+
+	if !in.hasCertificate() {
+		logger.Info("Cannot reconcile Certificate as the cert-manager Certificate CRD is not installed")
+		return reconcile.Result{}, nil
+	}
+
+	err := in.ReconcileSingleResource(ctx, request.Namespace, request.Name, nil, nil, logger)
+	logger.Info("Completed reconcile")
+	return reconcile.Result{}, err
+}
+
+// ReconcileAllResourceOfKind reconciles the state of the desired Certificates for the reconciler
+func (in *ReconcileCertificate) ReconcileAllResourceOfKind(ctx context.Context, request reconcile.Request, d coh.CoherenceResource, storage utils.Storage) (reconcile.Result, error) {
+	if !in.hasResources(storage) {
+		// nothing to do, so avoid the cost of checking for the CRD
+		return reconcile.Result{}, nil
+	}
+
+	if !in.hasCertificate() {
+		logger := in.GetLog().WithValues("Namespace", request.Namespace, "Name", request.Name, "Kind", in.Kind.Name())
+		logger.Info("Cannot reconcile Certificate as the cert-manager Certificate CRD is not installed")
+		if len(storage.GetLatest().GetResourcesOfKind(in.Kind)) > 0 {
+			// the Pods cannot start without the Secrets cert-manager creates, so this is an error
+			return reconcile.Result{}, fmt.Errorf("cannot create the Certificates for %s/%s as cert-manager is not installed", request.Namespace, request.Name)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	return in.ReconcileSecondaryResource.ReconcileAllResourceOfKind(ctx, request, d, storage)
+}
+
+// hasResources returns true if there are Certificates to create, update or delete.
+func (in *ReconcileCertificate) hasResources(storage utils.Storage) bool {
+	if len(storage.GetLatest().GetResourcesOfKind(in.Kind)) > 0 {
+		return true
+	}
+	for _, del := range storage.GetDeletions() {
+		if del.Kind == in.Kind {
+			return true
+		}
+	}
+	return false
+}
+
+// hasCertificate checks if the cert-manager Certificate CRD is registered in the cluster.
+func (in *ReconcileCertificate) hasCertificate() bool {
+	dc, err := discovery.NewDiscoveryClientForConfig(in.GetManager().GetConfig())
+	if err != nil {
+		in.GetLog().Error(err, "error creating discovery client")
+		return false
+	}
+	resources, err := dc.ServerResourcesForGroupVersion(certmanagerv1.SchemeGroupVersion.String())
+	switch {
+	case err != nil && apierrors.IsNotFound(err):
+		return false
+	case err != nil:
+		in.GetLog().Error(err, "error checking for cert-manager Certificate CRD")
+		return false
+	}
+	for _, r := range resources.APIResources {
+		if r.Kind == coh.CertificateKind {
+			return true
+		}
+	}
+	return false
+}
@@ -12,34 +12,47 @@ import (
 	"strconv"
 	"time"
 
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/go-logr/logr"
 	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/autoscale"
+	"github.com/oracle/coherence-operator/controllers/certificate"
+	"github.com/oracle/coherence-operator/controllers/drift"
 	"github.com/oracle/coherence-operator/controllers/errorhandling"
 	"github.com/oracle/coherence-operator/controllers/finalizer"
+	"github.com/oracle/coherence-operator/controllers/httproute"
+	"github.com/oracle/coherence-operator/controllers/members"
 	"github.com/oracle/coherence-operator/controllers/predicates"
 	"github.com/oracle/coherence-operator/controllers/reconciler"
 	"github.com/oracle/coherence-operator/controllers/resources"
 	"github.com/oracle/coherence-operator/controllers/secret"
 	"github.com/oracle/coherence-operator/controllers/servicemonitor"
+	"github.com/oracle/coherence-operator/controllers/snapshot"
 	"github.com/oracle/coherence-operator/controllers/statefulset"
 	"github.com/oracle/coherence-operator/controllers/status"
 	"github.com/oracle/coherence-operator/pkg/clients"
+	"github.com/oracle/coherence-operator/pkg/metrics"
 	"github.com/oracle/coherence-operator/pkg/operator"
+	"github.com/oracle/coherence-operator/pkg/tracing"
 	"github.com/oracle/coherence-operator/pkg/utils"
 	"github.com/pkg/errors"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 const (
@@ -51,19 +64,40 @@ const (
 
 	// The error message template to use to indicate a resource creation failure.
 	createResourcesFailedMessage string = "create resources for Coherence resource '%s' in namespace '%s' failed\n%s"
+
+	// The field index of the names of the Secrets that restart the Pods of a Coherence resource when they change.
+	restartOnChangeSecretsIndex = "spec.restartOnChangeSecrets"
+	// The field index of the names of the ConfigMaps that restart the Pods of a Coherence resource when they change.
+	restartOnChangeConfigMapsIndex = "spec.restartOnChangeConfigMaps"
 )
 
 // CoherenceReconciler reconciles a Coherence resource
 type CoherenceReconciler struct {
 	client.Client
 	reconciler.CommonReconciler
-	ClientSet        clients.ClientSet
-	Log              logr.Logger
-	Scheme           *runtime.Scheme
-	reconcilers      []reconciler.SecondaryResourceReconciler
-	finalizerManager *finalizer.FinalizerManager
-	statusManager    *status.StatusManager
-	resourcesManager *resources.OperatorSecretManager
+	ClientSet         clients.ClientSet
+	Log               logr.Logger
+	Scheme            *runtime.Scheme
+	reconcilers       []reconciler.SecondaryResourceReconciler
+	finalizerManager  *finalizer.FinalizerManager
+	statusManager     *status.StatusManager
+	resourcesManager  *resources.OperatorSecretManager
+	configHashManager *resources.ConfigHashManager
+	driftDetector     *drift.Detector
+	scheduleManager   *snapshot.ScheduleManager
+	autoscaler        *autoscale.Autoscaler
+	membersManager    *members.Manager
+}
+
+// reconcileSecondary reconciles all the secondary resources of the kind managed by a secondary reconciler
+// in a trace span.
+func reconcileSecondary(ctx context.Context, rec reconciler.SecondaryResourceReconciler, kind coh.ResourceType, request ctrl.Request,
+	deployment coh.CoherenceResource, storage utils.Storage) (ctrl.Result, error) {
+	ctx, span := tracing.StartForResource(ctx, "ReconcileAllResourceOfKind", kind.Name(), request.NamespacedName,
+		tracing.AttributeSecondaryKind.String(rec.GetControllerName()))
+	result, err := rec.ReconcileAllResourceOfKind(ctx, request, deployment, storage)
+	tracing.End(span, err)
+	return result, err
 }
 
 // Failure is a simple holder for a named error
@@ -76,16 +110,28 @@ type Failure struct {
 // There will be a compile-time error here if this breaks
 var _ reconcile.Reconciler = &CoherenceReconciler{}
 
-// +kubebuilder:rbac:groups=coherence.oracle.com,resources=coherence;coherencejob;coherence/finalizers;coherencejob/finalizers;coherence/status;coherencejob/status,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=coherence.oracle.com,resources=coherence;coherencejob;coherencesnapshot;coherencerestore;coherence/finalizers;coherencejob/finalizers;coherencesnapshot/finalizers;coherencerestore/finalizers;coherence/status;coherencejob/status;coherencesnapshot/status;coherencerestore/status,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=pods;pods/exec;services;endpoints;events;configmaps;secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies;ingresses,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile performs a full reconciliation for the Coherence resource referred to by the Request.
 // The Controller will requeue the Request to be processed again if an error is non-nil or
 // Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
 func (in *CoherenceReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	ctx, span := tracing.StartForResource(ctx, "CoherenceReconciler.Reconcile", coh.ResourceTypeCoherence.Name(), request.NamespacedName)
+	result, err := in.reconcileCoherence(ctx, request)
+	tracing.End(span, err)
+	return result, err
+}
+
+// reconcileCoherence performs a full reconciliation for the Coherence resource referred to by the Request.
+func (in *CoherenceReconciler) reconcileCoherence(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
 	var err error
 
 	log := in.Log.WithValues("namespace", request.Namespace, "name", request.Name)
@@ -110,6 +156,8 @@ func (in *CoherenceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 			// Owned objects are automatically garbage collected.
 			// Return and don't requeue
 			log.Info("Coherence resource not found. Ignoring request since object must be deleted.")
+			in.membersManager.Forget(request.NamespacedName)
+			metrics.DeleteResource(coh.ResourceTypeCoherence.Name(), request.NamespacedName)
 			return ctrl.Result{}, nil
 		}
 		// else... error reading the current deployment state from k8s.
@@ -119,6 +167,7 @@ func (in *CoherenceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 		wrappedErr := errorhandling.NewGetResourceError(request.Name, request.Namespace, err)
 		return reconcile.Result{}, wrappedErr
 	}
+	metrics.SetPhase(coh.ResourceTypeCoherence.Name(), request.NamespacedName, string(deployment.Status.Phase))
 
 	// Check whether this is a deletion
 	deleteTime := deployment.GetDeletionTimestamp()
@@ -263,6 +312,23 @@ func (in *CoherenceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 		return in.HandleErrAndRequeue(ctx, err, deployment, fmt.Sprintf(createResourcesFailedMessage, request.Name, request.Namespace, err), in.Log)
 	}
 
+	// set the hash of the content of the mounted Secrets and ConfigMaps on the StatefulSet Pod template,
+	// so that a change to the content causes a rolling upgrade of the Pods
+	configHash, err := in.configHashManager.GetConfigHash(ctx, deployment)
+	if err != nil {
+		err = errorhandling.NewOperationError("get_config_hash", err).
+			WithContext("resource", deployment.GetName()).
+			WithContext("namespace", deployment.GetNamespace())
+		return in.HandleErrAndRequeue(ctx, err, deployment, fmt.Sprintf(reconcileFailedMessage, request.Name, request.Namespace, err), in.Log)
+	}
+	storeConfigHash := storage.GetLatest().GetConfigHashAnnotation(deployment.GetName())
+	if storeConfigHash != "" && configHash != "" && storeConfigHash != configHash {
+		log.Info("Content of mounted Secrets or ConfigMaps changed", "configHash", configHash, "storeConfigHash", storeConfigHash)
+		in.GetEventRecorder().Eventf(deployment, nil, coreV1.EventTypeNormal, reconciler.EventReasonUpdated, "ConfigChanged",
+			"content of mounted Secrets or ConfigMaps has changed, the Pods will be restarted")
+	}
+	desiredResources.SetConfigHashAnnotation(configHash)
+
 	log.Info("Reconciling Coherence resource secondary resources", "hash", hash, "store", storeHash)
 
 	// make the deployment the owner of all the secondary resources about to be reconciled
@@ -290,7 +356,7 @@ func (in *CoherenceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 	// process the secondary resources in the order they should be created
 	var failures []Failure
 	for _, rec := range in.reconcilers {
-		r, err := rec.ReconcileAllResourceOfKind(ctx, request, deployment, storage)
+		r, err := reconcileSecondary(ctx, rec, coh.ResourceTypeCoherence, request, deployment, storage)
 		if err != nil {
 			failures = append(failures, Failure{Name: rec.GetControllerName(), Error: err})
 			result.RequeueAfter = time.Minute
@@ -337,12 +403,181 @@ func (in *CoherenceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 			WithContext("hash", hash)
 	}
 
+	// create any scheduled snapshot that is due and remove old scheduled snapshots
+	requeue, err := in.reconcileSnapshotSchedule(ctx, deployment)
+	if err != nil {
+		return result, err
+	}
+	if requeue > 0 && (result.RequeueAfter <= 0 || requeue < result.RequeueAfter) {
+		result.RequeueAfter = requeue
+	}
+
+	// check whether any of the secondary resources have drifted from the desired state
+	if err = in.reconcileDrift(ctx, deployment, storage); err != nil {
+		return result, err
+	}
+
+	// evaluate the autoscaling metrics and update the autoscaled replicas if required
+	requeue, err = in.reconcileAutoscale(ctx, deployment)
+	if err != nil {
+		return result, err
+	}
+	if requeue > 0 && (result.RequeueAfter <= 0 || requeue < result.RequeueAfter) {
+		result.RequeueAfter = requeue
+	}
+
+	// update the cluster members in the status if they are due to be refreshed
+	requeue, err = in.reconcileMembers(ctx, deployment)
+	if err != nil {
+		return result, err
+	}
+	if requeue > 0 && (result.RequeueAfter <= 0 || requeue < result.RequeueAfter) {
+		result.RequeueAfter = requeue
+	}
+
 	log.Info("Finished reconciling Coherence resource", "RequeueAfter", result.RequeueAfter)
 	return result, nil
 }
 
+// reconcileSnapshotSchedule reconciles the scheduled snapshots of a Coherence resource, returning
+// the time until the next scheduled snapshot is due.
+func (in *CoherenceReconciler) reconcileSnapshotSchedule(ctx context.Context, deployment *coh.Coherence) (time.Duration, error) {
+	if !operator.ShouldSupportSnapshots() {
+		if deployment.Spec.Coherence.GetPersistenceSpec().GetSnapshotSchedule() != nil {
+			in.Log.Info("Ignoring snapshot schedule, CoherenceSnapshot support is disabled", "Namespace", deployment.Namespace, "Name", deployment.Name)
+		}
+		return 0, nil
+	}
+
+	r, err := in.scheduleManager.ReconcileSchedule(ctx, deployment)
+	if err != nil {
+		return 0, errorhandling.NewOperationError("reconcile_snapshot_schedule", err).
+			WithContext("resource", deployment.GetName()).
+			WithContext("namespace", deployment.GetNamespace())
+	}
+
+	status := deployment.Status
+	if !r.ScheduleStartTime.Equal(status.SnapshotScheduleStartTime) || !r.LastScheduledTime.Equal(status.LastScheduledSnapshotTime) ||
+		!r.LastSnapshotTime.Equal(status.LastSnapshotTime) || r.LastSnapshot != status.LastSnapshot {
+		nn := types.NamespacedName{Namespace: deployment.Namespace, Name: deployment.Name}
+		if err = in.statusManager.UpdateSnapshotStatus(ctx, nn, r.ScheduleStartTime, r.LastScheduledTime, r.LastSnapshotTime, r.LastSnapshot); err != nil {
+			return 0, errorhandling.NewOperationError("update_snapshot_status", err).
+				WithContext("resource", deployment.GetName()).
+				WithContext("namespace", deployment.GetNamespace())
+		}
+	}
+	return r.RequeueAfter, nil
+}
+
+// reconcileDrift compares the secondary resources of a Coherence resource with the desired state
+// in the store and updates the Drifted condition in the status.
+func (in *CoherenceReconciler) reconcileDrift(ctx context.Context, deployment *coh.Coherence, storage utils.Storage) error {
+	nn := types.NamespacedName{Namespace: deployment.Namespace, Name: deployment.Name}
+	current := deployment.Status.Conditions.GetCondition(coh.ConditionTypeDrifted)
+
+	if deployment.Spec.GetDriftPolicy() == coh.DriftPolicyIgnore {
+		if current == nil {
+			return nil
+		}
+		return in.updateDriftedCondition(ctx, deployment, nn, nil)
+	}
+
+	drifted, err := in.driftDetector.Detect(ctx, deployment, storage)
+	if err != nil {
+		return errorhandling.NewOperationError("detect_drift", err).
+			WithContext("resource", deployment.GetName()).
+			WithContext("namespace", deployment.GetNamespace())
+	}
+
+	c := drift.Condition(drifted)
+	if current == nil && !c.IsTrue() {
+		// nothing has ever drifted, so there is no need to add the condition
+		return nil
+	}
+	if current != nil && current.Status == c.Status && current.Reason == c.Reason && current.Message == c.Message {
+		return nil
+	}
+	if c.IsTrue() {
+		in.GetEventRecorder().Eventf(deployment, nil, coreV1.EventTypeWarning, reconciler.EventReasonDrifted, "DetectDrift",
+			"secondary resources have drifted from the desired state: %s", c.Message)
+	}
+	return in.updateDriftedCondition(ctx, deployment, nn, &c)
+}
+
+func (in *CoherenceReconciler) updateDriftedCondition(ctx context.Context, deployment *coh.Coherence, nn types.NamespacedName, c *coh.Condition) error {
+	if err := in.statusManager.UpdateDriftedCondition(ctx, nn, c); err != nil {
+		return errorhandling.NewOperationError("update_drifted_condition", err).
+			WithContext("resource", deployment.GetName()).
+			WithContext("namespace", deployment.GetNamespace())
+	}
+	return nil
+}
+
+// reconcileAutoscale evaluates the autoscaling metrics of a Coherence resource, returning
+// the time until the metrics should be evaluated again. The autoscaled replicas are set in
+// the status, the update of the status causes the StatefulSet to be scaled.
+func (in *CoherenceReconciler) reconcileAutoscale(ctx context.Context, deployment *coh.Coherence) (time.Duration, error) {
+	r := in.autoscaler.ReconcileAutoscale(ctx, deployment)
+	if !equality.Semantic.DeepEqual(r.Status, deployment.Status.Autoscale) {
+		nn := types.NamespacedName{Namespace: deployment.Namespace, Name: deployment.Name}
+		if err := in.statusManager.UpdateAutoscaleStatus(ctx, nn, r.Status); err != nil {
+			return 0, errorhandling.NewOperationError("update_autoscale_status", err).
+				WithContext("resource", deployment.GetName()).
+				WithContext("namespace", deployment.GetNamespace())
+		}
+	}
+	return r.RequeueAfter, nil
+}
+
+// reconcileMembers updates the Coherence cluster members and services in the status of a Coherence
+// resource, returning the time until the members and services should be updated again.
+func (in *CoherenceReconciler) reconcileMembers(ctx context.Context, deployment *coh.Coherence) (time.Duration, error) {
+	r, err := in.membersManager.ReconcileMembers(ctx, deployment)
+	if err != nil {
+		return 0, errorhandling.NewOperationError("reconcile_members", err).
+			WithContext("resource", deployment.GetName()).
+			WithContext("namespace", deployment.GetNamespace())
+	}
+	if !r.Updated {
+		return r.RequeueAfter, nil
+	}
+
+	current := deployment.Status.Conditions.GetCondition(coh.ConditionTypeServicesEndangered)
+	var endangered *coh.Condition
+	if len(r.Services) > 0 {
+		c := members.EndangeredCondition(r.Services)
+		if current != nil || c.IsTrue() {
+			// the condition is only added once a service has been endangered
+			endangered = &c
+		}
+	}
+	conditionChanged := (current == nil) != (endangered == nil) ||
+		(current != nil && (current.Status != endangered.Status || current.Message != endangered.Message))
+
+	if conditionChanged || !equality.Semantic.DeepEqual(r.Members, deployment.Status.Members) ||
+		!equality.Semantic.DeepEqual(r.Services, deployment.Status.Services) {
+		if conditionChanged && endangered != nil && endangered.IsTrue() {
+			in.GetEventRecorder().Eventf(deployment, nil, coreV1.EventTypeWarning, reconciler.EventReasonServicesEndangered, "CheckServices",
+				"%s", endangered.Message)
+		}
+		nn := types.NamespacedName{Namespace: deployment.Namespace, Name: deployment.Name}
+		if err = in.statusManager.UpdateMembersStatus(ctx, nn, r.Members, r.Services, endangered); err != nil {
+			return 0, errorhandling.NewOperationError("update_members_status", err).
+				WithContext("resource", deployment.GetName()).
+				WithContext("namespace", deployment.GetNamespace())
+		}
+	}
+	return r.RequeueAfter, nil
+}
+
 func (in *CoherenceReconciler) SetupWithManager(mgr ctrl.Manager, cs clients.ClientSet) error {
 	SetupMonitoringResources(mgr)
+	if err := SetupGatewayResources(mgr); err != nil {
+		return err
+	}
+	if err := SetupCertManagerResources(mgr); err != nil {
+		return err
+	}
 
 	// Create the sub-resource reconcilers IN THE ORDER THAT RESOURCES MUST BE CREATED.
 	// This is important to ensure, for example, that a ConfigMap is created before the
@@ -350,8 +585,13 @@ func (in *CoherenceReconciler) SetupWithManager(mgr ctrl.Manager, cs clients.Cli
 	reconcilers := []reconciler.SecondaryResourceReconciler{
 		reconciler.NewConfigMapReconciler(mgr, cs),
 		secret.NewSecretReconciler(mgr, cs),
+		certificate.NewCertificateReconciler(mgr, cs),
 		reconciler.NewServiceReconciler(mgr, cs),
 		servicemonitor.NewServiceMonitorReconciler(mgr, cs),
+		reconciler.NewPodDisruptionBudgetReconciler(mgr, cs),
+		reconciler.NewNetworkPolicyReconciler(mgr, cs),
+		reconciler.NewIngressReconciler(mgr, cs),
+		httproute.NewHTTPRouteReconciler(mgr, cs),
 		statefulset.NewStatefulSetReconciler(mgr, cs),
 	}
 
@@ -378,8 +618,40 @@ func (in *CoherenceReconciler) SetupWithManager(mgr ctrl.Manager, cs clients.Cli
 		Log:    in.Log.WithName("resources"),
 	}
 
+	in.configHashManager = &resources.ConfigHashManager{
+		Client: mgr.GetClient(),
+		Log:    in.Log.WithName("confighash"),
+	}
+
+	in.driftDetector = &drift.Detector{
+		Client: mgr.GetClient(),
+		Log:    in.Log.WithName("drift"),
+	}
+
+	in.scheduleManager = &snapshot.ScheduleManager{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		Log:           in.Log.WithName("snapshot"),
+		EventRecorder: in.GetEventRecorder(),
+	}
+
+	in.autoscaler = &autoscale.Autoscaler{
+		Client:        mgr.GetClient(),
+		Log:           in.Log.WithName("autoscale"),
+		EventRecorder: in.GetEventRecorder(),
+	}
+
+	in.membersManager = &members.Manager{
+		Client: mgr.GetClient(),
+		Log:    in.Log.WithName("members"),
+	}
+
 	template := &coh.Coherence{}
 
+	if err := indexRestartOnChange(mgr); err != nil {
+		return err
+	}
+
 	// Watch for changes to secondary resources
 	for _, sub := range reconcilers {
 		if err := watchSecondaryResource(mgr, sub, template); err != nil {
@@ -387,16 +659,80 @@ func (in *CoherenceReconciler) SetupWithManager(mgr ctrl.Manager, cs clients.Cli
 		}
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(template).
 		Named("coherence").
 		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
-		Complete(in)
+		// Watch for changes to the content of Secrets and ConfigMaps mounted into the Pods,
+		// the Operator's own state store Secrets are never mounted so are ignored
+		Watches(&coreV1.Secret{}, handler.EnqueueRequestsFromMapFunc(in.findCoherenceForSecret),
+			builder.WithPredicates(predicate.NewPredicateFuncs(isNotStateStore))).
+		Watches(&coreV1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(in.findCoherenceForConfigMap))
+
+	if operator.ShouldSupportSnapshots() {
+		// Watch for scheduled snapshots completing so that the status can be updated
+		b = b.Owns(&coh.CoherenceSnapshot{}, builder.WithPredicates(predicates.SnapshotFinishedPredicate{}))
+	}
+
+	return b.Complete(in)
 }
 
 // GetReconciler returns this reconciler.
 func (in *CoherenceReconciler) GetReconciler() reconcile.Reconciler { return in }
 
+// indexRestartOnChange indexes the Coherence resources by the names of the Secrets and ConfigMaps
+// that restart their Pods when the content changes, so that a change to a Secret or ConfigMap is
+// mapped to the Coherence resources that reference it without listing every Coherence resource.
+func indexRestartOnChange(mgr ctrl.Manager) error {
+	ctx := context.Background()
+	indexer := mgr.GetFieldIndexer()
+	err := indexer.IndexField(ctx, &coh.Coherence{}, restartOnChangeSecretsIndex, func(o client.Object) []string {
+		return o.(*coh.Coherence).Spec.GetRestartOnChangeSecrets()
+	})
+	if err != nil {
+		return errors.Wrap(err, "indexing Coherence resources by restart on change Secrets")
+	}
+	err = indexer.IndexField(ctx, &coh.Coherence{}, restartOnChangeConfigMapsIndex, func(o client.Object) []string {
+		return o.(*coh.Coherence).Spec.GetRestartOnChangeConfigMaps()
+	})
+	if err != nil {
+		return errors.Wrap(err, "indexing Coherence resources by restart on change ConfigMaps")
+	}
+	return nil
+}
+
+// isNotStateStore returns false if an object is one of the Operator's state store Secrets.
+func isNotStateStore(o client.Object) bool {
+	return o.GetLabels()[coh.LabelCoherenceStore] != "true"
+}
+
+// findCoherenceForSecret returns reconcile requests for the Coherence resources
+// that restart their Pods when the content of the specified Secret changes.
+func (in *CoherenceReconciler) findCoherenceForSecret(ctx context.Context, o client.Object) []reconcile.Request {
+	return in.findCoherenceReferencing(ctx, o, restartOnChangeSecretsIndex)
+}
+
+// findCoherenceForConfigMap returns reconcile requests for the Coherence resources
+// that restart their Pods when the content of the specified ConfigMap changes.
+func (in *CoherenceReconciler) findCoherenceForConfigMap(ctx context.Context, o client.Object) []reconcile.Request {
+	return in.findCoherenceReferencing(ctx, o, restartOnChangeConfigMapsIndex)
+}
+
+// findCoherenceReferencing returns reconcile requests for the Coherence resources in the namespace
+// of an object that have the name of the object in the specified field index.
+func (in *CoherenceReconciler) findCoherenceReferencing(ctx context.Context, o client.Object, index string) []reconcile.Request {
+	list := &coh.CoherenceList{}
+	if err := in.GetClient().List(ctx, list, client.InNamespace(o.GetNamespace()), client.MatchingFields{index: o.GetName()}); err != nil {
+		in.Log.Error(err, "Error listing Coherence resources", "Namespace", o.GetNamespace(), "Index", index)
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, c := range list.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: c.Namespace, Name: c.Name}})
+	}
+	return requests
+}
+
 // watchSecondaryResource registers the secondary resource reconcilers to watch the resources to be reconciled
 func watchSecondaryResource(mgr ctrl.Manager, s reconciler.SecondaryResourceReconciler, owner coh.CoherenceResource) error {
 	var err error
@@ -427,3 +763,13 @@ func SetupMonitoringResources(mgr ctrl.Manager) {
 	}
 	mgr.GetScheme().AddKnownTypes(gv, &monitoringv1.ServiceMonitor{}, &monitoringv1.ServiceMonitorList{})
 }
+
+// SetupGatewayResources ensures the Gateway API types are registered with the manager.
+func SetupGatewayResources(mgr ctrl.Manager) error {
+	return gatewayv1.Install(mgr.GetScheme())
+}
+
+// SetupCertManagerResources ensures the cert-manager types are registered with the manager.
+func SetupCertManagerResources(mgr ctrl.Manager) error {
+	return certmanagerv1.AddToScheme(mgr.GetScheme())
+}
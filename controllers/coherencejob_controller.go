@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2020, 2025, Oracle and/or its affiliates.
+ * Copyright (c) 2020, 2026, Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * http://oss.oracle.com/licenses/upl.
  */
@@ -10,13 +10,17 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-logr/logr"
+	"github.com/oracle/coherence-operator/controllers/certificate"
+	"github.com/oracle/coherence-operator/controllers/httproute"
 	"github.com/oracle/coherence-operator/controllers/job"
 	"github.com/oracle/coherence-operator/controllers/reconciler"
 	"github.com/oracle/coherence-operator/controllers/secret"
 	"github.com/oracle/coherence-operator/controllers/servicemonitor"
 	"github.com/oracle/coherence-operator/pkg/clients"
+	"github.com/oracle/coherence-operator/pkg/metrics"
 	"github.com/oracle/coherence-operator/pkg/operator"
 	"github.com/oracle/coherence-operator/pkg/rest"
+	"github.com/oracle/coherence-operator/pkg/tracing"
 	"github.com/oracle/coherence-operator/pkg/utils"
 	"github.com/pkg/errors"
 	coreV1 "k8s.io/api/core/v1"
@@ -54,8 +58,11 @@ var (
 var _ reconcile.Reconciler = &CoherenceJobReconciler{}
 
 func (in *CoherenceJobReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	ctx, span := tracing.StartForResource(ctx, "CoherenceJobReconciler.Reconcile", coh.ResourceTypeCoherenceJob.Name(), request.NamespacedName)
 	deployment := &coh.CoherenceJob{}
-	return in.ReconcileDeployment(ctx, request, deployment)
+	result, err := in.ReconcileDeployment(ctx, request, deployment)
+	tracing.End(span, err)
+	return result, err
 }
 
 func (in *CoherenceJobReconciler) ReconcileDeployment(ctx context.Context, request ctrl.Request, deployment *coh.CoherenceJob) (ctrl.Result, error) {
@@ -82,11 +89,13 @@ func (in *CoherenceJobReconciler) ReconcileDeployment(ctx context.Context, reque
 			// Owned objects are automatically garbage collected.
 			// Return and don't requeue
 			log.Info("CoherenceJob resource not found. Ignoring request since object must be deleted.")
+			metrics.DeleteResource(coh.ResourceTypeCoherenceJob.Name(), request.NamespacedName)
 			return ctrl.Result{}, nil
 		}
 		// Error reading the current deployment state from k8s.
 		return reconcile.Result{}, errors.Wrap(err, "getting CoherenceJob resource")
 	}
+	metrics.SetPhase(coh.ResourceTypeCoherenceJob.Name(), request.NamespacedName, string(deployment.Status.Phase))
 
 	// Check whether this is a deletion
 	deleteTime := deployment.GetDeletionTimestamp()
@@ -206,7 +215,7 @@ func (in *CoherenceJobReconciler) ReconcileDeployment(ctx context.Context, reque
 	var failures []Failure
 	for _, rec := range in.reconcilers {
 		log.Info("Reconciling CoherenceJob resource secondary resources", "controller", rec.GetControllerName())
-		r, err := rec.ReconcileAllResourceOfKind(ctx, request, deployment, storage)
+		r, err := reconcileSecondary(ctx, rec, coh.ResourceTypeCoherenceJob, request, deployment, storage)
 		if err != nil {
 			failures = append(failures, Failure{Name: rec.GetControllerName(), Error: err})
 			result.RequeueAfter = time.Minute
@@ -241,6 +250,12 @@ func (in *CoherenceJobReconciler) ReconcileDeployment(ctx context.Context, reque
 
 func (in *CoherenceJobReconciler) SetupWithManager(mgr ctrl.Manager, cs clients.ClientSet) error {
 	SetupMonitoringResources(mgr)
+	if err := SetupGatewayResources(mgr); err != nil {
+		return err
+	}
+	if err := SetupCertManagerResources(mgr); err != nil {
+		return err
+	}
 
 	// Create the sub-resource reconcilers IN THE ORDER THAT RESOURCES MUST BE CREATED.
 	// This is important to ensure, for example, that a ConfigMap is created before the
@@ -248,8 +263,12 @@ func (in *CoherenceJobReconciler) SetupWithManager(mgr ctrl.Manager, cs clients.
 	reconcilers := []reconciler.SecondaryResourceReconciler{
 		reconciler.NewNamedConfigMapReconciler(mgr, cs, "controllers.JobConfigMap"),
 		secret.NewNamedSecretReconciler(mgr, cs, "controllers.JobSecret"),
+		certificate.NewNamedCertificateReconciler(mgr, cs, "controllers.JobCertificate"),
 		reconciler.NewNamedServiceReconciler(mgr, cs, "controllers.JobService"),
 		servicemonitor.NewNamedServiceMonitorReconciler(mgr, cs, "controllers.JobServiceMonitor"),
+		reconciler.NewNamedNetworkPolicyReconciler(mgr, cs, "controllers.JobNetworkPolicy"),
+		reconciler.NewNamedIngressReconciler(mgr, cs, "controllers.JobIngress"),
+		httproute.NewNamedHTTPRouteReconciler(mgr, cs, "controllers.JobHTTPRoute"),
 		job.NewJobReconciler(mgr, cs),
 	}
 
@@ -0,0 +1,419 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/reconciler"
+	"github.com/oracle/coherence-operator/pkg/clients"
+	"github.com/oracle/coherence-operator/pkg/events"
+	mgmt "github.com/oracle/coherence-operator/pkg/management"
+	"github.com/oracle/coherence-operator/pkg/probe"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	coreV1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// restoreControllerName is the name of the CoherenceRestore controller.
+	restoreControllerName = "controllers.CoherenceRestore"
+	// restorePollInterval is the interval between checks of an in-progress restore.
+	restorePollInterval = time.Second * 10
+)
+
+// CoherenceRestoreReconciler reconciles a CoherenceRestore object
+type CoherenceRestoreReconciler struct {
+	client.Client
+	reconciler.CommonReconciler
+	ClientSet clients.ClientSet
+	Log       logr.Logger
+	Scheme    *runtime.Scheme
+	// HTTPClient is the client used to call Coherence management over REST.
+	// If not set a default client is used.
+	HTTPClient *http.Client
+}
+
+// blank assignment to verify that CoherenceRestoreReconciler implements reconcile.Reconciler
+// There will be a compile-time error here if this breaks
+var _ reconcile.Reconciler = &CoherenceRestoreReconciler{}
+
+func (in *CoherenceRestoreReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	log := in.Log.WithValues("namespace", request.Namespace, "name", request.Name)
+
+	restore := &coh.CoherenceRestore{}
+	err := in.GetClient().Get(ctx, request.NamespacedName, restore)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			return ctrl.Result{}, nil
+		}
+		return reconcile.Result{}, errors.Wrap(err, "getting CoherenceRestore resource")
+	}
+
+	if restore.GetDeletionTimestamp() != nil {
+		// nothing to do, the restore is being deleted
+		return ctrl.Result{}, nil
+	}
+
+	updated := restore.DeepCopy()
+	if updated.Status.ObservedGeneration != updated.Generation {
+		// this is a new restore, or the spec has been changed to retry the restore, so (re)start the restore
+		log.Info("Starting CoherenceRestore", "Snapshot", updated.Spec.SnapshotName, "Services", updated.Spec.Services)
+		now := metav1.Now()
+		updated.Status = coh.CoherenceRestoreStatus{
+			Phase:              coh.RestorePhasePending,
+			StartTime:          &now,
+			ObservedGeneration: updated.Generation,
+		}
+	} else if updated.Status.Phase.IsFinished() {
+		// nothing to do
+		return ctrl.Result{}, nil
+	}
+
+	result, err := in.processRestore(ctx, updated, log)
+	if patchErr := in.GetClient().Status().Patch(ctx, updated, client.MergeFrom(restore)); patchErr != nil {
+		return ctrl.Result{}, errors.Wrap(patchErr, "updating CoherenceRestore resource status")
+	}
+	return result, err
+}
+
+// processRestore runs each step of the restore in turn until a step is still in progress,
+// a step fails, or all the steps have completed.
+func (in *CoherenceRestoreReconciler) processRestore(ctx context.Context, restore *coh.CoherenceRestore, log logr.Logger) (ctrl.Result, error) {
+	status := &restore.Status
+
+	timeout := restore.Spec.GetTimeout()
+	timedOut := status.StartTime != nil && time.Since(status.StartTime.Time) > timeout
+
+	deployment, found, err := in.MaybeFindDeployment(ctx, restore.Namespace, restore.Spec.Coherence)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "getting Coherence resource %s", restore.Spec.Coherence)
+	}
+	if found && !deployment.Spec.Coherence.IsManagementEnabled() {
+		in.failRestore(restore, fmt.Sprintf("Coherence management over REST is not enabled in Coherence resource %s", deployment.Name))
+		in.finishRestore(restore)
+		return ctrl.Result{}, nil
+	}
+
+	host, port, msg, err := findManagementEndpoint(ctx, in.GetClient(), deployment, restore.Spec.Coherence)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	var sts *appsv1.StatefulSet
+	if msg == "" {
+		if sts, found, err = in.MaybeFindStatefulSet(ctx, deployment.Namespace, deployment.Name); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "getting StatefulSet %s", deployment.Name)
+		} else if !found {
+			msg = fmt.Sprintf("waiting for StatefulSet %s to be created", deployment.Name)
+		}
+	}
+	if msg != "" {
+		if timedOut {
+			in.failRestore(restore, fmt.Sprintf("restore did not complete within %s, %s", timeout, msg))
+			in.finishRestore(restore)
+			return ctrl.Result{}, nil
+		}
+		// the Coherence resource is not yet ready
+		log.Info("Waiting to process CoherenceRestore", "Reason", msg)
+		status.Message = msg
+		return ctrl.Result{RequeueAfter: restorePollInterval}, nil
+	}
+
+	cl := mgmt.GetHTTPClient(in.HTTPClient, mgmt.RequestTimeout)
+	if timedOut {
+		in.failRestore(restore, fmt.Sprintf("restore did not complete within %s", timeout))
+		log.Info("CoherenceRestore failed", "Reason", status.Message)
+		in.resumeAfterFailure(ctx, cl, restore, deployment, sts, host, port)
+		in.finishRestore(restore)
+		return ctrl.Result{}, nil
+	}
+
+	status.Phase = coh.RestorePhaseInProgress
+	status.Message = ""
+
+	for {
+		var done bool
+		step := status.GetStep()
+		switch step {
+		case coh.ConditionTypeSnapshotRetrieved:
+			done = in.retrieveSnapshot(cl, restore, host, port)
+		case coh.ConditionTypeServicesSuspended:
+			done = in.suspendServices(ctx, restore, deployment, sts)
+		case coh.ConditionTypeSnapshotRecovered:
+			done = in.recoverSnapshot(cl, restore, host, port)
+		case coh.ConditionTypeServicesResumed:
+			done = in.resumeServices(ctx, cl, restore, deployment, sts, host, port)
+		default:
+			// all steps have completed
+			status.Phase = coh.RestorePhaseCompleted
+			in.finishRestore(restore)
+			return ctrl.Result{}, nil
+		}
+
+		if status.Phase == coh.RestorePhaseFailed {
+			log.Info("CoherenceRestore failed", "Step", step, "Reason", status.Message)
+			in.resumeAfterFailure(ctx, cl, restore, deployment, sts, host, port)
+			in.finishRestore(restore)
+			return ctrl.Result{}, nil
+		}
+		if !done {
+			return ctrl.Result{RequeueAfter: restorePollInterval}, nil
+		}
+		log.Info("CoherenceRestore step completed", "Step", step)
+	}
+}
+
+// retrieveSnapshot retrieves the archived snapshot for each service, if required.
+func (in *CoherenceRestoreReconciler) retrieveSnapshot(cl *http.Client, restore *coh.CoherenceRestore, host string, port int32) bool {
+	status := &restore.Status
+	step := coh.ConditionTypeSnapshotRetrieved
+	name := restore.Spec.SnapshotName
+
+	if !restore.Spec.IsArchived() {
+		status.SetStepSkipped(step, "the snapshot is not archived")
+		return true
+	}
+
+	if c := status.Conditions.GetCondition(step); c == nil || c.Reason != coh.RestoreReasonInProgress {
+		for _, svc := range restore.Spec.Services {
+			snapshots, code, err := mgmt.GetSnapshots(cl, host, port, svc)
+			if err = mgmt.CheckResponse("list snapshots", code, err); err != nil {
+				status.SetStepFailed(step, fmt.Sprintf("service %s: %s", svc, err.Error()))
+				return false
+			}
+			if snapshots.Contains(name) {
+				// the snapshot has already been retrieved, possibly by a previous attempt
+				continue
+			}
+			code, err = mgmt.RetrieveArchivedSnapshot(cl, host, port, svc, name)
+			if err = mgmt.CheckResponse("retrieve archived snapshot", code, err); err != nil {
+				status.SetStepFailed(step, fmt.Sprintf("service %s: %s", svc, err.Error()))
+				return false
+			}
+		}
+		status.SetStepInProgress(step, fmt.Sprintf("retrieving archived snapshot %s", name))
+		return false
+	}
+
+	if !in.isPersistenceIdle(cl, restore, step, host, port) {
+		return false
+	}
+	for _, svc := range restore.Spec.Services {
+		snapshots, code, err := mgmt.GetSnapshots(cl, host, port, svc)
+		if err = mgmt.CheckResponse("list snapshots", code, err); err != nil {
+			status.SetStepFailed(step, fmt.Sprintf("service %s: %s", svc, err.Error()))
+			return false
+		}
+		if !snapshots.Contains(name) {
+			status.SetStepFailed(step, fmt.Sprintf("snapshot %s was not found for service %s after it was retrieved", name, svc))
+			return false
+		}
+	}
+	status.SetStepSucceeded(step, fmt.Sprintf("retrieved archived snapshot %s", name))
+	return true
+}
+
+// suspendServices suspends the Coherence services in the target deployment.
+func (in *CoherenceRestoreReconciler) suspendServices(ctx context.Context, restore *coh.CoherenceRestore, deployment *coh.Coherence, sts *appsv1.StatefulSet) bool {
+	step := coh.ConditionTypeServicesSuspended
+	p := probe.CoherenceProbe{
+		Client:        in.GetClient(),
+		Config:        in.GetManager().GetConfig(),
+		EventRecorder: events.NewOwnedEventRecorder(restore, in.GetEventRecorder()),
+	}
+
+	switch p.SuspendServices(ctx, deployment, sts) {
+	case probe.ServiceSuspendSuccessful:
+		restore.Status.SetStepSucceeded(step, fmt.Sprintf("suspended Coherence services in StatefulSet %s", sts.Name))
+		return true
+	case probe.ServiceSuspendSkipped:
+		restore.Status.SetStepSkipped(step, fmt.Sprintf("suspension of Coherence services in StatefulSet %s is disabled", sts.Name))
+		return true
+	default:
+		restore.Status.SetStepFailed(step, fmt.Sprintf("failed to suspend Coherence services in StatefulSet %s", sts.Name))
+		return false
+	}
+}
+
+// recoverSnapshot recovers the snapshot for each service.
+func (in *CoherenceRestoreReconciler) recoverSnapshot(cl *http.Client, restore *coh.CoherenceRestore, host string, port int32) bool {
+	status := &restore.Status
+	step := coh.ConditionTypeSnapshotRecovered
+	name := restore.Spec.SnapshotName
+
+	if c := status.Conditions.GetCondition(step); c == nil || c.Reason != coh.RestoreReasonInProgress {
+		for _, svc := range restore.Spec.Services {
+			code, err := mgmt.RecoverSnapshot(cl, host, port, svc, name)
+			if err = mgmt.CheckResponse("recover snapshot", code, err); err != nil {
+				status.SetStepFailed(step, fmt.Sprintf("service %s: %s", svc, err.Error()))
+				return false
+			}
+		}
+		status.SetStepInProgress(step, fmt.Sprintf("recovering snapshot %s", name))
+		return false
+	}
+
+	if !in.isPersistenceIdle(cl, restore, step, host, port) {
+		return false
+	}
+	status.SetStepSucceeded(step, fmt.Sprintf("recovered snapshot %s", name))
+	return true
+}
+
+// resumeServices resumes the Coherence services that the target deployment allows to be
+// automatically resumed, using the same rules as the Operator uses when a Coherence Pod starts.
+func (in *CoherenceRestoreReconciler) resumeServices(ctx context.Context, cl *http.Client, restore *coh.CoherenceRestore, deployment *coh.Coherence, sts *appsv1.StatefulSet, host string, port int32) bool {
+	status := &restore.Status
+	step := coh.ConditionTypeServicesResumed
+
+	if c := status.Conditions.GetCondition(coh.ConditionTypeServicesSuspended); c != nil && c.Reason == coh.RestoreReasonSkipped {
+		status.SetStepSkipped(step, "Coherence services were not suspended")
+		return true
+	}
+
+	msg, err := in.resume(ctx, cl, restore, deployment, sts, host, port)
+	if err != nil {
+		status.SetStepFailed(step, err.Error())
+		return false
+	}
+	status.SetStepSucceeded(step, msg)
+	return true
+}
+
+// resumeAfterFailure resumes the Coherence services after the restore has failed, if the restore
+// suspended them and has not yet resumed them, so that a failed restore does not leave the services
+// suspended. The outcome is recorded in the ServicesResumed condition and in an event, the phase
+// and message of the restore still describe the failure.
+func (in *CoherenceRestoreReconciler) resumeAfterFailure(ctx context.Context, cl *http.Client, restore *coh.CoherenceRestore, deployment *coh.Coherence, sts *appsv1.StatefulSet, host string, port int32) {
+	status := &restore.Status
+	step := coh.ConditionTypeServicesResumed
+
+	if c := status.Conditions.GetCondition(coh.ConditionTypeServicesSuspended); c == nil || !c.IsTrue() || c.Reason != coh.RestoreReasonSucceeded {
+		// the restore did not suspend the services
+		return
+	}
+	if status.Conditions.GetCondition(step) != nil {
+		// the services have already been resumed, or resuming them was the step that failed
+		return
+	}
+
+	msg, err := in.resume(ctx, cl, restore, deployment, sts, host, port)
+	if err != nil {
+		msg = fmt.Sprintf("failed to resume Coherence services after the restore failed: %s", err.Error())
+		status.Conditions.SetCondition(coh.Condition{Type: step, Status: coreV1.ConditionFalse, Reason: coh.RestoreReasonFailed, Message: msg})
+		in.GetEventRecorder().Eventf(restore, nil, coreV1.EventTypeWarning, reconciler.EventReasonFailed, "ResumeServices", msg)
+		return
+	}
+	msg = fmt.Sprintf("%s after the restore failed", msg)
+	status.Conditions.SetCondition(coh.Condition{Type: step, Status: coreV1.ConditionTrue, Reason: coh.RestoreReasonSucceeded, Message: msg})
+	in.GetEventRecorder().Eventf(restore, nil, coreV1.EventTypeNormal, reconciler.EventReasonUpdated, "ResumeServices", msg)
+}
+
+// resume resumes the Coherence services that the target deployment allows to be automatically
+// resumed, recording the resumed and still suspended services in the status of the restore.
+func (in *CoherenceRestoreReconciler) resume(ctx context.Context, cl *http.Client, restore *coh.CoherenceRestore, deployment *coh.Coherence, sts *appsv1.StatefulSet, host string, port int32) (string, error) {
+	services, code, err := mgmt.GetServices(cl, host, port)
+	if err = mgmt.CheckResponse("list services", code, err); err != nil {
+		return "", err
+	}
+
+	stsSpec, _ := deployment.GetStatefulSetSpec()
+	var resume, suspended []string
+	seen := make(map[string]bool)
+	for _, svc := range services.Items {
+		if svc.Name == "" || seen[svc.Name] {
+			continue
+		}
+		seen[svc.Name] = true
+		if stsSpec.IsAutoResumeService(svc.Name) {
+			resume = append(resume, svc.Name)
+		} else {
+			suspended = append(suspended, svc.Name)
+		}
+	}
+
+	p := probe.CoherenceProbe{
+		Client: in.GetClient(),
+		Config: in.GetManager().GetConfig(),
+	}
+	if err = p.ResumeServices(ctx, deployment, sts, resume); err != nil {
+		return "", err
+	}
+
+	restore.Status.ResumedServices = resume
+	restore.Status.SuspendedServices = suspended
+	msg := "resumed Coherence services"
+	if len(suspended) > 0 {
+		msg = fmt.Sprintf("%s, services %s were not resumed as auto-resume is disabled", msg, strings.Join(suspended, ","))
+	}
+	return msg, nil
+}
+
+// isPersistenceIdle returns true if there are no persistence operations running for any
+// of the services being restored. If the persistence status cannot be obtained the step is failed.
+func (in *CoherenceRestoreReconciler) isPersistenceIdle(cl *http.Client, restore *coh.CoherenceRestore, step coh.ConditionType, host string, port int32) bool {
+	for _, svc := range restore.Spec.Services {
+		persistence, code, err := mgmt.GetPersistence(cl, host, port, svc)
+		if err = mgmt.CheckResponse("get persistence status", code, err); err != nil {
+			restore.Status.SetStepFailed(step, fmt.Sprintf("service %s: %s", svc, err.Error()))
+			return false
+		}
+		if !persistence.IsIdle() {
+			return false
+		}
+	}
+	return true
+}
+
+// failRestore fails the current step of the restore.
+func (in *CoherenceRestoreReconciler) failRestore(restore *coh.CoherenceRestore, msg string) {
+	if step := restore.Status.GetStep(); step != "" {
+		restore.Status.SetStepFailed(step, msg)
+	} else {
+		restore.Status.Phase = coh.RestorePhaseFailed
+		restore.Status.Message = msg
+	}
+}
+
+// finishRestore records the completion of the restore.
+func (in *CoherenceRestoreReconciler) finishRestore(restore *coh.CoherenceRestore) {
+	now := metav1.Now()
+	restore.Status.CompletionTime = &now
+	if restore.Status.Phase == coh.RestorePhaseFailed {
+		in.GetEventRecorder().Eventf(restore, nil, coreV1.EventTypeWarning, reconciler.EventReasonFailed, "RestoreSnapshot",
+			"restore of snapshot %s to Coherence resource %s failed: %s", restore.Spec.SnapshotName, restore.Spec.Coherence, restore.Status.Message)
+	} else {
+		in.GetEventRecorder().Eventf(restore, nil, coreV1.EventTypeNormal, reconciler.EventReasonUpdated, "RestoreSnapshot",
+			"restore of snapshot %s to Coherence resource %s completed", restore.Spec.SnapshotName, restore.Spec.Coherence)
+	}
+}
+
+func (in *CoherenceRestoreReconciler) SetupWithManager(mgr ctrl.Manager, cs clients.ClientSet) error {
+	in.SetCommonReconciler(restoreControllerName, mgr, cs)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&coh.CoherenceRestore{}).
+		Named("coherencerestore").
+		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
+		Complete(in)
+}
+
+func (in *CoherenceRestoreReconciler) GetReconciler() reconcile.Reconciler { return in }
@@ -0,0 +1,352 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/reconciler"
+	"github.com/oracle/coherence-operator/pkg/clients"
+	mgmt "github.com/oracle/coherence-operator/pkg/management"
+	"github.com/pkg/errors"
+	coreV1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// snapshotControllerName is the name of the CoherenceSnapshot controller.
+	snapshotControllerName = "controllers.CoherenceSnapshot"
+	// snapshotPollInterval is the interval between checks of an in-progress snapshot.
+	snapshotPollInterval = time.Second * 10
+)
+
+// CoherenceSnapshotReconciler reconciles a CoherenceSnapshot object
+type CoherenceSnapshotReconciler struct {
+	client.Client
+	reconciler.CommonReconciler
+	ClientSet clients.ClientSet
+	Log       logr.Logger
+	Scheme    *runtime.Scheme
+	// HTTPClient is the client used to call Coherence management over REST.
+	// If not set a default client is used.
+	HTTPClient *http.Client
+}
+
+// blank assignment to verify that CoherenceSnapshotReconciler implements reconcile.Reconciler
+// There will be a compile-time error here if this breaks
+var _ reconcile.Reconciler = &CoherenceSnapshotReconciler{}
+
+func (in *CoherenceSnapshotReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	log := in.Log.WithValues("namespace", request.Namespace, "name", request.Name)
+
+	snapshot := &coh.CoherenceSnapshot{}
+	err := in.GetClient().Get(ctx, request.NamespacedName, snapshot)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			return ctrl.Result{}, nil
+		}
+		return reconcile.Result{}, errors.Wrap(err, "getting CoherenceSnapshot resource")
+	}
+
+	// Check whether this is a deletion
+	if snapshot.GetDeletionTimestamp() != nil {
+		return ctrl.Result{}, in.finalizeSnapshot(ctx, snapshot, log)
+	}
+
+	// The finalizer is only required if the snapshot must be removed when this resource is deleted
+	if snapshot.Spec.IsRemoveOnDelete() != controllerutil.ContainsFinalizer(snapshot, coh.CoherenceFinalizer) {
+		if snapshot.Spec.IsRemoveOnDelete() {
+			controllerutil.AddFinalizer(snapshot, coh.CoherenceFinalizer)
+		} else {
+			controllerutil.RemoveFinalizer(snapshot, coh.CoherenceFinalizer)
+		}
+		if err = in.GetClient().Update(ctx, snapshot); err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "updating CoherenceSnapshot resource finalizer")
+		}
+	}
+
+	updated := snapshot.DeepCopy()
+	if updated.Status.ObservedGeneration != updated.Generation {
+		// this is a new snapshot, or the spec has been changed, so (re)start the snapshot
+		log.Info("Starting CoherenceSnapshot", "Snapshot", updated.GetSnapshotName(), "Services", updated.Spec.Services)
+		in.resetSnapshotStatus(updated)
+	} else if updated.Status.Phase.IsFinished() {
+		// nothing to do
+		return ctrl.Result{}, nil
+	}
+
+	result, err := in.processSnapshot(ctx, updated, log)
+	if patchErr := in.GetClient().Status().Patch(ctx, updated, client.MergeFrom(snapshot)); patchErr != nil {
+		return ctrl.Result{}, errors.Wrap(patchErr, "updating CoherenceSnapshot resource status")
+	}
+	return result, err
+}
+
+// resetSnapshotStatus resets the status of the snapshot so that all services are pending.
+func (in *CoherenceSnapshotReconciler) resetSnapshotStatus(snapshot *coh.CoherenceSnapshot) {
+	now := metav1.Now()
+	status := coh.CoherenceSnapshotStatus{
+		Phase:              coh.SnapshotPhasePending,
+		SnapshotName:       snapshot.GetSnapshotName(),
+		StartTime:          &now,
+		ObservedGeneration: snapshot.Generation,
+	}
+	for _, name := range snapshot.Spec.Services {
+		s := coh.SnapshotServiceStatus{Name: name}
+		s.SetPhase(coh.SnapshotPhasePending, "")
+		status.Services = append(status.Services, s)
+	}
+	snapshot.Status = status
+}
+
+// processSnapshot moves the snapshot of each service on to its next phase.
+func (in *CoherenceSnapshotReconciler) processSnapshot(ctx context.Context, snapshot *coh.CoherenceSnapshot, log logr.Logger) (ctrl.Result, error) {
+	status := &snapshot.Status
+
+	timeout := snapshot.Spec.GetTimeout()
+	if status.StartTime != nil && time.Since(status.StartTime.Time) > timeout {
+		in.failUnfinishedServices(snapshot, fmt.Sprintf("snapshot did not complete within %s", timeout))
+		return ctrl.Result{}, nil
+	}
+
+	deployment, found, err := in.MaybeFindDeployment(ctx, snapshot.Namespace, snapshot.Spec.Coherence)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "getting Coherence resource %s", snapshot.Spec.Coherence)
+	}
+	if found && !deployment.Spec.Coherence.IsManagementEnabled() {
+		in.failUnfinishedServices(snapshot, fmt.Sprintf("Coherence management over REST is not enabled in Coherence resource %s", deployment.Name))
+		return ctrl.Result{}, nil
+	}
+
+	host, port, msg, err := findManagementEndpoint(ctx, in.GetClient(), deployment, snapshot.Spec.Coherence)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if msg != "" {
+		// the Coherence resource is not yet ready
+		log.Info("Waiting to process CoherenceSnapshot", "Reason", msg)
+		status.Message = msg
+		return ctrl.Result{RequeueAfter: snapshotPollInterval}, nil
+	}
+
+	status.Message = ""
+	cl := mgmt.GetHTTPClient(in.HTTPClient, mgmt.RequestTimeout)
+	for i := range status.Services {
+		in.processServiceSnapshot(cl, snapshot, &status.Services[i], host, port)
+	}
+
+	status.UpdatePhase()
+	if !status.Phase.IsFinished() {
+		return ctrl.Result{RequeueAfter: snapshotPollInterval}, nil
+	}
+	in.finishSnapshot(snapshot)
+	return ctrl.Result{}, nil
+}
+
+// processServiceSnapshot moves the snapshot of a single service on to its next phase.
+func (in *CoherenceSnapshotReconciler) processServiceSnapshot(cl *http.Client, snapshot *coh.CoherenceSnapshot, s *coh.SnapshotServiceStatus, host string, port int32) {
+	name := snapshot.Status.SnapshotName
+
+	switch s.Phase {
+	case coh.SnapshotPhasePending:
+		snapshots, err := in.listSnapshots(cl, host, port, s)
+		if err != nil {
+			s.SetPhase(coh.SnapshotPhaseFailed, err.Error())
+			return
+		}
+		if snapshots.Contains(name) {
+			// the snapshot already exists, possibly from a previous attempt
+			in.snapshotCreated(cl, snapshot, s, host, port)
+			return
+		}
+		status, err := mgmt.CreateSnapshot(cl, host, port, s.Name, name)
+		if err = mgmt.CheckResponse("create snapshot", status, err); err != nil {
+			s.SetPhase(coh.SnapshotPhaseFailed, err.Error())
+			return
+		}
+		s.SetPhase(coh.SnapshotPhaseInProgress, "creating snapshot")
+	case coh.SnapshotPhaseInProgress, coh.SnapshotPhaseArchiving:
+		persistence, status, err := mgmt.GetPersistence(cl, host, port, s.Name)
+		if err = mgmt.CheckResponse("get persistence status", status, err); err != nil {
+			s.SetPhase(coh.SnapshotPhaseFailed, err.Error())
+			return
+		}
+		if !persistence.IsIdle() {
+			// the persistence operation is still running
+			return
+		}
+		if s.Phase == coh.SnapshotPhaseArchiving {
+			in.checkArchived(cl, name, s, host, port)
+			return
+		}
+		snapshots, err := in.listSnapshots(cl, host, port, s)
+		switch {
+		case err != nil:
+			s.SetPhase(coh.SnapshotPhaseFailed, err.Error())
+		case !snapshots.Contains(name):
+			s.SetPhase(coh.SnapshotPhaseFailed, fmt.Sprintf("snapshot %s was not found after the snapshot operation completed", name))
+		default:
+			in.snapshotCreated(cl, snapshot, s, host, port)
+		}
+	}
+}
+
+// snapshotCreated either starts archiving the snapshot or marks the service snapshot as completed.
+func (in *CoherenceSnapshotReconciler) snapshotCreated(cl *http.Client, snapshot *coh.CoherenceSnapshot, s *coh.SnapshotServiceStatus, host string, port int32) {
+	if !snapshot.Spec.IsArchive() {
+		s.SetPhase(coh.SnapshotPhaseCompleted, "snapshot created")
+		return
+	}
+	status, err := mgmt.ArchiveSnapshot(cl, host, port, s.Name, snapshot.Status.SnapshotName)
+	if err = mgmt.CheckResponse("archive snapshot", status, err); err != nil {
+		s.SetPhase(coh.SnapshotPhaseFailed, err.Error())
+		return
+	}
+	s.SetPhase(coh.SnapshotPhaseArchiving, "archiving snapshot")
+}
+
+// checkArchived verifies that an archive operation created the archived snapshot.
+func (in *CoherenceSnapshotReconciler) checkArchived(cl *http.Client, name string, s *coh.SnapshotServiceStatus, host string, port int32) {
+	archives, status, err := mgmt.GetArchivedSnapshots(cl, host, port, s.Name)
+	err = mgmt.CheckResponse("list archived snapshots", status, err)
+	switch {
+	case err != nil:
+		s.SetPhase(coh.SnapshotPhaseFailed, err.Error())
+	case !archives.Contains(name):
+		s.SetPhase(coh.SnapshotPhaseFailed, fmt.Sprintf("archived snapshot %s was not found after the archive operation completed", name))
+	default:
+		s.Archived = true
+		s.SetPhase(coh.SnapshotPhaseCompleted, "snapshot created and archived")
+	}
+}
+
+// listSnapshots lists the snapshots for a service, updating the service status with the result.
+func (in *CoherenceSnapshotReconciler) listSnapshots(cl *http.Client, host string, port int32, s *coh.SnapshotServiceStatus) (*mgmt.SnapshotsData, error) {
+	snapshots, status, err := mgmt.GetSnapshots(cl, host, port, s.Name)
+	if err = mgmt.CheckResponse("list snapshots", status, err); err != nil {
+		return nil, err
+	}
+	s.Snapshots = snapshots.Snapshots
+	return snapshots, nil
+}
+
+// failUnfinishedServices marks all services that have not finished as failed.
+func (in *CoherenceSnapshotReconciler) failUnfinishedServices(snapshot *coh.CoherenceSnapshot, msg string) {
+	for i := range snapshot.Status.Services {
+		if !snapshot.Status.Services[i].Phase.IsFinished() {
+			snapshot.Status.Services[i].SetPhase(coh.SnapshotPhaseFailed, msg)
+		}
+	}
+	snapshot.Status.Message = msg
+	snapshot.Status.UpdatePhase()
+	in.finishSnapshot(snapshot)
+}
+
+// finishSnapshot records the completion of the snapshot.
+func (in *CoherenceSnapshotReconciler) finishSnapshot(snapshot *coh.CoherenceSnapshot) {
+	now := metav1.Now()
+	snapshot.Status.CompletionTime = &now
+	if snapshot.Status.Phase == coh.SnapshotPhaseFailed {
+		in.GetEventRecorder().Eventf(snapshot, nil, coreV1.EventTypeWarning, reconciler.EventReasonFailed, "CreateSnapshot",
+			"snapshot %s of Coherence resource %s failed", snapshot.Status.SnapshotName, snapshot.Spec.Coherence)
+	} else {
+		in.GetEventRecorder().Eventf(snapshot, nil, coreV1.EventTypeNormal, reconciler.EventReasonCreated, "CreateSnapshot",
+			"snapshot %s of Coherence resource %s completed", snapshot.Status.SnapshotName, snapshot.Spec.Coherence)
+	}
+}
+
+// finalizeSnapshot removes the snapshot from the Coherence cluster, if required, and then removes the finalizer.
+func (in *CoherenceSnapshotReconciler) finalizeSnapshot(ctx context.Context, snapshot *coh.CoherenceSnapshot, log logr.Logger) error {
+	if !controllerutil.ContainsFinalizer(snapshot, coh.CoherenceFinalizer) {
+		return nil
+	}
+
+	if snapshot.Spec.IsRemoveOnDelete() {
+		in.removeSnapshot(ctx, snapshot, log)
+	}
+
+	controllerutil.RemoveFinalizer(snapshot, coh.CoherenceFinalizer)
+	if err := in.GetClient().Update(ctx, snapshot); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "trying to remove finalizer from CoherenceSnapshot resource")
+	}
+	return nil
+}
+
+// removeSnapshot removes the snapshot and any archived snapshot for each service.
+// This is a best effort, any failures are logged and reported as events but do not
+// prevent the CoherenceSnapshot resource being deleted.
+func (in *CoherenceSnapshotReconciler) removeSnapshot(ctx context.Context, snapshot *coh.CoherenceSnapshot, log logr.Logger) {
+	name := snapshot.Status.SnapshotName
+	if name == "" {
+		return
+	}
+
+	deployment, _, err := in.MaybeFindDeployment(ctx, snapshot.Namespace, snapshot.Spec.Coherence)
+	var host, msg string
+	var port int32
+	if err == nil {
+		host, port, msg, err = findManagementEndpoint(ctx, in.GetClient(), deployment, snapshot.Spec.Coherence)
+	}
+	if err != nil || msg != "" {
+		if err != nil {
+			msg = err.Error()
+		}
+		log.Info("Cannot remove snapshot", "Snapshot", name, "Reason", msg)
+		in.GetEventRecorder().Eventf(snapshot, nil, coreV1.EventTypeWarning, reconciler.EventReasonFailed, "RemoveSnapshot",
+			"cannot remove snapshot %s: %s", name, msg)
+		return
+	}
+
+	cl := mgmt.GetHTTPClient(in.HTTPClient, mgmt.RequestTimeout)
+	for _, s := range snapshot.Status.Services {
+		if s.Phase == coh.SnapshotPhasePending {
+			// the snapshot was never started for this service
+			continue
+		}
+		status, err := mgmt.RemoveSnapshot(cl, host, port, s.Name, name)
+		if err = mgmt.CheckResponse("remove snapshot", status, err); err != nil && status != http.StatusNotFound {
+			log.Info("Failed to remove snapshot", "Snapshot", name, "Service", s.Name, "Error", err.Error())
+			in.GetEventRecorder().Eventf(snapshot, nil, coreV1.EventTypeWarning, reconciler.EventReasonFailed, "RemoveSnapshot",
+				"failed to remove snapshot %s for service %s: %s", name, s.Name, err.Error())
+		}
+		if s.Archived {
+			status, err = mgmt.RemoveArchivedSnapshot(cl, host, port, s.Name, name)
+			if err = mgmt.CheckResponse("remove archived snapshot", status, err); err != nil && status != http.StatusNotFound {
+				log.Info("Failed to remove archived snapshot", "Snapshot", name, "Service", s.Name, "Error", err.Error())
+				in.GetEventRecorder().Eventf(snapshot, nil, coreV1.EventTypeWarning, reconciler.EventReasonFailed, "RemoveSnapshot",
+					"failed to remove archived snapshot %s for service %s: %s", name, s.Name, err.Error())
+			}
+		}
+	}
+	in.GetEventRecorder().Eventf(snapshot, nil, coreV1.EventTypeNormal, reconciler.EventReasonDeleted, "RemoveSnapshot",
+		"removed snapshot %s from Coherence resource %s", name, snapshot.Spec.Coherence)
+}
+
+func (in *CoherenceSnapshotReconciler) SetupWithManager(mgr ctrl.Manager, cs clients.ClientSet) error {
+	in.SetCommonReconciler(snapshotControllerName, mgr, cs)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&coh.CoherenceSnapshot{}).
+		Named("coherencesnapshot").
+		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
+		Complete(in)
+}
+
+func (in *CoherenceSnapshotReconciler) GetReconciler() reconcile.Reconciler { return in }
@@ -0,0 +1,378 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+// Package drift detects changes made to the secondary resources managed by the Operator,
+// for example by "kubectl edit", by comparing them to the desired state held in the state store.
+package drift
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ReasonDriftDetected is the Drifted condition reason when resources have drifted.
+	ReasonDriftDetected coh.ConditionReason = "DriftDetected"
+	// ReasonNoDrift is the Drifted condition reason when no resources have drifted.
+	ReasonNoDrift coh.ConditionReason = "NoDrift"
+
+	// maxMessagePaths is the maximum number of field paths listed in the Drifted condition message.
+	maxMessagePaths = 20
+)
+
+// commonIgnoredPaths are the field paths that are never treated as drift, as they
+// are either set by the Operator outside the desired state or cannot be changed.
+var commonIgnoredPaths = []string{
+	"metadata.annotations[" + coh.AnnotationOperatorVersion + "]",
+	"stringData",
+}
+
+// statefulSetIgnoredPaths are the StatefulSet field paths that are never treated as drift.
+// The replicas are changed by scaling, the partition by upgrades and the volume claim templates,
+// container commands and arguments are not patched by the Operator.
+// A change to the config hash is a pending update of the Pods rather than drift.
+var statefulSetIgnoredPaths = []string{
+	"spec.replicas",
+	"spec.updateStrategy.rollingUpdate.partition",
+	"spec.volumeClaimTemplates",
+	"spec.template.metadata.annotations[" + coh.AnnotationConfigHash + "]",
+	"spec.template.spec.containers[*].command",
+	"spec.template.spec.containers[*].args",
+	"spec.template.spec.initContainers[*].command",
+}
+
+// Resource is a secondary resource that has drifted from its desired state.
+type Resource struct {
+	Kind  coh.ResourceType
+	Name  string
+	Paths []string
+}
+
+// Detector compares the live secondary resources of a Coherence resource with
+// the desired state in the state store.
+type Detector struct {
+	Client client.Client
+	Log    logr.Logger
+}
+
+// Detect returns the secondary resources that have drifted from the desired state in the store.
+// Resources that have not yet been updated to the latest desired state are skipped, as any
+// difference is a pending update rather than drift, as is a StatefulSet that has been rolled back.
+func (d *Detector) Detect(ctx context.Context, deployment *coh.Coherence, storage utils.Storage) ([]Resource, error) {
+	storeHash, _ := storage.GetHash()
+	var drifted []Resource
+	for _, r := range storage.GetLatest().Items {
+		if r.IsDelete() || r.Spec == nil {
+			continue
+		}
+		if r.Kind == coh.ResourceTypeStatefulSet && deployment.Status.IsRolledBack(deployment.Generation) {
+			// the StatefulSet has been rolled back to the previous state
+			continue
+		}
+		// get the live state into a new empty object of the same type
+		live, ok := reflect.New(reflect.TypeOf(r.Spec).Elem()).Interface().(client.Object)
+		if !ok {
+			continue
+		}
+		live.GetObjectKind().SetGroupVersionKind(r.Spec.GetObjectKind().GroupVersionKind())
+		err := d.Client.Get(ctx, types.NamespacedName{Namespace: deployment.Namespace, Name: r.Name}, live)
+		switch {
+		case err != nil && (apierrors.IsNotFound(err) || meta.IsNoMatchError(err)):
+			// the resource will be created by its reconciler, or its CRD is not installed
+			continue
+		case err != nil:
+			return nil, err
+		}
+		if live.GetLabels()[coh.LabelCoherenceHash] != storeHash {
+			continue
+		}
+		paths, err := Diff(r.Spec, live, IgnoredPaths(deployment, r.Kind)...)
+		if err != nil {
+			return nil, err
+		}
+		if len(paths) > 0 {
+			d.Log.Info("Detected drift", "Namespace", deployment.Namespace, "Name", deployment.Name, "Kind", r.Kind, "Resource", r.Name, "Paths", paths)
+			drifted = append(drifted, Resource{Kind: r.Kind, Name: r.Name, Paths: paths})
+		}
+	}
+	return drifted, nil
+}
+
+// GetPolicy returns the drift policy for a Coherence resource.
+// Drift is only checked for Coherence resources that run as a StatefulSet.
+func GetPolicy(deployment coh.CoherenceResource) coh.DriftPolicyType {
+	if deployment == nil {
+		return coh.DriftPolicyIgnore
+	}
+	spec, found := deployment.GetStatefulSetSpec()
+	if !found {
+		return coh.DriftPolicyIgnore
+	}
+	return spec.GetDriftPolicy()
+}
+
+// IgnoredPaths returns the field paths that are not treated as drift for a kind of resource.
+func IgnoredPaths(deployment coh.CoherenceResource, kind coh.ResourceType) []string {
+	ignored := append([]string{}, commonIgnoredPaths...)
+	if kind == coh.ResourceTypeStatefulSet {
+		ignored = append(ignored, statefulSetIgnoredPaths...)
+		if deployment != nil && deployment.GetSpec().Affinity == nil {
+			// the default affinity may have been changed by a newer Operator version
+			ignored = append(ignored, "spec.template.spec.affinity")
+		}
+	}
+	return ignored
+}
+
+// Condition returns the Drifted condition for the drifted resources.
+func Condition(drifted []Resource) coh.Condition {
+	if len(drifted) == 0 {
+		return coh.Condition{Type: coh.ConditionTypeDrifted, Status: corev1.ConditionFalse, Reason: ReasonNoDrift}
+	}
+	return coh.Condition{
+		Type:    coh.ConditionTypeDrifted,
+		Status:  corev1.ConditionTrue,
+		Reason:  ReasonDriftDetected,
+		Message: Message(drifted),
+	}
+}
+
+// Message returns a message listing the changed field paths of the drifted resources,
+// for example "StatefulSet/storage: spec.template.spec.containers[coherence].image".
+func Message(drifted []Resource) string {
+	var parts []string
+	count := 0
+	for _, r := range drifted {
+		var paths []string
+		for _, p := range r.Paths {
+			if count == maxMessagePaths {
+				break
+			}
+			paths = append(paths, p)
+			count++
+		}
+		if len(paths) > 0 {
+			parts = append(parts, fmt.Sprintf("%s/%s: %s", r.Kind, r.Name, strings.Join(paths, ", ")))
+		}
+	}
+	msg := strings.Join(parts, "; ")
+	total := 0
+	for _, r := range drifted {
+		total += len(r.Paths)
+	}
+	if total > count {
+		msg = fmt.Sprintf("%s; and %d more", msg, total-count)
+	}
+	return msg
+}
+
+// Diff returns the sorted paths of the fields set in the desired object that are missing from,
+// or have a different value in, the live object. Fields that are only set in the live object,
+// for example defaults applied by the API server, are not drift. Only the labels and annotations
+// of the metadata are compared, and the status is never compared.
+func Diff(desired, live runtime.Object, ignore ...string) ([]string, error) {
+	d, err := runtime.DefaultUnstructuredConverter.ToUnstructured(desired)
+	if err != nil {
+		return nil, err
+	}
+	l, err := runtime.DefaultUnstructuredConverter.ToUnstructured(live)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for k, v := range d {
+		switch k {
+		case "apiVersion", "kind", "status":
+			continue
+		case "metadata":
+			dm, _ := v.(map[string]interface{})
+			lm, _ := l[k].(map[string]interface{})
+			for _, mk := range []string{"labels", "annotations"} {
+				if dv, found := dm[mk]; found {
+					paths = diffValue("metadata."+mk, dv, lm[mk], ignore, paths)
+				}
+			}
+		default:
+			paths = diffValue(k, v, l[k], ignore, paths)
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+var indexPattern = regexp.MustCompile(`\[[^]]*]`)
+
+// isIgnored returns true if the path, or one of its parents, is in the ignored paths.
+// An ignored path may use [*] to match any list index or element name.
+func isIgnored(path string, ignore []string) bool {
+	wildcard := indexPattern.ReplaceAllString(path, "[*]")
+	for _, i := range ignore {
+		for _, p := range []string{path, wildcard} {
+			if p == i || strings.HasPrefix(p, i+".") || strings.HasPrefix(p, i+"[") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func diffValue(path string, desired, live interface{}, ignore []string, paths []string) []string {
+	if isIgnored(path, ignore) {
+		return paths
+	}
+	if live == nil {
+		if isZero(desired) {
+			// the API server omits empty values
+			return paths
+		}
+		return append(paths, path)
+	}
+
+	switch dv := desired.(type) {
+	case map[string]interface{}:
+		lv, ok := live.(map[string]interface{})
+		if !ok {
+			return append(paths, path)
+		}
+		for k, v := range dv {
+			paths = diffValue(childPath(path, k), v, lv[k], ignore, paths)
+		}
+	case []interface{}:
+		lv, ok := live.([]interface{})
+		if !ok {
+			return append(paths, path)
+		}
+		if names, named := elementNames(dv); named {
+			// lists of named elements, such as containers, env vars and ports, are
+			// matched by name as their order may be changed by patching
+			liveByName := make(map[string]interface{})
+			if liveNames, ok := elementNames(lv); ok {
+				for i, n := range liveNames {
+					liveByName[n] = lv[i]
+				}
+			}
+			for i, n := range names {
+				paths = diffValue(fmt.Sprintf("%s[%s]", path, n), dv[i], liveByName[n], ignore, paths)
+			}
+			return paths
+		}
+		if len(dv) != len(lv) {
+			return append(paths, path)
+		}
+		for i := range dv {
+			paths = diffValue(fmt.Sprintf("%s[%d]", path, i), dv[i], lv[i], ignore, paths)
+		}
+	default:
+		if !scalarEqual(desired, live) {
+			return append(paths, path)
+		}
+	}
+	return paths
+}
+
+// elementNames returns the names of the elements in a list if every element is a map with a name.
+func elementNames(list []interface{}) ([]string, bool) {
+	if len(list) == 0 {
+		return nil, false
+	}
+	names := make([]string, len(list))
+	for i, e := range list {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		n, ok := m["name"].(string)
+		if !ok || n == "" {
+			return nil, false
+		}
+		names[i] = n
+	}
+	return names, true
+}
+
+// childPath returns the path of a map entry, keys that are not simple names,
+// such as label and annotation keys, are enclosed in brackets.
+func childPath(path, key string) string {
+	if strings.ContainsAny(key, "./") {
+		return fmt.Sprintf("%s[%s]", path, key)
+	}
+	return path + "." + key
+}
+
+func scalarEqual(desired, live interface{}) bool {
+	if reflect.DeepEqual(desired, live) {
+		return true
+	}
+	if df, ok := toFloat(desired); ok {
+		lf, ok := toFloat(live)
+		return ok && df == lf
+	}
+	// the API server converts quantities to their canonical form, for example "1000m" to "1"
+	ds, dok := desired.(string)
+	ls, lok := live.(string)
+	if dok && lok {
+		dq, err := resource.ParseQuantity(ds)
+		if err != nil {
+			return false
+		}
+		lq, err := resource.ParseQuantity(ls)
+		return err == nil && dq.Cmp(lq) == 0
+	}
+	return false
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func isZero(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		for _, e := range t {
+			if !isZero(e) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		return len(t) == 0
+	case string:
+		return t == ""
+	case bool:
+		return !t
+	default:
+		f, ok := toFloat(v)
+		return ok && f == 0
+	}
+}
@@ -0,0 +1,295 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package drift_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/drift"
+	"github.com/oracle/coherence-operator/pkg/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestDiffIgnoresServerDefaults(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	desired := createService()
+	live := createService()
+	// fields defaulted by the API server are not drift
+	live.Spec.ClusterIP = "10.0.0.1"
+	live.Spec.SessionAffinity = corev1.ServiceAffinityNone
+	live.Spec.Ports[0].Protocol = corev1.ProtocolTCP
+	live.Labels["added"] = "by-someone-else"
+	live.ResourceVersion = "100"
+	live.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "10.0.0.2"}}
+
+	paths, err := drift.Diff(desired, live)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(paths).To(BeEmpty())
+}
+
+func TestDiffDetectsChangedFields(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	desired := createService()
+	live := createService()
+	live.Spec.Ports[0].Port = 8080
+	live.Spec.Type = corev1.ServiceTypeNodePort
+	live.Labels["app"] = "changed"
+
+	paths, err := drift.Diff(desired, live)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(paths).To(Equal([]string{
+		"metadata.labels.app",
+		"spec.ports[http].port",
+		"spec.type",
+	}))
+}
+
+func TestDiffMatchesNamedElementsByName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	desired := createStatefulSet()
+	live := createStatefulSet()
+	c := live.Spec.Template.Spec.Containers[0]
+	c.Env = []corev1.EnvVar{c.Env[1], c.Env[0]}
+	live.Spec.Template.Spec.Containers[0] = c
+
+	paths, err := drift.Diff(desired, live)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(paths).To(BeEmpty())
+
+	c.Env = c.Env[:1]
+	c.Image = "coherence:2.0"
+	live.Spec.Template.Spec.Containers[0] = c
+	paths, err = drift.Diff(desired, live)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(paths).To(Equal([]string{
+		"spec.template.spec.containers[coherence].env[FOO]",
+		"spec.template.spec.containers[coherence].image",
+	}))
+}
+
+func TestDiffComparesCanonicalQuantities(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	desired := createStatefulSet()
+	desired.Spec.Template.Spec.Containers[0].Resources.Requests = corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("1000m"),
+	}
+	live := createStatefulSet()
+	live.Spec.Template.Spec.Containers[0].Resources.Requests = corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("1"),
+	}
+
+	paths, err := drift.Diff(desired, live)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(paths).To(BeEmpty())
+}
+
+func TestDiffIgnoresStatefulSetPaths(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := &coh.Coherence{}
+	desired := createStatefulSet()
+	desired.Spec.Template.Spec.Containers[0].Command = []string{"/coherence-operator/utils/runner"}
+	desired.Spec.Template.Annotations = map[string]string{coh.AnnotationConfigHash: "original"}
+	live := createStatefulSet()
+	live.Spec.Replicas = ptr.To(int32(5))
+	live.Spec.Template.Spec.Containers[0].Command = []string{"java"}
+	live.Spec.Template.Annotations = map[string]string{coh.AnnotationConfigHash: "changed"}
+
+	paths, err := drift.Diff(desired, live, drift.IgnoredPaths(deployment, coh.ResourceTypeStatefulSet)...)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(paths).To(BeEmpty())
+
+	// without the ignored paths all the changes are drift
+	paths, err = drift.Diff(desired, live)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(paths).To(ConsistOf(
+		"spec.replicas",
+		"spec.template.metadata.annotations[com.oracle.coherence.operator/config-hash]",
+		"spec.template.spec.containers[coherence].command[0]",
+	))
+}
+
+func TestCondition(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := drift.Condition(nil)
+	g.Expect(c.Type).To(Equal(coh.ConditionTypeDrifted))
+	g.Expect(c.IsFalse()).To(BeTrue())
+	g.Expect(c.Reason).To(Equal(drift.ReasonNoDrift))
+
+	c = drift.Condition([]drift.Resource{
+		{Kind: coh.ResourceTypeStatefulSet, Name: "storage", Paths: []string{"spec.template.spec.containers[coherence].image"}},
+		{Kind: coh.ResourceTypeService, Name: "storage-wka", Paths: []string{"spec.ports[coherence].port", "spec.type"}},
+	})
+	g.Expect(c.IsTrue()).To(BeTrue())
+	g.Expect(c.Reason).To(Equal(drift.ReasonDriftDetected))
+	g.Expect(c.Message).To(Equal("StatefulSet/storage: spec.template.spec.containers[coherence].image; " +
+		"Service/storage-wka: spec.ports[coherence].port, spec.type"))
+}
+
+func TestMessageIsBounded(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var paths []string
+	for i := 0; i < 25; i++ {
+		paths = append(paths, "spec.field")
+	}
+	msg := drift.Message([]drift.Resource{{Kind: coh.ResourceTypeService, Name: "test", Paths: paths}})
+	g.Expect(msg).To(HaveSuffix("; and 5 more"))
+}
+
+func TestGetPolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(drift.GetPolicy(&coh.Coherence{})).To(Equal(coh.DriftPolicyReport))
+	policy := coh.DriftPolicyCorrect
+	g.Expect(drift.GetPolicy(&coh.Coherence{Spec: coh.CoherenceStatefulSetResourceSpec{DriftPolicy: &policy}})).To(Equal(coh.DriftPolicyCorrect))
+	g.Expect(drift.GetPolicy(&coh.CoherenceJob{})).To(Equal(coh.DriftPolicyIgnore))
+}
+
+func TestDetect(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	desiredSvc := createService()
+	desiredSvc.Labels[coh.LabelCoherenceHash] = "1"
+	desiredSts := createStatefulSet()
+	desiredSts.Labels = map[string]string{coh.LabelCoherenceHash: "1"}
+	pending := createService()
+	pending.Name = "pending"
+	pending.Labels[coh.LabelCoherenceHash] = "1"
+
+	liveSvc := desiredSvc.DeepCopy()
+	liveSvc.Spec.Ports[0].Port = 9999
+	liveSts := desiredSts.DeepCopy()
+	// a resource not yet updated to the latest desired state has not drifted
+	livePending := pending.DeepCopy()
+	livePending.Labels[coh.LabelCoherenceHash] = "0"
+	livePending.Spec.Ports[0].Port = 9999
+
+	c := fake.NewClientBuilder().WithObjects(liveSvc, liveSts, livePending).Build()
+	d := &drift.Detector{Client: c, Log: logr.Discard()}
+
+	storage := &testStorage{hash: "1", latest: coh.Resources{Items: []coh.Resource{
+		{Kind: coh.ResourceTypeService, Name: desiredSvc.Name, Spec: desiredSvc},
+		{Kind: coh.ResourceTypeService, Name: pending.Name, Spec: pending},
+		{Kind: coh.ResourceTypeStatefulSet, Name: desiredSts.Name, Spec: desiredSts},
+	}}}
+
+	deployment := &coh.Coherence{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "storage"}}
+	drifted, err := d.Detect(ctx, deployment, storage)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(drifted).To(Equal([]drift.Resource{
+		{Kind: coh.ResourceTypeService, Name: desiredSvc.Name, Paths: []string{"spec.ports[http].port"}},
+	}))
+}
+
+func TestDetectSkipsRolledBackStatefulSet(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	desiredSts := createStatefulSet()
+	desiredSts.Labels = map[string]string{coh.LabelCoherenceHash: "2"}
+	// the live StatefulSet has been rolled back to the previous image
+	liveSts := desiredSts.DeepCopy()
+	liveSts.Spec.Template.Spec.Containers[0].Image = "coherence:0.9"
+
+	c := fake.NewClientBuilder().WithObjects(liveSts).Build()
+	d := &drift.Detector{Client: c, Log: logr.Discard()}
+	storage := &testStorage{hash: "2", latest: coh.Resources{Items: []coh.Resource{
+		{Kind: coh.ResourceTypeStatefulSet, Name: desiredSts.Name, Spec: desiredSts},
+	}}}
+
+	deployment := &coh.Coherence{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "storage", Generation: 2}}
+	drifted, err := d.Detect(ctx, deployment, storage)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(drifted).To(HaveLen(1))
+
+	deployment.Status.Conditions.SetCondition(coh.Condition{Type: coh.ConditionTypeRolledBack, Status: corev1.ConditionTrue, ObservedGeneration: 2})
+	drifted, err = d.Detect(ctx, deployment, storage)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(drifted).To(BeEmpty())
+}
+
+func createService() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "storage-http",
+			Labels:    map[string]string{"app": "storage"},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeClusterIP,
+			Selector: map[string]string{"app": "storage"},
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: intstr.FromInt32(8080)},
+			},
+		},
+	}
+}
+
+func createStatefulSet() *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "storage",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To(int32(3)),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  coh.ContainerNameCoherence,
+							Image: "coherence:1.0",
+							Env: []corev1.EnvVar{
+								{Name: "FOO", Value: "foo"},
+								{Name: "BAR", Value: "bar"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// testStorage is a utils.Storage holding the latest desired state.
+type testStorage struct {
+	hash   string
+	latest coh.Resources
+}
+
+var _ utils.Storage = &testStorage{}
+
+func (in *testStorage) GetName() string                                        { return "test" }
+func (in *testStorage) GetLatest() coh.Resources                               { return in.latest }
+func (in *testStorage) GetPrevious() coh.Resources                             { return coh.Resources{} }
+func (in *testStorage) GetPreviousGeneration() coh.Resources                   { return coh.Resources{} }
+func (in *testStorage) Destroy()                                               {}
+func (in *testStorage) GetHash() (string, bool)                                { return in.hash, true }
+func (in *testStorage) IsJob(reconcile.Request) bool                           { return false }
+func (in *testStorage) GetDeletions() []coh.Resource                           { return nil }
+func (in *testStorage) ResetHash(context.Context, coh.CoherenceResource) error { return nil }
+func (in *testStorage) Store(context.Context, coh.Resources, coh.CoherenceResource) error {
+	return nil
+}
@@ -16,6 +16,8 @@ import (
 
 	"github.com/go-logr/logr"
 	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/status"
+	"github.com/oracle/coherence-operator/pkg/metrics"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -52,6 +54,7 @@ type ErrorHandler struct {
 	Client        client.Client
 	Log           logr.Logger
 	EventRecorder events.EventRecorder
+	StatusManager *status.StatusManager
 }
 
 // HandleError handles an error in the reconciliation loop
@@ -65,6 +68,7 @@ func (eh *ErrorHandler) HandleError(ctx context.Context, err error, resource coh
 
 	// Categorize the error
 	category := eh.categorizeError(err)
+	metrics.RecordError(resource.GetNamespace(), resource.GetName(), string(category))
 
 	// Add caller information to the log
 	callerInfo := GetCallerInfo(1)
@@ -89,19 +93,42 @@ func (eh *ErrorHandler) HandleError(ctx context.Context, err error, resource coh
 	}
 
 	// Handle the error based on its category
+	var result reconcile.Result
+	var resultErr error
+	historyResult := coh.HistoryResultDeferred
 	switch category {
 	case ErrorCategoryTransient:
 		// For transient errors, requeue with backoff
-		return eh.handleTransientError(resource)
+		result, resultErr = eh.handleTransientError(resource)
 	case ErrorCategoryRecoverable:
 		// For recoverable errors, attempt recovery
-		return eh.attemptRecovery(ctx, err, resource)
+		result, resultErr = eh.attemptRecovery(ctx, err, resource)
+		if resultErr != nil {
+			historyResult = coh.HistoryResultFailed
+		}
 	case ErrorCategoryPermanent:
 		// For permanent errors, don't requeue
-		return reconcile.Result{}, nil
+		result = reconcile.Result{}
+		historyResult = coh.HistoryResultFailed
 	default:
 		// For unknown errors, requeue with a short delay
-		return reconcile.Result{RequeueAfter: 5 * time.Second}, nil
+		result = reconcile.Result{RequeueAfter: 5 * time.Second}
+	}
+
+	eh.recordHistory(ctx, resource, category, historyResult, result, fmt.Sprintf("%s: %s", msg, err.Error()))
+	return result, resultErr
+}
+
+// recordHistory adds a recovery entry for a handled error to the history of the resource.
+func (eh *ErrorHandler) recordHistory(ctx context.Context, resource coh.CoherenceResource, category ErrorCategory,
+	historyResult coh.HistoryResult, result reconcile.Result, msg string) {
+	inputs := map[string]string{"category": string(category)}
+	if result.RequeueAfter > 0 {
+		inputs["requeueAfter"] = result.RequeueAfter.String()
+	}
+	entry := coh.NewHistoryEntry(coh.HistoryOperationRecovery, historyResult, msg, inputs)
+	if err := eh.StatusManager.AddHistory(ctx, resource, entry); err != nil {
+		eh.Log.Error(err, "Failed to record error handling history")
 	}
 }
 
@@ -995,10 +1022,11 @@ func GetCallerInfo(skip int) string {
 }
 
 // NewErrorHandler creates a new ErrorHandler
-func NewErrorHandler(client client.Client, log logr.Logger, recorder events.EventRecorder) *ErrorHandler {
+func NewErrorHandler(client client.Client, log logr.Logger, recorder events.EventRecorder, sm *status.StatusManager) *ErrorHandler {
 	return &ErrorHandler{
 		Client:        client,
 		Log:           log,
 		EventRecorder: recorder,
+		StatusManager: sm,
 	}
 }
@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package httproute
+
+import (
+	"context"
+	"time"
+
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/reconciler"
+	"github.com/oracle/coherence-operator/pkg/clients"
+	"github.com/oracle/coherence-operator/pkg/utils"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const (
+	// The name of this controller. This is used in events, log messages, etc.
+	controllerName = "controllers.HTTPRoute"
+)
+
+// blank assignment to verify that ReconcileHTTPRoute implements reconcile.Reconciler.
+// If the `reconcile.Reconciler` API was to change then we'd get a compile error here.
+var _ reconcile.Reconciler = &ReconcileHTTPRoute{}
+
+// NewHTTPRouteReconciler returns a new Gateway API HTTPRoute reconciler.
+func NewHTTPRouteReconciler(mgr manager.Manager, cs clients.ClientSet) reconciler.SecondaryResourceReconciler {
+	return NewNamedHTTPRouteReconciler(mgr, cs, controllerName)
+}
+
+// NewNamedHTTPRouteReconciler returns a new Gateway API HTTPRoute reconciler.
+func NewNamedHTTPRouteReconciler(mgr manager.Manager, cs clients.ClientSet, name string) reconciler.SecondaryResourceReconciler {
+	r := &ReconcileHTTPRoute{
+		ReconcileSecondaryResource: reconciler.ReconcileSecondaryResource{
+			Kind:      coh.ResourceTypeHTTPRoute,
+			Template:  &gatewayv1.HTTPRoute{},
+			SkipWatch: true,
+		},
+	}
+
+	r.SetCommonReconciler(name, mgr, cs)
+	// HTTPRoute is a custom resource, so it does not support strategic merge patches
+	r.GetPatcher().SetPatchType(types.MergePatchType)
+	return r
+}
+
+// ReconcileHTTPRoute reconciles the Gateway API HTTPRoutes for a Coherence resource.
+// The Gateway API CRDs are optional, so the HTTPRoutes are only reconciled if the
+// HTTPRoute CRD is installed in the cluster.
+type ReconcileHTTPRoute struct {
+	reconciler.ReconcileSecondaryResource
+}
+
+func (in *ReconcileHTTPRoute) GetReconciler() reconcile.Reconciler { return in }
+
+// Reconcile reads that state of the HTTPRoutes for a deployment and makes changes based on the
+// state read and the desired state based on the parent Coherence resource.
+func (in *ReconcileHTTPRoute) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := in.GetLog().WithValues("Namespace", request.Namespace, "Name", request.Name, "Kind", in.Kind.Name())
+	logger.Info("Starting reconcile")
+
+	// Attempt to lock the requested resource. If the resource is locked then another
+	// request for the same resource is already in progress so requeue this one.
+	if ok := in.Lock(request); !ok {
+		return reconcile.Result{RequeueAfter: time.Second * 10}, nil
+	}
+	// Make sure that the request is unlocked when this method exits
+	defer in.Unlock(request)
+
+	if !in.hasHTTPRoute() {
+		logger.Info("Cannot reconcile HTTPRoute as the Gateway API HTTPRoute CRD is not installed")
+		return reconcile.Result{}, nil
+	}
+
+	err := in.ReconcileSingleResource(ctx, request.Namespace, request.Name, nil, nil, logger)
+	logger.Info("Completed reconcile")
+	return reconcile.Result{}, err
+}
+
+// ReconcileAllResourceOfKind reconciles the state of the desired HTTPRoutes for the reconciler
+func (in *ReconcileHTTPRoute) ReconcileAllResourceOfKind(ctx context.Context, request reconcile.Request, d coh.CoherenceResource, storage utils.Storage) (reconcile.Result, error) {
+	if !in.hasResources(storage) {
+		// nothing to do, so avoid the cost of checking for the CRD
+		return reconcile.Result{}, nil
+	}
+
+	if !in.hasHTTPRoute() {
+		logger := in.GetLog().WithValues("Namespace", request.Namespace, "Name", request.Name, "Kind", in.Kind.Name())
+		logger.Info("Cannot reconcile HTTPRoute as the Gateway API HTTPRoute CRD is not installed")
+		return reconcile.Result{}, nil
+	}
+
+	return in.ReconcileSecondaryResource.ReconcileAllResourceOfKind(ctx, request, d, storage)
+}
+
+// hasResources returns true if there are HTTPRoutes to create, update or delete.
+func (in *ReconcileHTTPRoute) hasResources(storage utils.Storage) bool {
+	if len(storage.GetLatest().GetResourcesOfKind(in.Kind)) > 0 {
+		return true
+	}
+	for _, del := range storage.GetDeletions() {
+		if del.Kind == in.Kind {
+			return true
+		}
+	}
+	return false
+}
+
+// hasHTTPRoute checks if the Gateway API HTTPRoute CRD is registered in the cluster.
+func (in *ReconcileHTTPRoute) hasHTTPRoute() bool {
+	dc, err := discovery.NewDiscoveryClientForConfig(in.GetManager().GetConfig())
+	if err != nil {
+		in.GetLog().Error(err, "error creating discovery client")
+		return false
+	}
+	resources, err := dc.ServerResourcesForGroupVersion(gatewayv1.GroupVersion.String())
+	switch {
+	case err != nil && apierrors.IsNotFound(err):
+		return false
+	case err != nil:
+		in.GetLog().Error(err, "error checking for Gateway API HTTPRoute CRD")
+		return false
+	}
+	for _, r := range resources.APIResources {
+		if r.Kind == coh.HTTPRouteKind {
+			return true
+		}
+	}
+	return false
+}
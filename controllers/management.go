@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/pkg/probe"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// findManagementEndpoint finds the host and port to use to call Coherence management over REST
+// for a Coherence resource, which may be nil if the resource does not exist.
+// If the endpoint is not yet available a message is returned describing why.
+func findManagementEndpoint(ctx context.Context, c client.Client, deployment *coh.Coherence, name string) (string, int32, string, error) {
+	if deployment == nil {
+		return "", 0, fmt.Sprintf("waiting for Coherence resource %s to be created", name), nil
+	}
+
+	sts := &appsv1.StatefulSet{}
+	err := c.Get(ctx, types.NamespacedName{Namespace: deployment.Namespace, Name: deployment.Name}, sts)
+	switch {
+	case err != nil && apierrors.IsNotFound(err):
+		return "", 0, fmt.Sprintf("waiting for StatefulSet %s to be created", deployment.Name), nil
+	case err != nil:
+		return "", 0, "", errors.Wrapf(err, "getting StatefulSet %s", deployment.Name)
+	}
+
+	p := probe.CoherenceProbe{Client: c}
+	host, port, err := p.GetManagementHostAndPort(ctx, deployment, sts)
+	if err != nil {
+		return "", 0, fmt.Sprintf("waiting for Coherence resource %s: %s", name, err.Error()), nil
+	}
+	return host, port, "", nil
+}
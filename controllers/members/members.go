@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+// Package members populates the Coherence cluster members and services in the status of Coherence resources.
+package members
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/pkg/management"
+	"github.com/oracle/coherence-operator/pkg/probe"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ReasonServicesEndangered is the ServicesEndangered condition reason when services are below NODE-SAFE.
+	ReasonServicesEndangered coh.ConditionReason = "ServicesEndangered"
+	// ReasonServicesSafe is the ServicesEndangered condition reason when all services are at least NODE-SAFE.
+	ReasonServicesSafe coh.ConditionReason = "ServicesSafe"
+)
+
+// Result is the result of reconciling the members and services of a Coherence resource.
+type Result struct {
+	// Members are the members to set in the status of the Coherence resource.
+	Members []coh.CoherenceMemberStatus
+	// Services are the services to set in the status of the Coherence resource.
+	Services []coh.CoherenceServiceStatus
+	// Updated is true if the members and services were obtained from the cluster and should be set in the status.
+	Updated bool
+	// RequeueAfter is the time until the members should be obtained again.
+	RequeueAfter time.Duration
+}
+
+// Manager obtains the members of a Coherence deployment and the partitioned services of its cluster
+// from Coherence management over REST. The cost is bounded, the members and services are obtained
+// from a single Pod of each deployment at most once per interval, using one request for the members,
+// one for the services and one for each partitioned service, each attempted once with a short timeout,
+// and the status only changes when the members or services change.
+type Manager struct {
+	Client client.Client
+	Log    logr.Logger
+	// HTTPClient is the optional http client used to call Coherence management over REST.
+	HTTPClient *http.Client
+
+	lock        sync.Mutex
+	lastUpdates map[types.NamespacedName]time.Time
+}
+
+// ReconcileMembers obtains the members and services of a Coherence deployment if they are due to be updated.
+func (m *Manager) ReconcileMembers(ctx context.Context, deployment *coh.Coherence) (Result, error) {
+	nn := types.NamespacedName{Namespace: deployment.Namespace, Name: deployment.Name}
+	spec := deployment.Spec.MemberStatus
+	if !spec.IsEnabled() || !deployment.Spec.Coherence.IsManagementEnabled() || deployment.GetReplicas() == 0 {
+		// the members cannot be obtained so remove any existing members and services from the status
+		m.Forget(nn)
+		return Result{Updated: true}, nil
+	}
+
+	interval := spec.GetInterval()
+	now := time.Now()
+	if last, found := m.getLastUpdate(nn); found {
+		wait := last.Add(interval).Sub(now)
+		if m.membersChanged(deployment) {
+			// the members have changed, for example after scaling, so update them before the end
+			// of the current interval, but never more often than the minimum interval
+			wait = last.Add(coh.MinMemberStatusInterval).Sub(now)
+		}
+		if wait > 0 {
+			return Result{RequeueAfter: wait}, nil
+		}
+	}
+
+	result, err := m.fetch(ctx, deployment)
+	m.setLastUpdate(nn, now)
+	if err != nil {
+		// a failure is not fatal, the existing members and services are kept and the request retried next interval
+		m.Log.Info("Failed to obtain Coherence members and services", "Namespace", deployment.Namespace, "Name", deployment.Name, "Error", err.Error())
+		return Result{RequeueAfter: interval}, nil
+	}
+	result.Updated = true
+	result.RequeueAfter = interval
+	return result, nil
+}
+
+// Forget removes the record of when the members and services of a deployment were last obtained.
+func (m *Manager) Forget(nn types.NamespacedName) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.lastUpdates, nn)
+}
+
+func (m *Manager) getLastUpdate(nn types.NamespacedName) (time.Time, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	last, found := m.lastUpdates[nn]
+	return last, found
+}
+
+func (m *Manager) setLastUpdate(nn types.NamespacedName, t time.Time) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.lastUpdates == nil {
+		m.lastUpdates = make(map[types.NamespacedName]time.Time)
+	}
+	m.lastUpdates[nn] = t
+}
+
+// membersChanged returns true if the number of members in the status does not match the number
+// of ready replicas.
+func (m *Manager) membersChanged(deployment *coh.Coherence) bool {
+	return int32(len(deployment.Status.Members)) != deployment.Status.ReadyReplicas
+}
+
+// fetch obtains the members for the Pods of a deployment and the partitioned services of its cluster
+// from Coherence management over REST.
+func (m *Manager) fetch(ctx context.Context, deployment *coh.Coherence) (Result, error) {
+	sts := &appsv1.StatefulSet{}
+	if err := m.Client.Get(ctx, types.NamespacedName{Namespace: deployment.Namespace, Name: deployment.Name}, sts); err != nil {
+		if apierrors.IsNotFound(err) {
+			return Result{}, nil
+		}
+		return Result{}, errors.Wrapf(err, "getting StatefulSet %s", deployment.Name)
+	}
+
+	p := probe.CoherenceProbe{Client: m.Client}
+	pods, err := p.GetPodsForStatefulSet(ctx, sts)
+	if err != nil {
+		return Result{}, errors.Wrapf(err, "getting Pods for StatefulSet %s", deployment.Name)
+	}
+	podNames := make(map[string]bool)
+	for _, pod := range pods.Items {
+		podNames[pod.Name] = true
+	}
+
+	host, port, err := p.GetManagementHostAndPort(ctx, deployment, sts)
+	if err != nil {
+		return Result{}, err
+	}
+
+	// the members are obtained inline in the reconcile, so a request that fails is not retried,
+	// the members are obtained again at the next interval
+	cl := management.GetHTTPClient(m.HTTPClient, management.ReconcileRequestTimeout)
+	data, status, err := management.GetMembers(cl, host, port, management.SingleAttempt())
+	if err = management.CheckResponse("get cluster members", status, err); err != nil {
+		return Result{}, err
+	}
+	services, status, err := management.GetServicesPartitionData(cl, host, port, management.SingleAttempt())
+	if err = management.CheckResponse("get services partition data", status, err); err != nil {
+		return Result{}, err
+	}
+	return Result{Members: ToMemberStatus(data, podNames), Services: ToServiceStatus(services)}, nil
+}
+
+// ToMemberStatus converts the cluster members for the specified Pods to member status, sorted by Pod name.
+func ToMemberStatus(data *management.MembersData, pods map[string]bool) []coh.CoherenceMemberStatus {
+	if data == nil {
+		return nil
+	}
+	var members []coh.CoherenceMemberStatus
+	for _, md := range data.Items {
+		if !pods[md.MemberName] {
+			continue
+		}
+		members = append(members, coh.CoherenceMemberStatus{
+			Pod:          md.MemberName,
+			MemberID:     int32(md.ID),
+			Machine:      md.MachineName,
+			Rack:         md.RackName,
+			Site:         md.SiteName,
+			Role:         md.RoleName,
+			LoggingLevel: int32(md.LoggingLevel),
+		})
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].Pod < members[j].Pod })
+	return members
+}
+
+// ToServiceStatus converts the partition data of services to service status.
+func ToServiceStatus(data []management.ServicePartitionData) []coh.CoherenceServiceStatus {
+	var services []coh.CoherenceServiceStatus
+	for _, sd := range data {
+		services = append(services, coh.CoherenceServiceStatus{
+			Name:                       sd.Name,
+			Type:                       sd.Type,
+			HAStatus:                   sd.HAStatus,
+			HAStatusCode:               int32(sd.HAStatusCode),
+			BackupCount:                int32(sd.BackupCount),
+			NodeCount:                  int32(sd.ServiceNodeCount),
+			RemainingDistributionCount: int32(sd.RemainingDistributionCount),
+		})
+	}
+	return services
+}
+
+// EndangeredCondition returns the ServicesEndangered condition for the services.
+// A service is endangered if it has backups configured but is below NODE-SAFE.
+func EndangeredCondition(services []coh.CoherenceServiceStatus) coh.Condition {
+	var endangered []string
+	for _, svc := range services {
+		pd := management.PartitionData{BackupCount: int(svc.BackupCount), HAStatusCode: int(svc.HAStatusCode)}
+		if pd.IsEndangered() {
+			endangered = append(endangered, fmt.Sprintf("%s (%s)", svc.Name, svc.HAStatus))
+		}
+	}
+	if len(endangered) == 0 {
+		return coh.Condition{Type: coh.ConditionTypeServicesEndangered, Status: corev1.ConditionFalse, Reason: ReasonServicesSafe}
+	}
+	return coh.Condition{
+		Type:    coh.ConditionTypeServicesEndangered,
+		Status:  corev1.ConditionTrue,
+		Reason:  ReasonServicesEndangered,
+		Message: "services below NODE-SAFE: " + strings.Join(endangered, ", "),
+	}
+}
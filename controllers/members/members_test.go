@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package members_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/members"
+	"github.com/oracle/coherence-operator/pkg/fakes/stubs"
+	"github.com/oracle/coherence-operator/pkg/management"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestToMemberStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	data := &management.MembersData{Items: []management.MemberData{
+		{MemberName: "storage-1", ID: 3, MachineName: "node-b", RackName: "rack-2", SiteName: "site-1", RoleName: "storage", LoggingLevel: 5},
+		{MemberName: "storage-0", ID: 1, MachineName: "node-a", RackName: "rack-1", SiteName: "site-1", RoleName: "storage", LoggingLevel: 9},
+		{MemberName: "proxy-0", ID: 2, MachineName: "node-a", RackName: "rack-1", SiteName: "site-1", RoleName: "proxy"},
+	}}
+
+	result := members.ToMemberStatus(data, map[string]bool{"storage-0": true, "storage-1": true})
+	g.Expect(result).To(Equal([]coh.CoherenceMemberStatus{
+		{Pod: "storage-0", MemberID: 1, Machine: "node-a", Rack: "rack-1", Site: "site-1", Role: "storage", LoggingLevel: 9},
+		{Pod: "storage-1", MemberID: 3, Machine: "node-b", Rack: "rack-2", Site: "site-1", Role: "storage", LoggingLevel: 5},
+	}))
+	g.Expect(members.ToMemberStatus(nil, nil)).To(BeNil())
+}
+
+func TestMemberStatusInterval(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var spec *coh.MemberStatusSpec
+	g.Expect(spec.IsEnabled()).To(BeTrue())
+	g.Expect(spec.GetInterval()).To(Equal(coh.DefaultMemberStatusInterval))
+
+	spec = &coh.MemberStatusSpec{Enabled: ptr.To(false), Interval: &metav1.Duration{Duration: time.Second}}
+	g.Expect(spec.IsEnabled()).To(BeFalse())
+	g.Expect(spec.GetInterval()).To(Equal(coh.MinMemberStatusInterval))
+}
+
+func TestReconcileMembers(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	server := stubs.NewManagementServer(t)
+	server.SetResponse(stubs.ManagementMembersPath, http.StatusOK, `{"items": [
+		{"memberName": "storage-0", "id": 1, "machineName": "node-a", "rackName": "rack-1", "siteName": "site-1", "roleName": "storage", "loggingLevel": 5},
+		{"memberName": "other-0", "id": 2, "machineName": "node-a", "rackName": "rack-1", "siteName": "site-1", "roleName": "other", "loggingLevel": 5}
+	]}`)
+	server.SetResponse(stubs.ManagementServicesPath, http.StatusOK, `{"items": [{"name": "PartitionedCache", "type": "DistributedCache"}]}`)
+	server.SetResponse(stubs.ManagementPartitionPath("PartitionedCache"), http.StatusOK,
+		`{"HAStatus": "ENDANGERED", "HAStatusCode": 0, "backupCount": 1, "serviceNodeCount": 1, "remainingDistributionCount": 5}`)
+
+	deployment, sts, pod := stubs.NewManagedCoherence(server)
+	m := &members.Manager{Client: stubs.NewClient(deployment, sts, pod), Log: logr.Discard()}
+
+	r, err := m.ReconcileMembers(ctx, deployment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(r.Updated).To(BeTrue())
+	g.Expect(r.RequeueAfter).To(Equal(coh.DefaultMemberStatusInterval))
+	g.Expect(r.Members).To(Equal([]coh.CoherenceMemberStatus{
+		{Pod: "storage-0", MemberID: 1, Machine: "node-a", Rack: "rack-1", Site: "site-1", Role: "storage", LoggingLevel: 5},
+	}))
+	g.Expect(r.Services).To(Equal([]coh.CoherenceServiceStatus{
+		{Name: "PartitionedCache", Type: "DistributedCache", HAStatus: "ENDANGERED", BackupCount: 1, NodeCount: 1, RemainingDistributionCount: 5},
+	}))
+	g.Expect(server.Requests(stubs.ManagementMembersPath)).To(Equal(1))
+
+	// the members are not obtained again until the interval has passed
+	deployment.Status.Members = r.Members
+	r, err = m.ReconcileMembers(ctx, deployment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(r.Updated).To(BeFalse())
+	g.Expect(r.RequeueAfter).To(BeNumerically(">", 0))
+	g.Expect(r.RequeueAfter).To(BeNumerically("<=", coh.DefaultMemberStatusInterval))
+	g.Expect(server.Requests(stubs.ManagementMembersPath)).To(Equal(1))
+
+	// disabling the members status removes the members
+	deployment.Spec.MemberStatus = &coh.MemberStatusSpec{Enabled: ptr.To(false)}
+	r, err = m.ReconcileMembers(ctx, deployment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(r.Updated).To(BeTrue())
+	g.Expect(r.Members).To(BeNil())
+	g.Expect(r.Services).To(BeNil())
+	g.Expect(server.Requests(stubs.ManagementMembersPath)).To(Equal(1))
+}
+
+func TestEndangeredCondition(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := members.EndangeredCondition([]coh.CoherenceServiceStatus{
+		{Name: "PartitionedCache", HAStatus: "NODE-SAFE", HAStatusCode: 1, BackupCount: 1},
+		{Name: "NoBackups", HAStatus: "ENDANGERED", HAStatusCode: 0, BackupCount: 0},
+	})
+	g.Expect(c.Type).To(Equal(coh.ConditionTypeServicesEndangered))
+	g.Expect(c.IsFalse()).To(BeTrue())
+	g.Expect(c.Reason).To(Equal(members.ReasonServicesSafe))
+
+	c = members.EndangeredCondition([]coh.CoherenceServiceStatus{
+		{Name: "PartitionedCache", HAStatus: "ENDANGERED", HAStatusCode: 0, BackupCount: 1},
+		{Name: "Other", HAStatus: "ENDANGERED", HAStatusCode: 0, BackupCount: 2},
+	})
+	g.Expect(c.IsTrue()).To(BeTrue())
+	g.Expect(c.Reason).To(Equal(members.ReasonServicesEndangered))
+	g.Expect(c.Message).To(Equal("services below NODE-SAFE: PartitionedCache (ENDANGERED), Other (ENDANGERED)"))
+}
+
+func TestReconcileMembersKeepsMembersOnFailure(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	server := stubs.NewManagementServer(t)
+	server.SetDefaultStatus(http.StatusServiceUnavailable)
+
+	deployment, sts, pod := stubs.NewManagedCoherence(server)
+	m := &members.Manager{Client: stubs.NewClient(deployment, sts, pod), Log: logr.Discard()}
+
+	r, err := m.ReconcileMembers(ctx, deployment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(r.Updated).To(BeFalse())
+	g.Expect(r.RequeueAfter).To(Equal(coh.DefaultMemberStatusInterval))
+}
+
+func TestReconcileMembersDoesNotRetry(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	server := stubs.NewManagementServer(t)
+	deployment, sts, pod := stubs.NewManagedCoherence(server)
+	// the management endpoint refuses connections
+	server.Close()
+
+	m := &members.Manager{Client: stubs.NewClient(deployment, sts, pod), Log: logr.Discard()}
+
+	start := time.Now()
+	r, err := m.ReconcileMembers(ctx, deployment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(r.Updated).To(BeFalse())
+	g.Expect(r.RequeueAfter).To(Equal(coh.DefaultMemberStatusInterval))
+	g.Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+}
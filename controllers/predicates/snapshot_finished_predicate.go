@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package predicates
+
+import (
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+var _ predicate.Predicate = SnapshotFinishedPredicate{}
+
+// SnapshotFinishedPredicate is a predicate that only passes update events
+// for a CoherenceSnapshot when the snapshot has just completed or failed.
+// This allows the owner of a scheduled snapshot to update its own status,
+// without reconciling the owner on every change to the snapshot progress.
+type SnapshotFinishedPredicate struct {
+	predicate.Funcs
+}
+
+// Create filters out all events.
+func (SnapshotFinishedPredicate) Create(event.CreateEvent) bool {
+	return false
+}
+
+// Update passes events where the snapshot phase has changed to a finished phase.
+func (SnapshotFinishedPredicate) Update(e event.UpdateEvent) bool {
+	oldSnapshot, ok := e.ObjectOld.(*coh.CoherenceSnapshot)
+	if !ok {
+		return false
+	}
+	newSnapshot, ok := e.ObjectNew.(*coh.CoherenceSnapshot)
+	if !ok {
+		return false
+	}
+	return newSnapshot.Status.Phase.IsFinished() && oldSnapshot.Status.Phase != newSnapshot.Status.Phase
+}
+
+// Delete filters out all events.
+func (SnapshotFinishedPredicate) Delete(event.DeleteEvent) bool {
+	return false
+}
+
+// Generic filters out all events.
+func (SnapshotFinishedPredicate) Generic(event.GenericEvent) bool {
+	return false
+}
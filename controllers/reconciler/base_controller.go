@@ -15,7 +15,9 @@ import (
 
 	"github.com/go-logr/logr"
 	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/drift"
 	"github.com/oracle/coherence-operator/controllers/errorhandling"
+	"github.com/oracle/coherence-operator/controllers/status"
 	"github.com/oracle/coherence-operator/pkg/clients"
 	"github.com/oracle/coherence-operator/pkg/operator"
 	"github.com/oracle/coherence-operator/pkg/patching"
@@ -50,6 +52,10 @@ const (
 	EventReasonReconciling string = "Reconciling"
 	// EventReasonScaling is the reason description for an scaling event.
 	EventReasonScaling string = "Scaling"
+	// EventReasonDrifted is the reason description for a drift event.
+	EventReasonDrifted string = "Drifted"
+	// EventReasonServicesEndangered is the reason description for an endangered services event.
+	EventReasonServicesEndangered string = "ServicesEndangered"
 )
 
 var (
@@ -81,6 +87,7 @@ type CommonReconciler struct {
 	mutex     *sync.Mutex
 	logger    logr.Logger
 	patcher   patching.ResourcePatcher
+	statusMgr *status.StatusManager
 }
 
 func (in *CommonReconciler) GetControllerName() string       { return in.name }
@@ -97,6 +104,9 @@ func (in *CommonReconciler) GetLog() logr.Logger {
 func (in *CommonReconciler) GetPatcher() patching.ResourcePatcher {
 	return in.patcher
 }
+func (in *CommonReconciler) GetStatusManager() *status.StatusManager {
+	return in.statusMgr
+}
 
 func (in *CommonReconciler) SetCommonReconciler(name string, mgr manager.Manager, cs clients.ClientSet) {
 	logger := logf.Log.WithName(name)
@@ -106,6 +116,11 @@ func (in *CommonReconciler) SetCommonReconciler(name string, mgr manager.Manager
 	in.mutex = commonMutex
 	in.logger = logger
 	in.patcher = patching.NewResourcePatcher(mgr, logger, types.StrategicMergePatchType)
+	in.statusMgr = &status.StatusManager{
+		Client:  mgr.GetClient(),
+		Log:     logger.WithName("status"),
+		Patcher: in.patcher,
+	}
 }
 
 // Lock attempts to lock the requested resource.
@@ -162,6 +177,37 @@ func (in *CommonReconciler) UpdateCoherenceJobStatusCondition(ctx context.Contex
 	return in.updateDeploymentStatusCondition(ctx, key, c, &coh.CoherenceJob{})
 }
 
+// UpdateCoherenceStatusNonPhaseCondition sets a condition in the Coherence resource's status
+// without changing the phase, for example the ScalingBlocked condition.
+func (in *CommonReconciler) UpdateCoherenceStatusNonPhaseCondition(ctx context.Context, key types.NamespacedName, c coh.Condition) error {
+	deployment := &coh.Coherence{}
+	err := in.GetClient().Get(ctx, key, deployment)
+	switch {
+	case err != nil && apierrors.IsNotFound(err):
+		// deployment not found - possibly deleted
+		return nil
+	case err != nil:
+		return errors.Wrapf(err, "getting deployment %s", key.Name)
+	case deployment.GetDeletionTimestamp() != nil:
+		// deployment is being deleted
+		return nil
+	}
+
+	updated := deployment.DeepCopy()
+	updated.Status.Conditions.SetCondition(c)
+	updated.Status.UpdateStandardConditions(updated)
+	patch, err := in.CreateTwoWayPatchOfType(types.MergePatchType, deployment.GetName(), updated, deployment)
+	if err != nil {
+		return errors.Wrap(err, "creating Coherence resource status patch")
+	}
+	if patch != nil {
+		if err = in.GetClient().Status().Patch(ctx, deployment, patch); err != nil {
+			return errors.Wrap(err, "updating Coherence resource status")
+		}
+	}
+	return nil
+}
+
 // UpdateDeploymentStatusCondition updates the Coherence resource's status.
 func (in *CommonReconciler) updateDeploymentStatusCondition(ctx context.Context, key types.NamespacedName, c coh.Condition, deployment coh.CoherenceResource) error {
 	var err error
@@ -465,6 +511,7 @@ func (in *CommonReconciler) HandleErrAndFinish(ctx context.Context, err error, d
 		// update the status to failed.
 		status := deployment.GetStatus()
 		status.Phase = coh.ConditionTypeFailed
+		status.UpdateStandardConditions(deployment)
 		if e := in.GetClient().Status().Update(ctx, deployment); e != nil {
 			// There isn't much we can do, we're already handling an error
 			logger.V(0).Info("failed to update deployment status due to: " + e.Error())
@@ -523,6 +570,7 @@ func (in *CommonReconciler) getErrorHandler() *errorhandling.ErrorHandler {
 		in.GetClient(),
 		in.GetLog().WithName("error-handler"),
 		in.GetEventRecorder(),
+		in.GetStatusManager(),
 	)
 }
 
@@ -822,6 +870,9 @@ func (in *ReconcileSecondaryResource) ReconcileSingleResource(ctx context.Contex
 	default:
 		// Both the resource and owning Coherence resource exist so this is maybe an update
 		err = in.Update(ctx, name, resource, storage, logger)
+		if err == nil && drift.GetPolicy(owner) == coh.DriftPolicyCorrect {
+			err = in.CorrectDrift(ctx, owner, name, resource, storage, logger)
+		}
 	}
 
 	logger.Info(fmt.Sprintf("Finished reconciling single %v", in.Kind))
@@ -893,6 +944,35 @@ func (in *ReconcileSecondaryResource) Update(ctx context.Context, name string, c
 	return err
 }
 
+// CorrectDrift patches the resource back to the desired state if it has been changed since it
+// was last updated by the Operator. Resources that are not yet updated to the latest desired
+// state are left to the normal update.
+func (in *ReconcileSecondaryResource) CorrectDrift(ctx context.Context, owner coh.CoherenceResource, name string, current client.Object, storage utils.Storage, logger logr.Logger) error {
+	if !in.HashLabelsMatch(current, storage) {
+		return nil
+	}
+	desired, found := storage.GetLatest().GetResource(in.Kind, name)
+	if !found || desired.IsDelete() {
+		return nil
+	}
+	paths, err := drift.Diff(desired.Spec, current, drift.IgnoredPaths(owner, in.Kind)...)
+	if err != nil || len(paths) == 0 {
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("Correcting drift of %v", in.Kind), "Paths", paths)
+	// using the desired state as the original state patches every drifted field back to the desired value
+	patched, err := in.ThreeWayPatch(ctx, name, current, desired.Spec, desired.Spec)
+	if err != nil {
+		return errors.Wrapf(err, "failed to correct drift of %v/%s", in.Kind, name)
+	}
+	if patched {
+		in.GetEventRecorder().Eventf(owner, nil, corev1.EventTypeNormal, EventReasonDrifted, "CorrectDrift",
+			"corrected drift of %v/%s: %s", in.Kind, name, strings.Join(paths, ", "))
+	}
+	return nil
+}
+
 func (in *ReconcileSecondaryResource) FindResource(ctx context.Context, namespace, name string) (client.Object, bool, error) {
 	object := in.NewFromTemplate(namespace, name)
 	err := in.GetClient().Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, object)
@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2020, 2025, Oracle and/or its affiliates.
+ * Copyright (c) 2020, 2026, Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * http://oss.oracle.com/licenses/upl.
  */
@@ -13,6 +13,8 @@ import (
 	coh "github.com/oracle/coherence-operator/api/v1"
 	"github.com/oracle/coherence-operator/pkg/clients"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -38,6 +40,26 @@ func NewNamedServiceReconciler(mgr manager.Manager, cs clients.ClientSet, name s
 	return NewSimpleReconciler(mgr, cs, name, coh.ResourceTypeService, &corev1.Service{})
 }
 
+func NewPodDisruptionBudgetReconciler(mgr manager.Manager, cs clients.ClientSet) SecondaryResourceReconciler {
+	return NewSimpleReconciler(mgr, cs, "controllers.PodDisruptionBudget", coh.ResourceTypePodDisruptionBudget, &policyv1.PodDisruptionBudget{})
+}
+
+func NewNetworkPolicyReconciler(mgr manager.Manager, cs clients.ClientSet) SecondaryResourceReconciler {
+	return NewNamedNetworkPolicyReconciler(mgr, cs, "controllers.NetworkPolicy")
+}
+
+func NewNamedNetworkPolicyReconciler(mgr manager.Manager, cs clients.ClientSet, name string) SecondaryResourceReconciler {
+	return NewSimpleReconciler(mgr, cs, name, coh.ResourceTypeNetworkPolicy, &networkingv1.NetworkPolicy{})
+}
+
+func NewIngressReconciler(mgr manager.Manager, cs clients.ClientSet) SecondaryResourceReconciler {
+	return NewNamedIngressReconciler(mgr, cs, "controllers.Ingress")
+}
+
+func NewNamedIngressReconciler(mgr manager.Manager, cs clients.ClientSet, name string) SecondaryResourceReconciler {
+	return NewSimpleReconciler(mgr, cs, name, coh.ResourceTypeIngress, &networkingv1.Ingress{})
+}
+
 // NewSimpleReconciler returns a new SimpleReconciler.
 func NewSimpleReconciler(mgr manager.Manager, cs clients.ClientSet, name string, kind coh.ResourceType, template client.Object) SecondaryResourceReconciler {
 	r := &SimpleReconciler{
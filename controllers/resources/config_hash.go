@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"sort"
+
+	"github.com/go-logr/logr"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	coreV1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ConfigHashManager calculates a hash of the content of the Secrets and ConfigMaps mounted
+// into the Pods of a Coherence resource, so that the Pods can be restarted when the content changes.
+type ConfigHashManager struct {
+	Client client.Client
+	Log    logr.Logger
+}
+
+// GetConfigHash returns the hash of the content of the Secrets and ConfigMaps mounted into the
+// Pods of a Coherence resource that have restart on change enabled.
+// A blank hash is returned if there are no Secrets or ConfigMaps to track.
+func (m *ConfigHashManager) GetConfigHash(ctx context.Context, deployment *coh.Coherence) (string, error) {
+	secrets := deployment.Spec.GetRestartOnChangeSecrets()
+	configMaps := deployment.Spec.GetRestartOnChangeConfigMaps()
+	if len(secrets) == 0 && len(configMaps) == 0 {
+		return "", nil
+	}
+
+	h := sha256.New()
+	for _, name := range secrets {
+		secret := &coreV1.Secret{}
+		found, err := m.get(ctx, deployment.Namespace, name, secret)
+		if err != nil {
+			return "", err
+		}
+		writeHashPair(h, "Secret", name)
+		if found {
+			writeHashData(h, secret.Data)
+		}
+	}
+	for _, name := range configMaps {
+		cm := &coreV1.ConfigMap{}
+		found, err := m.get(ctx, deployment.Namespace, name, cm)
+		if err != nil {
+			return "", err
+		}
+		writeHashPair(h, "ConfigMap", name)
+		if found {
+			data := make(map[string][]byte, len(cm.Data)+len(cm.BinaryData))
+			for k, v := range cm.Data {
+				data[k] = []byte(v)
+			}
+			for k, v := range cm.BinaryData {
+				data[k] = v
+			}
+			writeHashData(h, data)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// get fetches the named object, returning false if it does not exist.
+func (m *ConfigHashManager) get(ctx context.Context, namespace, name string, o client.Object) (bool, error) {
+	err := m.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, o)
+	switch {
+	case err != nil && apierrors.IsNotFound(err):
+		// a missing Secret or ConfigMap is part of the hash, so creating it later will restart the Pods
+		m.Log.Info("Mounted resource not found when calculating config hash", "Namespace", namespace, "Name", name)
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	return true, nil
+}
+
+func writeHashPair(h hash.Hash, key, value string) {
+	_, _ = h.Write([]byte(key))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(value))
+	_, _ = h.Write([]byte{0})
+}
+
+func writeHashData(h hash.Hash, data map[string][]byte) {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		writeHashPair(h, k, string(data[k]))
+	}
+}
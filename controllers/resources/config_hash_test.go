@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package resources_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/resources"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestConfigHashIsBlankWithNoMountedResources(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	m := &resources.ConfigHashManager{Client: fake.NewClientBuilder().Build(), Log: logr.Discard()}
+	hash, err := m.GetConfigHash(context.Background(), createDeployment(nil))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(hash).To(BeEmpty())
+}
+
+func TestConfigHashChangesWhenContentChanges(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "certs"},
+		Data:       map[string][]byte{"keystore.jks": []byte("one")},
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "config"},
+		Data:       map[string]string{"cache-config.xml": "<cache-config/>"},
+	}
+	c := fake.NewClientBuilder().WithObjects(secret, cm).Build()
+	m := &resources.ConfigHashManager{Client: c, Log: logr.Discard()}
+
+	deployment := createDeployment(func(spec *coh.CoherenceResourceSpec) {
+		spec.SecretVolumes = []coh.SecretVolumeSpec{{Name: "certs", MountPath: "/certs"}}
+		spec.ConfigMapVolumes = []coh.ConfigMapVolumeSpec{{Name: "config", MountPath: "/config"}}
+	})
+
+	first, err := m.GetConfigHash(ctx, deployment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(first).NotTo(BeEmpty())
+
+	// the hash is stable if nothing changes
+	again, err := m.GetConfigHash(ctx, deployment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(again).To(Equal(first))
+
+	// changing the Secret content changes the hash
+	secret.Data["keystore.jks"] = []byte("two")
+	g.Expect(c.Update(ctx, secret)).To(Succeed())
+	second, err := m.GetConfigHash(ctx, deployment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(second).NotTo(Equal(first))
+
+	// changing the ConfigMap content changes the hash
+	cm.Data["cache-config.xml"] = "<cache-config></cache-config>"
+	g.Expect(c.Update(ctx, cm)).To(Succeed())
+	third, err := m.GetConfigHash(ctx, deployment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(third).NotTo(Equal(second))
+}
+
+func TestConfigHashIgnoresVolumesWithRestartOnChangeDisabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "certs"},
+		Data:       map[string][]byte{"keystore.jks": []byte("one")},
+	}
+	c := fake.NewClientBuilder().WithObjects(secret).Build()
+	m := &resources.ConfigHashManager{Client: c, Log: logr.Discard()}
+
+	deployment := createDeployment(func(spec *coh.CoherenceResourceSpec) {
+		spec.SecretVolumes = []coh.SecretVolumeSpec{{Name: "certs", MountPath: "/certs", RestartOnChange: ptr.To(false)}}
+	})
+
+	hash, err := m.GetConfigHash(ctx, deployment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(hash).To(BeEmpty())
+}
+
+func TestConfigHashWithMissingSecret(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	c := fake.NewClientBuilder().Build()
+	m := &resources.ConfigHashManager{Client: c, Log: logr.Discard()}
+
+	deployment := createDeployment(func(spec *coh.CoherenceResourceSpec) {
+		spec.SecretVolumes = []coh.SecretVolumeSpec{{Name: "certs", MountPath: "/certs", Optional: ptr.To(true)}}
+	})
+
+	missing, err := m.GetConfigHash(ctx, deployment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(missing).NotTo(BeEmpty())
+
+	// creating the Secret changes the hash
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "certs"},
+		Data:       map[string][]byte{"keystore.jks": []byte("one")},
+	}
+	g.Expect(c.Create(ctx, secret)).To(Succeed())
+	created, err := m.GetConfigHash(ctx, deployment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(created).NotTo(Equal(missing))
+}
+
+func createDeployment(fn func(spec *coh.CoherenceResourceSpec)) *coh.Coherence {
+	deployment := &coh.Coherence{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "storage"}}
+	if fn != nil {
+		fn(&deployment.Spec.CoherenceResourceSpec)
+	}
+	return deployment
+}
@@ -0,0 +1,271 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/reconciler"
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+	coreV1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// ScheduleResult is the result of reconciling the snapshot schedule of a Coherence resource.
+type ScheduleResult struct {
+	// ScheduleStartTime is the time that the snapshot schedule was first observed.
+	ScheduleStartTime *metav1.Time
+	// LastScheduledTime is the time that a scheduled snapshot was last started.
+	LastScheduledTime *metav1.Time
+	// LastSnapshotTime is the completion time of the most recent successful scheduled snapshot.
+	LastSnapshotTime *metav1.Time
+	// LastSnapshot is the name of the most recent successful scheduled snapshot.
+	LastSnapshot string
+	// RequeueAfter is the time until the next scheduled snapshot.
+	RequeueAfter time.Duration
+}
+
+// ScheduleManager manages the scheduled snapshots of Coherence resources.
+type ScheduleManager struct {
+	Client        client.Client
+	Scheme        *runtime.Scheme
+	Log           logr.Logger
+	EventRecorder events.EventRecorder
+}
+
+// ReconcileSchedule creates a CoherenceSnapshot if a scheduled snapshot is due and removes
+// scheduled snapshots that are no longer required by the retention policy.
+func (sm *ScheduleManager) ReconcileSchedule(ctx context.Context, deployment *coh.Coherence) (ScheduleResult, error) {
+	sched := deployment.Spec.Coherence.GetPersistenceSpec().GetSnapshotSchedule()
+	result := ScheduleResult{
+		ScheduleStartTime: deployment.Status.SnapshotScheduleStartTime,
+		LastScheduledTime: deployment.Status.LastScheduledSnapshotTime,
+	}
+
+	snapshots, err := sm.listScheduledSnapshots(ctx, deployment)
+	if err != nil {
+		return result, err
+	}
+	result.LastSnapshot, result.LastSnapshotTime = LatestCompleted(snapshots)
+
+	if sched == nil {
+		// there is no schedule, any existing scheduled snapshots are left in place,
+		// if a schedule is added later it starts from the time it is added
+		result.ScheduleStartTime = nil
+		return result, nil
+	}
+
+	schedule, loc, err := sched.ParseSchedule()
+	if err != nil {
+		sm.EventRecorder.Eventf(deployment, nil, coreV1.EventTypeWarning, reconciler.EventReasonFailed, "ScheduleSnapshot", err.Error())
+		return result, nil
+	}
+
+	now := time.Now()
+	if result.ScheduleStartTime == nil {
+		// the schedule has just been added, so schedule times that have already passed are not run
+		result.ScheduleStartTime = &metav1.Time{Time: now}
+	}
+	earliest := result.ScheduleStartTime.Time
+	if result.LastScheduledTime != nil && result.LastScheduledTime.After(earliest) {
+		earliest = result.LastScheduledTime.Time
+	}
+
+	if scheduled, found := MostRecentScheduleTime(schedule, loc, earliest, now); found {
+		result.LastScheduledTime = &metav1.Time{Time: scheduled}
+		sm.triggerSnapshot(ctx, deployment, sched, snapshots, scheduled)
+	}
+
+	if err = sm.pruneSnapshots(ctx, deployment, sched, snapshots, now); err != nil {
+		return result, err
+	}
+
+	result.RequeueAfter = schedule.Next(now.In(loc)).Sub(now)
+	return result, nil
+}
+
+// triggerSnapshot creates the CoherenceSnapshot for a schedule time, unless a previous
+// scheduled snapshot is still running or the deployment is stopped.
+func (sm *ScheduleManager) triggerSnapshot(ctx context.Context, deployment *coh.Coherence, sched *coh.SnapshotScheduleSpec, snapshots []coh.CoherenceSnapshot, scheduled time.Time) {
+	name := ScheduledSnapshotName(deployment.Name, scheduled)
+
+	if deployment.GetReplicas() == 0 {
+		sm.Log.Info("Skipping scheduled snapshot, the deployment is stopped", "Snapshot", name)
+		sm.EventRecorder.Eventf(deployment, nil, coreV1.EventTypeNormal, reconciler.EventReasonReconciling, "ScheduleSnapshot",
+			"skipped scheduled snapshot %s as the deployment has zero replicas", name)
+		return
+	}
+
+	for _, s := range snapshots {
+		if s.Name != name && !s.Status.Phase.IsFinished() {
+			sm.Log.Info("Skipping scheduled snapshot, a previous snapshot is still running", "Snapshot", name, "Running", s.Name)
+			sm.EventRecorder.Eventf(deployment, nil, coreV1.EventTypeWarning, reconciler.EventReasonReconciling, "ScheduleSnapshot",
+				"skipped scheduled snapshot %s as snapshot %s is still running", name, s.Name)
+			return
+		}
+	}
+
+	snapshot := &coh.CoherenceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: deployment.Namespace,
+			Name:      name,
+			Labels: map[string]string{
+				coh.LabelCoherenceDeployment: deployment.Name,
+				coh.LabelComponent:           coh.LabelComponentScheduledSnapshot,
+			},
+		},
+		Spec: coh.CoherenceSnapshotSpec{
+			Coherence:      deployment.Name,
+			Services:       sched.Services,
+			Archive:        sched.Archive,
+			RemoveOnDelete: ptr.To(true),
+			Timeout:        sched.Timeout,
+		},
+	}
+	if err := controllerutil.SetControllerReference(deployment, snapshot, sm.Scheme); err != nil {
+		sm.Log.Error(err, "Failed to set owner of scheduled snapshot", "Snapshot", name)
+		return
+	}
+
+	err := sm.Client.Create(ctx, snapshot)
+	switch {
+	case err != nil && apierrors.IsAlreadyExists(err):
+		// the snapshot was already created for this schedule time
+	case err != nil:
+		sm.Log.Error(err, "Failed to create scheduled snapshot", "Snapshot", name)
+		sm.EventRecorder.Eventf(deployment, nil, coreV1.EventTypeWarning, reconciler.EventReasonFailed, "ScheduleSnapshot",
+			"failed to create scheduled snapshot %s: %s", name, err.Error())
+	default:
+		sm.Log.Info("Created scheduled snapshot", "Snapshot", name)
+		sm.EventRecorder.Eventf(deployment, nil, coreV1.EventTypeNormal, reconciler.EventReasonCreated, "ScheduleSnapshot",
+			"created scheduled snapshot %s", name)
+	}
+}
+
+// pruneSnapshots deletes the scheduled snapshots that are no longer required by the retention policy.
+// Deleting the CoherenceSnapshot resource removes the snapshot from the Coherence cluster.
+func (sm *ScheduleManager) pruneSnapshots(ctx context.Context, deployment *coh.Coherence, sched *coh.SnapshotScheduleSpec, snapshots []coh.CoherenceSnapshot, now time.Time) error {
+	for _, s := range SnapshotsToPrune(snapshots, sched.GetRetentionCount(), sched.GetRetentionAge(), now) {
+		snapshot := s
+		if err := sm.Client.Delete(ctx, &snapshot); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "deleting scheduled snapshot %s", s.Name)
+		}
+		sm.Log.Info("Deleted scheduled snapshot", "Snapshot", s.Name, "Phase", s.Status.Phase)
+		sm.EventRecorder.Eventf(deployment, nil, coreV1.EventTypeNormal, reconciler.EventReasonDeleted, "PruneSnapshot",
+			"deleted scheduled snapshot %s", s.Name)
+	}
+	return nil
+}
+
+// listScheduledSnapshots returns the scheduled CoherenceSnapshot resources for a Coherence resource.
+func (sm *ScheduleManager) listScheduledSnapshots(ctx context.Context, deployment *coh.Coherence) ([]coh.CoherenceSnapshot, error) {
+	list := &coh.CoherenceSnapshotList{}
+	labels := client.MatchingLabels{
+		coh.LabelCoherenceDeployment: deployment.Name,
+		coh.LabelComponent:           coh.LabelComponentScheduledSnapshot,
+	}
+	if err := sm.Client.List(ctx, list, client.InNamespace(deployment.Namespace), labels); err != nil {
+		return nil, errors.Wrapf(err, "listing scheduled snapshots for Coherence resource %s", deployment.Name)
+	}
+	var snapshots []coh.CoherenceSnapshot
+	for _, s := range list.Items {
+		if s.GetDeletionTimestamp() == nil {
+			snapshots = append(snapshots, s)
+		}
+	}
+	return snapshots, nil
+}
+
+// ScheduledSnapshotName returns the name of the CoherenceSnapshot for a schedule time.
+func ScheduledSnapshotName(deployment string, scheduled time.Time) string {
+	return fmt.Sprintf("%s-%d", deployment, scheduled.Unix()/60)
+}
+
+// MostRecentScheduleTime returns the most recent schedule time after earliest and not after now.
+// If there is no schedule time in that range false is returned. Only the most recent schedule time
+// is returned, missed schedule times before it are never run. The schedule time is found by looking
+// back from now over a doubling period until it contains a schedule time, so the number of schedule
+// times checked does not depend on how long ago earliest was.
+func MostRecentScheduleTime(schedule cron.Schedule, loc *time.Location, earliest, now time.Time) (time.Time, bool) {
+	now = now.In(loc)
+	start := earliest.In(loc)
+	for period := time.Minute; now.Add(-period).After(start); period *= 2 {
+		if t := schedule.Next(now.Add(-period)); !t.IsZero() && !t.After(now) {
+			start = now.Add(-period)
+			break
+		}
+	}
+
+	var scheduled time.Time
+	for t := schedule.Next(start); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		scheduled = t
+	}
+	return scheduled, !scheduled.IsZero()
+}
+
+// LatestCompleted returns the snapshot name and completion time of the most recent
+// successfully completed snapshot.
+func LatestCompleted(snapshots []coh.CoherenceSnapshot) (string, *metav1.Time) {
+	var name string
+	var latest *metav1.Time
+	for _, s := range snapshots {
+		if s.Status.Phase != coh.SnapshotPhaseCompleted || s.Status.CompletionTime == nil {
+			continue
+		}
+		if latest == nil || latest.Before(s.Status.CompletionTime) {
+			name = s.Status.SnapshotName
+			latest = s.Status.CompletionTime
+		}
+	}
+	return name, latest
+}
+
+// SnapshotsToPrune returns the snapshots that should be removed by the retention policy.
+// Successful snapshots beyond the retention count, or older than the retention age, are removed,
+// although the most recent successful snapshot is always kept. Failed snapshots are removed once
+// a newer snapshot has completed successfully.
+func SnapshotsToPrune(snapshots []coh.CoherenceSnapshot, count int, age time.Duration, now time.Time) []coh.CoherenceSnapshot {
+	sorted := make([]coh.CoherenceSnapshot, len(snapshots))
+	copy(sorted, snapshots)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[j].CreationTimestamp.Before(&sorted[i].CreationTimestamp)
+	})
+
+	var prune []coh.CoherenceSnapshot
+	completed := 0
+	for _, s := range sorted {
+		switch s.Status.Phase {
+		case coh.SnapshotPhaseCompleted:
+			completed++
+			if completed == 1 {
+				// always keep the most recent successful snapshot
+				continue
+			}
+			expired := age > 0 && s.Status.CompletionTime != nil && now.Sub(s.Status.CompletionTime.Time) > age
+			if (count > 0 && completed > count) || expired {
+				prune = append(prune, s)
+			}
+		case coh.SnapshotPhaseFailed:
+			if completed > 0 {
+				prune = append(prune, s)
+			}
+		}
+	}
+	return prune
+}
@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package snapshot_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/snapshot"
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestMostRecentScheduleTime(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	schedule, err := cron.ParseStandard("0 2 * * *")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	earliest := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	// no schedule time has passed
+	_, found := snapshot.MostRecentScheduleTime(schedule, time.UTC, earliest, earliest.Add(time.Hour))
+	g.Expect(found).To(BeFalse())
+
+	// several schedule times have passed, only the most recent is returned
+	now := time.Date(2026, time.March, 4, 3, 0, 0, 0, time.UTC)
+	scheduled, found := snapshot.MostRecentScheduleTime(schedule, time.UTC, earliest, now)
+	g.Expect(found).To(BeTrue())
+	g.Expect(scheduled).To(Equal(time.Date(2026, time.March, 4, 2, 0, 0, 0, time.UTC)))
+
+	// the schedule time equal to earliest has already been run
+	_, found = snapshot.MostRecentScheduleTime(schedule, time.UTC, scheduled, now)
+	g.Expect(found).To(BeFalse())
+}
+
+func TestMostRecentScheduleTimeIsNotStale(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// a schedule every minute that was last run a year ago has missed far too many
+	// schedule times to walk forward through, the most recent is still returned
+	schedule, err := cron.ParseStandard("* * * * *")
+	g.Expect(err).NotTo(HaveOccurred())
+	now := time.Date(2026, time.March, 4, 3, 0, 30, 0, time.UTC)
+	scheduled, found := snapshot.MostRecentScheduleTime(schedule, time.UTC, now.AddDate(-1, 0, 0), now)
+	g.Expect(found).To(BeTrue())
+	g.Expect(scheduled).To(Equal(time.Date(2026, time.March, 4, 3, 0, 0, 0, time.UTC)))
+
+	// an irregular schedule, at 06:30, 10:30 and 14:30 on weekdays
+	schedule, err = cron.ParseStandard("30 6-16/4 * * 1-5")
+	g.Expect(err).NotTo(HaveOccurred())
+	// Monday 07:00, the most recent schedule time is 06:30 on Monday, not on Friday
+	now = time.Date(2026, time.March, 2, 7, 0, 0, 0, time.UTC)
+	scheduled, found = snapshot.MostRecentScheduleTime(schedule, time.UTC, now.AddDate(0, 0, -30), now)
+	g.Expect(found).To(BeTrue())
+	g.Expect(scheduled).To(Equal(time.Date(2026, time.March, 2, 6, 30, 0, 0, time.UTC)))
+	// Monday 06:00, the most recent schedule time is 14:30 on Friday
+	now = time.Date(2026, time.March, 2, 6, 0, 0, 0, time.UTC)
+	scheduled, found = snapshot.MostRecentScheduleTime(schedule, time.UTC, now.AddDate(0, 0, -30), now)
+	g.Expect(found).To(BeTrue())
+	g.Expect(scheduled).To(Equal(time.Date(2026, time.February, 27, 14, 30, 0, 0, time.UTC)))
+}
+
+func TestReconcileScheduleAddedToExistingDeployment(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	// the Coherence resource was created two days ago, the schedule has just been added
+	deployment := &coh.Coherence{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "test",
+			Name:              "storage",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-48 * time.Hour)),
+		},
+		Spec: coh.CoherenceStatefulSetResourceSpec{
+			CoherenceResourceSpec: coh.CoherenceResourceSpec{Replicas: ptr.To(int32(3))},
+		},
+	}
+	deployment.Spec.Coherence = &coh.CoherenceSpec{Persistence: &coh.PersistenceSpec{
+		SnapshotSchedule: &coh.SnapshotScheduleSpec{Schedule: "* * * * *", Services: []string{"PartitionedCache"}},
+	}}
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	g.Expect(coh.AddToScheme(scheme)).To(Succeed())
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deployment).Build()
+	sm := snapshot.ScheduleManager{Client: c, Scheme: scheme, Log: logr.Discard(), EventRecorder: events.NewFakeRecorder(10)}
+
+	// the schedule times since the Coherence resource was created are not run
+	result, err := sm.ReconcileSchedule(ctx, deployment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.ScheduleStartTime).NotTo(BeNil())
+	g.Expect(result.LastScheduledTime).To(BeNil())
+	list := &coh.CoherenceSnapshotList{}
+	g.Expect(c.List(ctx, list)).To(Succeed())
+	g.Expect(list.Items).To(BeEmpty())
+
+	// once a schedule time has passed since the schedule was added a single snapshot is taken
+	start := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+	deployment.Status.SnapshotScheduleStartTime = &start
+	result, err = sm.ReconcileSchedule(ctx, deployment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.ScheduleStartTime).To(Equal(&start))
+	g.Expect(result.LastScheduledTime).NotTo(BeNil())
+	g.Expect(c.List(ctx, list)).To(Succeed())
+	g.Expect(list.Items).To(HaveLen(1))
+	g.Expect(list.Items[0].Name).To(Equal(snapshot.ScheduledSnapshotName(deployment.Name, result.LastScheduledTime.Time)))
+
+	// removing the schedule clears the start time
+	deployment.Spec.Coherence.Persistence.SnapshotSchedule = nil
+	result, err = sm.ReconcileSchedule(ctx, deployment)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.ScheduleStartTime).To(BeNil())
+}
+
+func TestScheduledSnapshotNameIsStable(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheduled := time.Date(2026, time.March, 4, 2, 0, 0, 0, time.UTC)
+	name := snapshot.ScheduledSnapshotName("storage", scheduled)
+	g.Expect(name).To(Equal(snapshot.ScheduledSnapshotName("storage", scheduled.Add(30*time.Second))))
+	g.Expect(name).NotTo(Equal(snapshot.ScheduledSnapshotName("storage", scheduled.Add(time.Minute))))
+}
+
+func TestLatestCompleted(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Now()
+	snapshots := []coh.CoherenceSnapshot{
+		scheduledSnapshot("one", now.Add(-3*time.Hour), coh.SnapshotPhaseCompleted),
+		scheduledSnapshot("two", now.Add(-2*time.Hour), coh.SnapshotPhaseCompleted),
+		scheduledSnapshot("three", now.Add(-time.Hour), coh.SnapshotPhaseFailed),
+	}
+
+	name, completed := snapshot.LatestCompleted(snapshots)
+	g.Expect(name).To(Equal("two"))
+	g.Expect(completed).NotTo(BeNil())
+	g.Expect(completed.Time).To(Equal(snapshots[1].Status.CompletionTime.Time))
+
+	name, completed = snapshot.LatestCompleted(nil)
+	g.Expect(name).To(BeEmpty())
+	g.Expect(completed).To(BeNil())
+}
+
+func TestSnapshotsToPruneByCount(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Now()
+	snapshots := []coh.CoherenceSnapshot{
+		scheduledSnapshot("one", now.Add(-4*time.Hour), coh.SnapshotPhaseCompleted),
+		scheduledSnapshot("two", now.Add(-3*time.Hour), coh.SnapshotPhaseFailed),
+		scheduledSnapshot("three", now.Add(-2*time.Hour), coh.SnapshotPhaseCompleted),
+		scheduledSnapshot("four", now.Add(-time.Hour), coh.SnapshotPhaseCompleted),
+		scheduledSnapshot("five", now, coh.SnapshotPhaseInProgress),
+	}
+
+	prune := snapshot.SnapshotsToPrune(snapshots, 2, 0, now)
+	g.Expect(names(prune)).To(ConsistOf("one", "two"))
+}
+
+func TestSnapshotsToPruneByAgeKeepsLatest(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Now()
+	snapshots := []coh.CoherenceSnapshot{
+		scheduledSnapshot("one", now.Add(-72*time.Hour), coh.SnapshotPhaseCompleted),
+		scheduledSnapshot("two", now.Add(-48*time.Hour), coh.SnapshotPhaseCompleted),
+		scheduledSnapshot("three", now.Add(-time.Hour), coh.SnapshotPhaseFailed),
+	}
+
+	prune := snapshot.SnapshotsToPrune(snapshots, 0, 24*time.Hour, now)
+	g.Expect(names(prune)).To(ConsistOf("one"))
+}
+
+func scheduledSnapshot(name string, created time.Time, phase coh.SnapshotPhase) coh.CoherenceSnapshot {
+	s := coh.CoherenceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+		Status: coh.CoherenceSnapshotStatus{
+			Phase:        phase,
+			SnapshotName: name,
+		},
+	}
+	if phase.IsFinished() {
+		completed := metav1.NewTime(created.Add(time.Minute))
+		s.Status.CompletionTime = &completed
+	}
+	return s
+}
+
+func names(snapshots []coh.CoherenceSnapshot) []string {
+	var n []string
+	for _, s := range snapshots {
+		n = append(n, s.Name)
+	}
+	return n
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package statefulset
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/status"
+	"github.com/oracle/coherence-operator/pkg/approval"
+	"github.com/oracle/coherence-operator/pkg/events"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// EventReasonApprovalDenied is the event reason when the approval webhook denies an action.
+	EventReasonApprovalDenied = "ApprovalDenied"
+	// EventReasonApprovalDeferred is the event reason when the approval webhook defers an action.
+	EventReasonApprovalDeferred = "ApprovalDeferred"
+)
+
+// requestApproval requests the approval of a disruptive action from the approval webhook of a deployment.
+// The action is approved if no approval webhook is configured. The decision is recorded in the status of
+// the deployment, and an event is sent if the action is not approved.
+// The decision is returned and, if the action is not approved, a message describing the decision is returned along with the result
+// to return from the reconcile: a deferred action is requeued after the retry time, a denied action
+// is not requeued.
+func requestApproval(ctx context.Context, sm *status.StatusManager, recorder events.OwnedEventRecorder, deployment coh.CoherenceResource,
+	spec *coh.ApprovalWebhookSpec, action coh.ApprovalAction, pods []corev1.Pod, inputs map[string]string) (coh.ApprovalDecision, string, reconcile.Result) {
+	if spec == nil {
+		return coh.ApprovalDecisionApprove, "", reconcile.Result{}
+	}
+
+	var response approval.Response
+	if token, err := approval.GetBearerToken(ctx, sm.Client, deployment.GetNamespace(), spec); err != nil {
+		// the action is never approved if the webhook cannot be called
+		response = approval.Response{Decision: coh.ApprovalDecisionDefer, Reason: err.Error()}
+	} else {
+		response = approval.Call(ctx, nil, spec, token, approval.NewRequest(deployment, action, pods, inputs))
+	}
+	log.Info("Approval webhook decision", "Namespace", deployment.GetNamespace(), "Name", deployment.GetName(),
+		"Action", action, "Decision", response.Decision, "Reason", response.Reason)
+
+	decision := coh.ApprovalStatus{
+		Time:     metav1.Now(),
+		Action:   action,
+		Inputs:   maps.Clone(inputs),
+		Decision: response.Decision,
+		Reason:   response.Reason,
+	}
+	if err := sm.SetApproval(ctx, deployment, decision); err != nil {
+		log.Info("Failed to record approval decision", "Namespace", deployment.GetNamespace(), "Name", deployment.GetName(),
+			"Error", err.Error())
+	}
+
+	switch response.Decision {
+	case coh.ApprovalDecisionApprove:
+		return response.Decision, "", reconcile.Result{}
+	case coh.ApprovalDecisionDeny:
+		msg := approvalMessage(action, "denied", response.Reason)
+		recorder.Warn(EventReasonApprovalDenied, msg)
+		return response.Decision, msg, reconcile.Result{}
+	default:
+		retry := response.GetRetryAfter(spec)
+		msg := approvalMessage(action, "deferred", response.Reason)
+		recorder.Info(EventReasonApprovalDeferred, msg)
+		return response.Decision, msg, reconcile.Result{RequeueAfter: retry}
+	}
+}
+
+// approvalMessage returns the message describing an approval webhook decision that did not approve an action.
+func approvalMessage(action coh.ApprovalAction, decision, reason string) string {
+	if reason == "" {
+		return fmt.Sprintf("the approval webhook %s the %s action", decision, action)
+	}
+	return fmt.Sprintf("the approval webhook %s the %s action: %s", decision, action, reason)
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package statefulset_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/statefulset"
+	"github.com/oracle/coherence-operator/pkg/approval"
+	"github.com/oracle/coherence-operator/pkg/fakes/stubs"
+	"github.com/oracle/coherence-operator/pkg/probe"
+)
+
+func TestCanaryUpgradeDeniedByApprovalWebhook(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	var requests []approval.Request
+	server := newApprovalTestServer(g, &requests, `{"decision":"Deny","reason":"change freeze"}`)
+	defer server.Close()
+
+	deployment := newApprovalTestCoherence(g, server.URL)
+	c, kc, sts := newCanaryTest(deployment, time.Now().Add(-10*time.Minute), 1)
+
+	s := statefulset.GetUpgradeStrategy(deployment, probe.CoherenceProbe{Client: c}, stubs.NewStatusManager(c))
+	result, err := s.RollingUpgrade(ctx, sts, "storage-wka", kc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(BeZero())
+	g.Expect(remainingPods(g, kc)).To(HaveLen(3))
+
+	g.Expect(requests).To(HaveLen(1))
+	g.Expect(requests[0].Action).To(Equal(coh.ApprovalActionDeletePods))
+	g.Expect(requests[0].Pods).To(HaveLen(1))
+	g.Expect(requests[0].Pods[0].Name).To(Equal("storage-1"))
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	g.Expect(latest.Status.Approval).NotTo(BeNil())
+	g.Expect(latest.Status.Approval.Action).To(Equal(coh.ApprovalActionDeletePods))
+	g.Expect(latest.Status.Approval.Decision).To(Equal(coh.ApprovalDecisionDeny))
+	g.Expect(latest.Status.Approval.Reason).To(Equal("change freeze"))
+	g.Expect(latest.Status.History).NotTo(BeEmpty())
+	entry := latest.Status.History[len(latest.Status.History)-1]
+	g.Expect(entry.Result).To(Equal(coh.HistoryResultDeferred))
+	g.Expect(entry.Message).To(ContainSubstring("denied the DeletePods action: change freeze"))
+}
+
+func TestCanaryUpgradeDeferredByApprovalWebhook(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	var requests []approval.Request
+	server := newApprovalTestServer(g, &requests, `{"decision":"Defer","retryAfterSeconds":120}`)
+	defer server.Close()
+
+	deployment := newApprovalTestCoherence(g, server.URL)
+	c, kc, sts := newCanaryTest(deployment, time.Now().Add(-10*time.Minute), 1)
+
+	s := statefulset.GetUpgradeStrategy(deployment, probe.CoherenceProbe{Client: c}, stubs.NewStatusManager(c))
+	result, err := s.RollingUpgrade(ctx, sts, "storage-wka", kc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(2 * time.Minute))
+	g.Expect(remainingPods(g, kc)).To(HaveLen(3))
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	g.Expect(latest.Status.Approval).NotTo(BeNil())
+	g.Expect(latest.Status.Approval.Decision).To(Equal(coh.ApprovalDecisionDefer))
+}
+
+func TestCanaryUpgradeApprovedByApprovalWebhook(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	var requests []approval.Request
+	server := newApprovalTestServer(g, &requests, `{"decision":"Approve"}`)
+	defer server.Close()
+
+	deployment := newApprovalTestCoherence(g, server.URL)
+	c, kc, sts := newCanaryTest(deployment, time.Now().Add(-10*time.Minute), 1)
+
+	s := statefulset.GetUpgradeStrategy(deployment, probe.CoherenceProbe{Client: c}, stubs.NewStatusManager(c))
+	result, err := s.RollingUpgrade(ctx, sts, "storage-wka", kc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(BeZero())
+	g.Expect(remainingPods(g, kc)).To(ConsistOf("storage-0", "storage-2"))
+	g.Expect(requests).To(HaveLen(1))
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	g.Expect(latest.Status.Approval).NotTo(BeNil())
+	g.Expect(latest.Status.Approval.Decision).To(Equal(coh.ApprovalDecisionApprove))
+}
+
+// newApprovalTestServer returns an approval webhook stub that records the requests and always
+// returns the same reply. The verification probe of the canary Pods is also served.
+func newApprovalTestServer(g *WithT, requests *[]approval.Request, reply string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			// the canary verification probe
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		request := approval.Request{}
+		g.Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+		*requests = append(*requests, request)
+		_, _ = w.Write([]byte(reply))
+	}))
+}
+
+func newApprovalTestCoherence(g *WithT, url string) *coh.Coherence {
+	server := &httptest.Server{URL: url}
+	deployment := newCanaryTestCoherence(&coh.CanaryUpgradeSpec{Probe: newCanaryTestProbe(g, server)})
+	deployment.Spec.ApprovalWebhook = &coh.ApprovalWebhookSpec{URL: url + "/approve"}
+	return deployment
+}
@@ -0,0 +1,325 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package statefulset
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/status"
+	"github.com/oracle/coherence-operator/pkg/metrics"
+	"github.com/oracle/coherence-operator/pkg/probe"
+	"github.com/oracle/coherence-operator/pkg/tracing"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// EventReasonCanaryFailed is the event reason when the canary Pods of a Canary upgrade fail verification.
+	EventReasonCanaryFailed = "CanaryFailed"
+	// EventReasonCanaryVerified is the event reason when the canary Pods of a Canary upgrade pass verification.
+	EventReasonCanaryVerified = "CanaryVerified"
+
+	// canaryJobRequeue is how often a running canary verification Job is checked.
+	canaryJobRequeue = 30 * time.Second
+)
+
+// ----- CanaryUpgradeStrategy ---------------------------------------------------------------------
+
+var _ UpgradeStrategy = CanaryUpgradeStrategy{}
+
+// CanaryUpgradeStrategy upgrades the canary Pods one at a time, pauses, verifies the canary Pods
+// and then upgrades the remaining Pods one at a time. The canary Pods are the Pods with an ordinal
+// greater than or equal to the partition, which is the replica count less the number of canary Pods.
+// If verification fails the upgrade is held at the partition and the CanaryFailed condition is set.
+type CanaryUpgradeStrategy struct {
+	cp           probe.CoherenceProbe
+	sm           *status.StatusManager
+	scalingProbe *coh.Probe
+	canary       *coh.CanaryUpgradeSpec
+	approval     *coh.ApprovalWebhookSpec
+	deployment   coh.CoherenceResource
+}
+
+func (in CanaryUpgradeStrategy) IsOperatorManaged() bool {
+	return true
+}
+
+func (in CanaryUpgradeStrategy) RollingUpgrade(ctx context.Context, sts *appsv1.StatefulSet, svc string, c kubernetes.Interface) (result reconcile.Result, err error) {
+	start := time.Now()
+	ctx, span := tracing.StartForResource(ctx, "RollingUpgrade", coh.ResourceTypeCoherence.Name(),
+		types.NamespacedName{Namespace: sts.Namespace, Name: sts.Name},
+		tracing.AttributeUpgradeStrategy.String(string(coh.UpgradeCanary)))
+	defer func() { tracing.End(span, err) }()
+
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	if sts.Status.ReadyReplicas != replicas || sts.Status.CurrentRevision == sts.Status.UpdateRevision {
+		return reconcile.Result{}, nil
+	}
+
+	pods, err := in.cp.GetPodsForStatefulSet(ctx, sts)
+	if err != nil {
+		log.Error(err, "Error getting list of Pods for StatefulSet", "Namespace", sts.Namespace, "Name", sts.Name)
+		return reconcile.Result{}, err
+	}
+	if len(pods.Items) != int(replicas) {
+		log.Info("Count of Pods found for StatefulSet does not match replicas", "Namespace", sts.Namespace, "Name", sts.Name, "Replicas", replicas, "Found", len(pods.Items))
+		return reconcile.Result{}, nil
+	}
+
+	revision := sts.Status.UpdateRevision
+	partition := replicas - in.canary.GetReplicas(replicas)
+	canaries, pendingCanaries, pending := groupCanaryPods(sts, pods, partition, revision)
+	inputs := func(p ...corev1.Pod) map[string]string {
+		return upgradeHistoryInputs(coh.UpgradeCanary, revision, "partition", strconv.Itoa(int(partition)), p)
+	}
+
+	if len(pendingCanaries) > 0 {
+		// upgrade the canary Pods first
+		return in.upgradePod(ctx, sts, svc, c, pods, pendingCanaries[0], inputs(pendingCanaries[0]), start)
+	}
+
+	if len(pending) == 0 {
+		// nothing to do, all pods are at the required revision
+		return reconcile.Result{}, nil
+	}
+
+	// the canary Pods are upgraded, pause before verifying them
+	pause := in.canary.GetPause()
+	if wait := time.Until(latestReadyTime(canaries).Add(pause)); wait > 0 {
+		log.Info("Canary Pods upgraded, pausing before verification", "Namespace", sts.Namespace, "Name", sts.Name, "Partition", partition, "Wait", wait)
+		recordHistory(ctx, in.sm, in.deployment, coh.NewHistoryEntry(coh.HistoryOperationUpgradeStep, coh.HistoryResultDeferred,
+			fmt.Sprintf("pausing for %s after upgrading %d canary Pods", pause, len(canaries)), inputs(canaries...)))
+		return reconcile.Result{RequeueAfter: wait}, nil
+	}
+
+	verified, reason, msg, requeue, err := in.verify(ctx, sts, svc, pods, canaries, revision)
+	switch {
+	case err != nil:
+		return reconcile.Result{}, err
+	case requeue > 0:
+		// waiting for the verification Job to complete
+		return reconcile.Result{RequeueAfter: requeue}, nil
+	case !verified:
+		// hold the upgrade at the partition
+		log.Info("Canary Pods failed verification, upgrade is held", "Namespace", sts.Namespace, "Name", sts.Name, "Partition", partition, "Reason", reason)
+		in.updateCanaryFailed(ctx, corev1.ConditionTrue, reason, msg)
+		recordHistory(ctx, in.sm, in.deployment, coh.NewHistoryEntry(coh.HistoryOperationUpgradeStep, coh.HistoryResultFailed,
+			msg, inputs(canaries...)))
+		metrics.RecordRollingUpgradeStep(sts.Namespace, sts.Name, string(coh.UpgradeCanary), metrics.ResultFailed, time.Since(start))
+		return reconcile.Result{RequeueAfter: time.Minute}, nil
+	}
+
+	in.updateCanaryFailed(ctx, corev1.ConditionFalse, coh.ReasonCanaryVerified, fmt.Sprintf("%d canary Pods passed verification", len(canaries)))
+
+	// upgrade the remaining Pods one at a time, each only if the scaling probe passes
+	log.Info("Canary Pods verified", "Namespace", sts.Namespace, "Name", sts.Name, "Partition", partition)
+	return in.upgradePod(ctx, sts, svc, c, pods, pending[0], inputs(pending[0]), start)
+}
+
+// upgradePod upgrades a single Pod if the scaling probe passes.
+func (in CanaryUpgradeStrategy) upgradePod(ctx context.Context, sts *appsv1.StatefulSet, svc string, c kubernetes.Interface,
+	pods corev1.PodList, pod corev1.Pod, inputs map[string]string, start time.Time) (reconcile.Result, error) {
+	if !in.cp.ExecuteProbeForSubSetOfPods(ctx, sts, svc, in.scalingProbe, pods, corev1.PodList{Items: []corev1.Pod{pod}}) {
+		log.Info("Pods failed Status HA check, upgrade is deferred for one minute", "Namespace", sts.Namespace, "Name", sts.Name, "Pod", pod.Name)
+		metrics.RecordRollingUpgradeStep(sts.Namespace, sts.Name, string(coh.UpgradeCanary), metrics.ResultDeferred, time.Since(start))
+		recordHistory(ctx, in.sm, in.deployment, coh.NewHistoryEntry(coh.HistoryOperationUpgradeStep, coh.HistoryResultDeferred,
+			fmt.Sprintf("Pod %s failed the StatusHA check", pod.Name), inputs))
+		return reconcile.Result{RequeueAfter: time.Minute}, nil
+	}
+	log.Info("Upgrading Pod", "Namespace", sts.Namespace, "Name", sts.Name, "Pod", pod.Name)
+	return in.deletePod(ctx, sts, c, pod, inputs, start)
+}
+
+// deletePod deletes a Pod so that it is recreated at the update revision, if the deletion is approved.
+func (in CanaryUpgradeStrategy) deletePod(ctx context.Context, sts *appsv1.StatefulSet, c kubernetes.Interface, pod corev1.Pod,
+	inputs map[string]string, start time.Time) (reconcile.Result, error) {
+	decision, msg, approvalResult := requestApproval(ctx, in.sm, in.cp.EventRecorder, in.deployment, in.approval,
+		coh.ApprovalActionDeletePods, []corev1.Pod{pod}, inputs)
+	if decision != coh.ApprovalDecisionApprove {
+		log.Info("Upgrade of Pod was not approved", "Namespace", sts.Namespace, "Name", sts.Name, "Pod", pod.Name, "Reason", msg)
+		metrics.RecordRollingUpgradeStep(sts.Namespace, sts.Name, string(coh.UpgradeCanary), metrics.ResultDeferred, time.Since(start))
+		recordHistory(ctx, in.sm, in.deployment, coh.NewHistoryEntry(coh.HistoryOperationUpgradeStep, coh.HistoryResultDeferred, msg, inputs))
+		return approvalResult, nil
+	}
+
+	err := deletePods(ctx, corev1.PodList{Items: []corev1.Pod{pod}}, c)
+	result := metrics.ResultSucceeded
+	if err != nil {
+		result = metrics.ResultFailed
+		recordHistory(ctx, in.sm, in.deployment, coh.NewHistoryEntry(coh.HistoryOperationUpgradeStep, coh.HistoryResultFailed,
+			fmt.Sprintf("failed to delete Pod %s: %s", pod.Name, err.Error()), inputs))
+	} else {
+		recordHistory(ctx, in.sm, in.deployment, coh.NewHistoryEntry(coh.HistoryOperationUpgradeStep, coh.HistoryResultSucceeded,
+			fmt.Sprintf("deleted Pod %s", pod.Name), inputs))
+	}
+	metrics.RecordRollingUpgradeStep(sts.Namespace, sts.Name, string(coh.UpgradeCanary), result, time.Since(start))
+	// The deleted Pod will be rescheduled, when it is ready the StatefulSet status
+	// will be updated, and we will end up back in this method
+	return reconcile.Result{}, err
+}
+
+// verify runs the scaling probe, the verification probe and the verification Job for the canary Pods.
+// If the verification Job is still running a positive requeue duration is returned.
+func (in CanaryUpgradeStrategy) verify(ctx context.Context, sts *appsv1.StatefulSet, svc string, pods corev1.PodList, canaries []corev1.Pod,
+	revision string) (bool, coh.ConditionReason, string, time.Duration, error) {
+	if !in.cp.ExecuteProbeForSubSetOfPods(ctx, sts, svc, in.scalingProbe, pods, corev1.PodList{Items: canaries}) {
+		return false, coh.ReasonCanaryNotStatusHA, "the scaling probe failed after upgrading the canary Pods", 0, nil
+	}
+
+	if in.canary != nil && in.canary.Probe != nil {
+		for _, pod := range canaries {
+			ok, err := in.cp.RunProbe(ctx, pod, svc, in.canary.Probe)
+			if err != nil {
+				return false, coh.ReasonCanaryProbeFailed, fmt.Sprintf("the verification probe failed for canary Pod %s: %s", pod.Name, err.Error()), 0, nil
+			}
+			if !ok {
+				return false, coh.ReasonCanaryProbeFailed, fmt.Sprintf("the verification probe failed for canary Pod %s", pod.Name), 0, nil
+			}
+		}
+	}
+
+	if in.canary != nil && in.canary.Job != nil {
+		job, err := in.ensureVerificationJob(ctx, sts, revision)
+		if err != nil {
+			return false, "", "", 0, err
+		}
+		switch {
+		case isJobConditionTrue(job, batchv1.JobFailed):
+			return false, coh.ReasonCanaryJobFailed, fmt.Sprintf("the verification Job %s failed", job.Name), 0, nil
+		case !isJobConditionTrue(job, batchv1.JobComplete):
+			log.Info("Waiting for canary verification Job to complete", "Namespace", sts.Namespace, "Name", sts.Name, "Job", job.Name)
+			return false, "", "", canaryJobRequeue, nil
+		}
+	}
+	return true, "", "", 0, nil
+}
+
+// ensureVerificationJob returns the verification Job for the revision, creating the Job if it does not exist.
+func (in CanaryUpgradeStrategy) ensureVerificationJob(ctx context.Context, sts *appsv1.StatefulSet, revision string) (*batchv1.Job, error) {
+	// the revision name is prefixed with the StatefulSet name, which may make it too long for a label value
+	labels := map[string]string{
+		coh.LabelCoherenceDeployment: sts.Name,
+		coh.LabelCanaryRevision:      strings.TrimPrefix(revision, sts.Name+"-"),
+	}
+
+	jobs := batchv1.JobList{}
+	if err := in.cp.Client.List(ctx, &jobs, client.InNamespace(sts.Namespace), client.MatchingLabels(labels)); err != nil {
+		return nil, err
+	}
+	if len(jobs.Items) > 0 {
+		return &jobs.Items[0], nil
+	}
+
+	job := buildActionJob("canary", in.canary.Job, in.deployment)
+	jobLabels := make(map[string]string)
+	for k, v := range job.Labels {
+		jobLabels[k] = v
+	}
+	for k, v := range labels {
+		jobLabels[k] = v
+	}
+	job.Labels = jobLabels
+	if err := in.cp.Client.Create(ctx, job); err != nil {
+		return nil, err
+	}
+	log.Info("Created canary verification Job", "Namespace", sts.Namespace, "Name", sts.Name, "Job", job.Name)
+	recordHistory(ctx, in.sm, in.deployment, coh.NewHistoryEntry(coh.HistoryOperationUpgradeStep, coh.HistoryResultDeferred,
+		fmt.Sprintf("created canary verification Job %s", job.Name), map[string]string{"strategy": string(coh.UpgradeCanary), "revision": revision}))
+	return job, nil
+}
+
+// updateCanaryFailed sets the CanaryFailed condition, sending an event if the condition has changed.
+func (in CanaryUpgradeStrategy) updateCanaryFailed(ctx context.Context, s corev1.ConditionStatus, reason coh.ConditionReason, msg string) {
+	if current := in.deployment.GetStatus().Conditions.GetCondition(coh.ConditionTypeCanaryFailed); current == nil || current.Status != s ||
+		current.Reason != reason || current.Message != msg {
+		if s == corev1.ConditionTrue {
+			in.cp.EventRecorder.Warn(EventReasonCanaryFailed, msg)
+		} else {
+			in.cp.EventRecorder.Info(EventReasonCanaryVerified, msg)
+		}
+	}
+
+	if in.sm == nil {
+		return
+	}
+	c := coh.Condition{Type: coh.ConditionTypeCanaryFailed, Status: s, Reason: reason, Message: msg}
+	if err := in.sm.SetCondition(ctx, in.deployment, c); err != nil {
+		log.Info("Failed to update CanaryFailed condition", "Namespace", in.deployment.GetNamespace(), "Name", in.deployment.GetName(), "Error", err.Error())
+	}
+}
+
+// groupCanaryPods returns the canary Pods, the canary Pods that are not at the required revision and the
+// other Pods that are not at the required revision. The Pods not at the required revision are sorted
+// by descending ordinal, so they are upgraded in the same order as a StatefulSet rolling upgrade.
+func groupCanaryPods(sts *appsv1.StatefulSet, pods corev1.PodList, partition int32, revision string) ([]corev1.Pod, []corev1.Pod, []corev1.Pod) {
+	var canaries, pendingCanaries, pending []corev1.Pod
+	for _, pod := range pods.Items {
+		ordinal := podOrdinal(sts, pod)
+		upgraded := pod.Labels["controller-revision-hash"] == revision
+		switch {
+		case ordinal >= int(partition):
+			canaries = append(canaries, pod)
+			if !upgraded {
+				pendingCanaries = append(pendingCanaries, pod)
+			}
+		case !upgraded:
+			pending = append(pending, pod)
+		}
+	}
+	byOrdinalDesc := func(p []corev1.Pod) {
+		sort.Slice(p, func(i, j int) bool { return podOrdinal(sts, p[i]) > podOrdinal(sts, p[j]) })
+	}
+	byOrdinalDesc(pendingCanaries)
+	byOrdinalDesc(pending)
+	return canaries, pendingCanaries, pending
+}
+
+// podOrdinal returns the ordinal of a StatefulSet Pod, or -1 if the Pod name does not have an ordinal.
+func podOrdinal(sts *appsv1.StatefulSet, pod corev1.Pod) int {
+	ordinal, err := strconv.Atoi(strings.TrimPrefix(pod.Name, sts.Name+"-"))
+	if err != nil {
+		return -1
+	}
+	return ordinal
+}
+
+// latestReadyTime returns the latest time that any of the Pods became ready.
+func latestReadyTime(pods []corev1.Pod) time.Time {
+	var latest time.Time
+	for _, pod := range pods {
+		for _, c := range pod.Status.Conditions {
+			if c.Type == corev1.PodReady && c.Status == corev1.ConditionTrue && c.LastTransitionTime.After(latest) {
+				latest = c.LastTransitionTime.Time
+			}
+		}
+	}
+	return latest
+}
+
+// isJobConditionTrue returns true if the Job has the specified condition with a true status.
+func isJobConditionTrue(job *batchv1.Job, t batchv1.JobConditionType) bool {
+	for _, c := range job.Status.Conditions {
+		if c.Type == t && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
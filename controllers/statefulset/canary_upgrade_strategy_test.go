@@ -0,0 +1,244 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package statefulset_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/statefulset"
+	"github.com/oracle/coherence-operator/pkg/fakes/stubs"
+	"github.com/oracle/coherence-operator/pkg/probe"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	canaryTestCurrent = "storage-1111"
+	canaryTestUpdate  = "storage-2222"
+)
+
+func TestUseUpgradeStrategyCanary(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := &coh.Coherence{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-deployment",
+		},
+		Spec: coh.CoherenceStatefulSetResourceSpec{
+			RollingUpdateStrategy: ptr.To(coh.UpgradeCanary),
+		},
+	}
+
+	p := probe.CoherenceProbe{}
+	s := statefulset.GetUpgradeStrategy(c, p, nil)
+
+	g.Expect(s).To(BeAssignableToTypeOf(statefulset.CanaryUpgradeStrategy{}))
+	g.Expect(s.IsOperatorManaged()).To(BeTrue())
+}
+
+func TestCanaryUpgradeUpgradesHighestOrdinalPodFirst(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	deployment := newCanaryTestCoherence(nil)
+	c, kc, sts := newCanaryTest(deployment, time.Now(), 0)
+
+	s := statefulset.GetUpgradeStrategy(deployment, probe.CoherenceProbe{Client: c}, stubs.NewStatusManager(c))
+	result, err := s.RollingUpgrade(ctx, sts, "storage-wka", kc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(BeZero())
+	g.Expect(remainingPods(g, kc)).To(ConsistOf("storage-0", "storage-1"))
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	g.Expect(latest.Status.History).To(HaveLen(1))
+	g.Expect(latest.Status.History[0].Result).To(Equal(coh.HistoryResultSucceeded))
+	g.Expect(latest.Status.History[0].Inputs).To(HaveKeyWithValue("partition", "2"))
+}
+
+func TestCanaryUpgradePausesAfterCanaryPodsUpgraded(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	deployment := newCanaryTestCoherence(&coh.CanaryUpgradeSpec{Pause: &metav1.Duration{Duration: 5 * time.Minute}})
+	c, kc, sts := newCanaryTest(deployment, time.Now(), 1)
+
+	s := statefulset.GetUpgradeStrategy(deployment, probe.CoherenceProbe{Client: c}, stubs.NewStatusManager(c))
+	result, err := s.RollingUpgrade(ctx, sts, "storage-wka", kc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(BeNumerically(">", 4*time.Minute))
+	g.Expect(result.RequeueAfter).To(BeNumerically("<=", 5*time.Minute))
+	g.Expect(remainingPods(g, kc)).To(HaveLen(3))
+}
+
+func TestCanaryUpgradeHeldWhenVerificationProbeFails(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	deployment := newCanaryTestCoherence(&coh.CanaryUpgradeSpec{Probe: newCanaryTestProbe(g, server)})
+	c, kc, sts := newCanaryTest(deployment, time.Now().Add(-10*time.Minute), 1)
+
+	s := statefulset.GetUpgradeStrategy(deployment, probe.CoherenceProbe{Client: c}, stubs.NewStatusManager(c))
+	result, err := s.RollingUpgrade(ctx, sts, "storage-wka", kc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(time.Minute))
+	g.Expect(remainingPods(g, kc)).To(HaveLen(3))
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	condition := latest.Status.Conditions.GetCondition(coh.ConditionTypeCanaryFailed)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+	g.Expect(condition.Reason).To(Equal(coh.ReasonCanaryProbeFailed))
+}
+
+func TestCanaryUpgradeContinuesWhenVerificationProbePasses(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	deployment := newCanaryTestCoherence(&coh.CanaryUpgradeSpec{Probe: newCanaryTestProbe(g, server)})
+	c, kc, sts := newCanaryTest(deployment, time.Now().Add(-10*time.Minute), 1)
+
+	s := statefulset.GetUpgradeStrategy(deployment, probe.CoherenceProbe{Client: c}, stubs.NewStatusManager(c))
+	result, err := s.RollingUpgrade(ctx, sts, "storage-wka", kc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(BeZero())
+	g.Expect(remainingPods(g, kc)).To(ConsistOf("storage-0", "storage-2"))
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	condition := latest.Status.Conditions.GetCondition(coh.ConditionTypeCanaryFailed)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(coh.ReasonCanaryVerified))
+}
+
+func TestCanaryUpgradeDeferredWhenScalingProbeFailsAfterVerification(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	// the scaling probe passes when the canary Pods are verified and fails for the next Pod
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) > 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	deployment := newCanaryTestCoherence(nil)
+	deployment.Spec.Scaling.Probe = newCanaryTestProbe(g, server)
+	c, kc, sts := newCanaryTest(deployment, time.Now().Add(-10*time.Minute), 1)
+
+	s := statefulset.GetUpgradeStrategy(deployment, probe.CoherenceProbe{Client: c}, stubs.NewStatusManager(c))
+	result, err := s.RollingUpgrade(ctx, sts, "storage-wka", kc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(time.Minute))
+	g.Expect(remainingPods(g, kc)).To(HaveLen(3))
+	g.Expect(requests.Load()).To(Equal(int32(2)))
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	g.Expect(latest.Status.History).NotTo(BeEmpty())
+	entry := latest.Status.History[len(latest.Status.History)-1]
+	g.Expect(entry.Result).To(Equal(coh.HistoryResultDeferred))
+	g.Expect(entry.Message).To(Equal("Pod storage-1 failed the StatusHA check"))
+}
+
+func newCanaryTestCoherence(canary *coh.CanaryUpgradeSpec) *coh.Coherence {
+	return stubs.NewCoherence(coh.CoherenceStatefulSetResourceSpec{
+		RollingUpdateStrategy: ptr.To(coh.UpgradeCanary),
+		RollingUpdateCanary:   canary,
+		// a probe with no handler always passes, so no Coherence cluster is required
+		Scaling: &coh.ScalingSpec{Probe: &coh.Probe{}},
+	})
+}
+
+// newCanaryTest returns the clients and the StatefulSet for an upgrade of a deployment with three Pods,
+// where the specified number of Pods with the highest ordinals are at the update revision. All the Pods
+// became ready at the specified time.
+func newCanaryTest(deployment *coh.Coherence, ready time.Time, upgraded int) (client.Client, *kfake.Clientset, *appsv1.StatefulSet) {
+	pods := newCanaryTestPods(3, ready)
+	objects := make([]client.Object, 0, len(pods))
+	kubeObjects := make([]runtime.Object, 0, len(pods))
+	for i := range pods {
+		if i >= len(pods)-upgraded {
+			pods[i].Labels["controller-revision-hash"] = canaryTestUpdate
+		}
+		objects = append(objects, &pods[i])
+		kubeObjects = append(kubeObjects, pods[i].DeepCopy())
+	}
+	return stubs.NewClient(deployment, objects...), kfake.NewClientset(kubeObjects...), newCanaryTestStatefulSet(3)
+}
+
+// newCanaryTestStatefulSet returns a StatefulSet with an upgrade from the current to the update revision in progress.
+func newCanaryTestStatefulSet(replicas int32) *appsv1.StatefulSet {
+	sts := stubs.NewStatefulSet(stubs.NewCoherence(coh.CoherenceStatefulSetResourceSpec{}), replicas)
+	sts.Status.CurrentRevision = canaryTestCurrent
+	sts.Status.UpdateRevision = canaryTestUpdate
+	return sts
+}
+
+// newCanaryTestPods returns Pods at the current revision, which became ready at the specified time.
+func newCanaryTestPods(count int, ready time.Time) []corev1.Pod {
+	deployment := stubs.NewCoherence(coh.CoherenceStatefulSetResourceSpec{})
+	pods := make([]corev1.Pod, count)
+	for i := range pods {
+		pods[i] = *stubs.NewPod(deployment, i)
+		pods[i].Labels["controller-revision-hash"] = canaryTestCurrent
+		pods[i].Status.PodIP = "127.0.0.1"
+		pods[i].Status.Conditions[0].LastTransitionTime = metav1.NewTime(ready)
+	}
+	return pods
+}
+
+func newCanaryTestProbe(g *WithT, server *httptest.Server) *coh.Probe {
+	u, err := url.Parse(server.URL)
+	g.Expect(err).NotTo(HaveOccurred())
+	port, err := strconv.Atoi(u.Port())
+	g.Expect(err).NotTo(HaveOccurred())
+	return &coh.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{Host: u.Hostname(), Port: intstr.FromInt32(int32(port)), Path: "/ready"},
+		},
+	}
+}
+
+func remainingPods(g *WithT, kc *kfake.Clientset) []string {
+	list, err := kc.CoreV1().Pods("test").List(context.Background(), metav1.ListOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	names := make([]string, 0, len(list.Items))
+	for _, pod := range list.Items {
+		names = append(names, pod.Name)
+	}
+	return names
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package statefulset
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/status"
+	"github.com/oracle/coherence-operator/pkg/events"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// EventReasonWaitingForMaintenanceWindow is the event reason when a disruptive operation is waiting for a maintenance window.
+	EventReasonWaitingForMaintenanceWindow = "WaitingForMaintenanceWindow"
+
+	// invalidMaintenanceWindowRetry is how long to wait before checking the maintenance windows again when
+	// no window is open and none is due to open because the windows are invalid.
+	invalidMaintenanceWindowRetry = time.Minute
+)
+
+// WaitForMaintenanceWindow returns the time to wait for the next maintenance window of a deployment to open
+// before a disruptive operation can run, or zero if the operation can run now. The operation describes what
+// is waiting, for example "upgrade Pods". While an operation is waiting the WaitingForMaintenanceWindow
+// condition is set and an event is sent, the condition is removed when the operation can run.
+// An invalid maintenance window is treated as closed, the condition then shows why the window is invalid
+// and a warning event is sent.
+func WaitForMaintenanceWindow(ctx context.Context, sm *status.StatusManager, recorder events.OwnedEventRecorder, deployment coh.CoherenceResource,
+	operation string, now time.Time) time.Duration {
+	spec, found := deployment.GetStatefulSetSpec()
+	if !found {
+		return 0
+	}
+
+	next, err := spec.NextMaintenanceWindow(now)
+	if next.IsZero() && err == nil {
+		clearWaitingForMaintenanceWindow(ctx, sm, deployment)
+		return 0
+	}
+
+	var msg string
+	var wait time.Duration
+	reason := coh.ReasonOutsideMaintenanceWindow
+	switch {
+	case next.IsZero():
+		// every window is invalid, so no window will open until the windows are fixed
+		msg = fmt.Sprintf("waiting for a valid maintenance window to %s, invalid maintenance windows: %s", operation, err.Error())
+		wait = invalidMaintenanceWindowRetry
+		reason = coh.ReasonInvalidMaintenanceWindow
+	case err != nil:
+		msg = fmt.Sprintf("waiting for the maintenance window opening at %s to %s, invalid maintenance windows: %s",
+			next.Format(time.RFC3339), operation, err.Error())
+		wait = next.Sub(now)
+		reason = coh.ReasonInvalidMaintenanceWindow
+	default:
+		msg = fmt.Sprintf("waiting for the maintenance window opening at %s to %s", next.Format(time.RFC3339), operation)
+		wait = next.Sub(now)
+	}
+
+	condition := deployment.GetStatus().Conditions.GetCondition(coh.ConditionTypeWaitingForMaintenanceWindow)
+	if condition == nil || !condition.IsTrue() || condition.Reason != reason || condition.Message != msg {
+		if err != nil {
+			recorder.Warn(EventReasonWaitingForMaintenanceWindow, msg)
+		} else {
+			recorder.Info(EventReasonWaitingForMaintenanceWindow, msg)
+		}
+		waiting := coh.Condition{Type: coh.ConditionTypeWaitingForMaintenanceWindow, Status: corev1.ConditionTrue,
+			Reason: reason, Message: msg}
+		if err := sm.SetCondition(ctx, deployment, waiting); err != nil {
+			log.Info("Failed to update WaitingForMaintenanceWindow condition", "Namespace", deployment.GetNamespace(),
+				"Name", deployment.GetName(), "Error", err.Error())
+		}
+	}
+	return wait
+}
+
+// clearWaitingForMaintenanceWindow removes the WaitingForMaintenanceWindow condition of a deployment, if it is present.
+// A failure is logged, as it does not affect the operation.
+func clearWaitingForMaintenanceWindow(ctx context.Context, sm *status.StatusManager, deployment coh.CoherenceResource) {
+	if deployment.GetStatus().Conditions.GetCondition(coh.ConditionTypeWaitingForMaintenanceWindow) == nil {
+		return
+	}
+	if err := sm.RemoveCondition(ctx, deployment, coh.ConditionTypeWaitingForMaintenanceWindow); err != nil {
+		log.Info("Failed to remove WaitingForMaintenanceWindow condition", "Namespace", deployment.GetNamespace(),
+			"Name", deployment.GetName(), "Error", err.Error())
+	}
+}
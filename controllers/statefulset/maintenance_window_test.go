@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package statefulset_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/statefulset"
+	"github.com/oracle/coherence-operator/pkg/events"
+	"github.com/oracle/coherence-operator/pkg/fakes/stubs"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sevents "k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+)
+
+func TestWaitForMaintenanceWindowOutsideWindow(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	deployment := newMaintenanceWindowTestCoherence()
+	c := stubs.NewClient(deployment)
+
+	// Friday evening, the window opens on Saturday at 22:00
+	now := time.Date(2026, time.October, 16, 18, 0, 0, 0, time.UTC)
+	wait := statefulset.WaitForMaintenanceWindow(ctx, stubs.NewStatusManager(c), events.OwnedEventRecorder{}, deployment, "upgrade Pods", now)
+	g.Expect(wait).To(Equal(28 * time.Hour))
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	condition := latest.Status.Conditions.GetCondition(coh.ConditionTypeWaitingForMaintenanceWindow)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+	g.Expect(condition.Reason).To(Equal(coh.ReasonOutsideMaintenanceWindow))
+	g.Expect(condition.Message).To(Equal("waiting for the maintenance window opening at 2026-10-17T22:00:00Z to upgrade Pods"))
+}
+
+func TestWaitForMaintenanceWindowInsideWindow(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	deployment := newMaintenanceWindowTestCoherence()
+	deployment.Status.Conditions.SetCondition(coh.Condition{Type: coh.ConditionTypeWaitingForMaintenanceWindow,
+		Status: corev1.ConditionTrue, Reason: coh.ReasonOutsideMaintenanceWindow})
+	c := stubs.NewClient(deployment)
+
+	now := time.Date(2026, time.October, 17, 23, 0, 0, 0, time.UTC)
+	wait := statefulset.WaitForMaintenanceWindow(ctx, stubs.NewStatusManager(c), events.OwnedEventRecorder{}, deployment, "upgrade Pods", now)
+	g.Expect(wait).To(BeZero())
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	g.Expect(latest.Status.Conditions.GetCondition(coh.ConditionTypeWaitingForMaintenanceWindow)).To(BeNil())
+}
+
+func TestWaitForMaintenanceWindowWithoutWindows(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deployment := newMaintenanceWindowTestCoherence()
+	deployment.Spec.MaintenanceWindows = nil
+	c := stubs.NewClient(deployment)
+
+	wait := statefulset.WaitForMaintenanceWindow(context.Background(), stubs.NewStatusManager(c), events.OwnedEventRecorder{}, deployment, "upgrade Pods", time.Now())
+	g.Expect(wait).To(BeZero())
+}
+
+func TestWaitForMaintenanceWindowWithInvalidWindow(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	deployment := newMaintenanceWindowTestCoherence()
+	deployment.Spec.MaintenanceWindows[0].TimeZone = ptr.To("Not/AZone")
+	c := stubs.NewClient(deployment)
+	recorder := k8sevents.NewFakeRecorder(10)
+
+	// the invalid window is treated as closed, even when it would be open
+	now := time.Date(2026, time.October, 17, 23, 0, 0, 0, time.UTC)
+	wait := statefulset.WaitForMaintenanceWindow(ctx, stubs.NewStatusManager(c), events.NewOwnedEventRecorder(deployment, recorder),
+		deployment, "upgrade Pods", now)
+	g.Expect(wait).To(Equal(time.Minute))
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	condition := latest.Status.Conditions.GetCondition(coh.ConditionTypeWaitingForMaintenanceWindow)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+	g.Expect(condition.Reason).To(Equal(coh.ReasonInvalidMaintenanceWindow))
+	g.Expect(condition.Message).To(ContainSubstring(`invalid maintenance window time zone "Not/AZone"`))
+
+	g.Expect(recorder.Events).To(Receive(HavePrefix("Warning " + statefulset.EventReasonWaitingForMaintenanceWindow)))
+}
+
+func newMaintenanceWindowTestCoherence() *coh.Coherence {
+	return stubs.NewCoherence(coh.CoherenceStatefulSetResourceSpec{
+		RollingUpdateStrategy: ptr.To(coh.UpgradeByNode),
+		MaintenanceWindows: []coh.MaintenanceWindowSpec{
+			{Schedule: "0 22 * * 6", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: ptr.To("UTC")},
+		},
+	})
+}
@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package statefulset
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/pkg/probe"
+	"github.com/oracle/coherence-operator/pkg/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// EventReasonRolledBack is the event reason when a failed rolling upgrade is rolled back.
+	EventReasonRolledBack = "RolledBack"
+	// EventReasonRollbackAcknowledged is the event reason when a Coherence resource is updated after a rollback.
+	EventReasonRollbackAcknowledged = "RollbackAcknowledged"
+)
+
+// FindFailedUpgradePods returns the Pods of an in-progress rolling upgrade that have failed, with
+// the reason for the failure. If no Pods have failed, the time until a Pod that is not ready will
+// exceed the ready timeout is returned, so that the check can be repeated.
+func FindFailedUpgradePods(spec *coh.RollbackSpec, sts *appsv1.StatefulSet, pods corev1.PodList, now time.Time) (coh.ConditionReason, []corev1.Pod, time.Duration) {
+	var reason coh.ConditionReason
+	var failed []corev1.Pod
+	var wait time.Duration
+
+	if !spec.IsEnabled() || sts.Status.CurrentRevision == sts.Status.UpdateRevision {
+		return reason, failed, wait
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Labels["controller-revision-hash"] != sts.Status.UpdateRevision || pod.DeletionTimestamp != nil {
+			continue
+		}
+		r, w := spec.GetPodFailure(pod, now)
+		switch {
+		case r != "":
+			if reason == "" || r == coh.ReasonRollbackCrashLoopBackOff {
+				reason = r
+			}
+			failed = append(failed, pod)
+		case w > 0 && (wait == 0 || w < wait):
+			wait = w
+		}
+	}
+	return reason, failed, wait
+}
+
+// rollbackStorage is a Storage where the previous resources are the latest resources. When patching
+// the StatefulSet back to the previous generation the original state for the three-way patch is then
+// the latest state, so that anything added by the failed version is removed.
+type rollbackStorage struct {
+	utils.Storage
+}
+
+func (in rollbackStorage) GetPrevious() coh.Resources {
+	return in.GetLatest()
+}
+
+// GetHash reports that there is no hash, the rolled back StatefulSet keeps the hash of the latest
+// state so the hash would otherwise match and the StatefulSet would never be patched.
+func (in rollbackStorage) GetHash() (string, bool) {
+	return "", false
+}
+
+// maybeRollback rolls back a failed rolling upgrade of a StatefulSet, returning true if the StatefulSet
+// has been rolled back, in which case the latest desired state must not be applied. If the rolling upgrade
+// has not failed, a positive duration is returned if it should be checked again.
+func (in *ReconcileStatefulSet) maybeRollback(ctx context.Context, deployment coh.CoherenceResource, current *appsv1.StatefulSet,
+	storage utils.Storage, logger logr.Logger) (bool, time.Duration, reconcile.Result, error) {
+	spec, found := deployment.GetStatefulSetSpec()
+	if !found {
+		return false, 0, reconcile.Result{}, nil
+	}
+
+	generation := deployment.GetGeneration()
+	condition := deployment.GetStatus().Conditions.GetCondition(coh.ConditionTypeRolledBack)
+	switch {
+	case deployment.GetStatus().IsRolledBack(generation):
+		// the rollback stays in place until the Coherence resource is updated
+		result, err := in.rollbackStatefulSet(ctx, deployment, current, storage, current.Annotations[coh.AnnotationRolledBackRevision], logger)
+		return true, 0, result, err
+	case condition != nil && condition.IsTrue():
+		// the Coherence resource has been updated since the rollback, so the latest state is applied
+		msg := fmt.Sprintf("the Coherence resource was updated after generation %d was rolled back", condition.ObservedGeneration)
+		in.GetEventRecorder().Eventf(deployment, nil, corev1.EventTypeNormal, EventReasonRollbackAcknowledged, "", msg)
+		c := coh.Condition{Type: coh.ConditionTypeRolledBack, Status: corev1.ConditionFalse, Reason: coh.ReasonRollbackAcknowledged,
+			Message: msg, ObservedGeneration: condition.ObservedGeneration}
+		if err := in.GetStatusManager().SetCondition(ctx, deployment, c); err != nil {
+			logger.Info("Failed to update RolledBack condition", "Error", err.Error())
+		}
+	}
+
+	if !spec.Rollback.IsEnabled() || current.Status.CurrentRevision == current.Status.UpdateRevision {
+		return false, 0, reconcile.Result{}, nil
+	}
+
+	p := probe.CoherenceProbe{Client: in.GetClient()}
+	pods, err := p.GetPodsForStatefulSet(ctx, current)
+	if err != nil {
+		return false, 0, reconcile.Result{}, err
+	}
+	reason, failed, wait := FindFailedUpgradePods(spec.Rollback, current, pods, time.Now())
+	if len(failed) == 0 {
+		return false, wait, reconcile.Result{}, nil
+	}
+
+	names := make([]string, 0, len(failed))
+	for _, pod := range failed {
+		names = append(names, pod.Name)
+	}
+	revision := current.Status.UpdateRevision
+	inputs := map[string]string{"revision": revision, "pods": strings.Join(names, ",")}
+
+	previous, found := storage.GetPreviousGeneration().GetResource(coh.ResourceTypeStatefulSet, current.Name)
+	switch {
+	case !found || previous.IsDelete():
+		logger.Info("Cannot roll back failed rolling upgrade, there is no previous StatefulSet", "Revision", revision, "Reason", reason)
+		return false, 0, reconcile.Result{}, nil
+	case previous.Spec.GetLabels()[coh.LabelCoherenceHash] == deployment.GetGenerationString():
+		// the previous StatefulSet is the failed version, so there is nothing to roll back to
+		logger.Info("Cannot roll back failed rolling upgrade, the previous StatefulSet is the current generation", "Revision", revision, "Reason", reason)
+		return false, 0, reconcile.Result{}, nil
+	case condition != nil && previous.Spec.GetLabels()[coh.LabelCoherenceHash] == strconv.FormatInt(condition.ObservedGeneration, 10):
+		// never roll back to a version that was itself rolled back
+		logger.Info("Cannot roll back failed rolling upgrade, the previous StatefulSet was rolled back", "Revision", revision, "Reason", reason)
+		return false, 0, reconcile.Result{}, nil
+	}
+
+	msg := fmt.Sprintf("rolled back revision %s of generation %d, %s: %s", revision, generation, reason, strings.Join(names, ","))
+	logger.Info("Rolling back failed rolling upgrade", "Revision", revision, "Reason", reason, "Pods", names)
+	in.GetEventRecorder().Eventf(deployment, nil, corev1.EventTypeWarning, EventReasonRolledBack, "", msg)
+
+	c := coh.Condition{Type: coh.ConditionTypeRolledBack, Status: corev1.ConditionTrue, Reason: reason, Message: msg, ObservedGeneration: generation}
+	// the condition is also set on the in-memory status, as it is used to skip the ready checks when patching
+	deployment.GetStatus().Conditions.SetCondition(c)
+	if err := in.GetStatusManager().SetCondition(ctx, deployment, c); err != nil {
+		return true, 0, reconcile.Result{}, err
+	}
+	recordHistory(ctx, in.GetStatusManager(), deployment, coh.NewHistoryEntry(coh.HistoryOperationRollback, coh.HistoryResultSucceeded, msg, inputs))
+
+	result, err := in.rollbackStatefulSet(ctx, deployment, current, storage, revision, logger)
+	return true, 0, result, err
+}
+
+// rollbackStatefulSet patches the StatefulSet back to the previous generation in the store and deletes the failed
+// Pods that are not ready, as the StatefulSet would otherwise wait for them to be ready. The remaining upgraded
+// Pods are then rolled back by the normal upgrade process.
+func (in *ReconcileStatefulSet) rollbackStatefulSet(ctx context.Context, deployment coh.CoherenceResource, current *appsv1.StatefulSet,
+	storage utils.Storage, revision string, logger logr.Logger) (reconcile.Result, error) {
+	resource, found := storage.GetPreviousGeneration().GetResource(coh.ResourceTypeStatefulSet, current.Name)
+	if !found || resource.IsDelete() {
+		return reconcile.Result{}, nil
+	}
+
+	if revision != "" && current.Status.ObservedGeneration == current.Generation && current.Status.UpdateRevision != revision {
+		// the StatefulSet has been rolled back, so any failed Pods can be replaced
+		p := probe.CoherenceProbe{Client: in.GetClient()}
+		pods, err := p.GetPodsForStatefulSet(ctx, current)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		toDelete := corev1.PodList{}
+		for _, pod := range pods.Items {
+			if ready, _ := p.IsPodReady(pod); !ready && pod.DeletionTimestamp == nil && pod.Labels["controller-revision-hash"] == revision {
+				toDelete.Items = append(toDelete.Items, pod)
+			}
+		}
+		if len(toDelete.Items) > 0 {
+			logger.Info("Deleting failed Pods after rolling back", "Revision", revision, "Count", len(toDelete.Items))
+			if err := deletePods(ctx, toDelete, in.GetClientSet().KubeClient); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+	}
+
+	desired := resource.Spec.(*appsv1.StatefulSet).DeepCopy()
+	// the hash of the latest state is kept, so that the StatefulSet is not patched back to the latest
+	// state and an Operator managed upgrade strategy rolls back the remaining upgraded Pods
+	if hash, ok := storage.GetHash(); ok {
+		if desired.Labels == nil {
+			desired.Labels = make(map[string]string)
+		}
+		desired.Labels[coh.LabelCoherenceHash] = hash
+	}
+	if desired.Annotations == nil {
+		desired.Annotations = make(map[string]string)
+	}
+	desired.Annotations[coh.AnnotationRolledBackRevision] = revision
+
+	return in.maybePatchStatefulSet(ctx, deployment, current, desired, rollbackStorage{Storage: storage}, false, logger)
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package statefulset
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/pkg/clients"
+	"github.com/oracle/coherence-operator/pkg/fakes/stubs"
+	"github.com/oracle/coherence-operator/pkg/patching"
+	"github.com/oracle/coherence-operator/pkg/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestRollbackToPreviousGenerationAfterSeveralReconciles(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	deployment, current, pod := newRollbackTestResources()
+	r, c, storage := newRollbackTestReconciler(g, deployment, current, pod)
+
+	// generation 1 is reconciled a number of times
+	storeRollbackTestGeneration(g, ctx, storage, deployment, 1, "app:1.0.0", 3)
+	// generation 2 is reconciled a number of times while its Pods are upgraded
+	storeRollbackTestGeneration(g, ctx, storage, deployment, 2, "app:2.0.0", 3)
+
+	// the previous resources are the latest resources, but the previous generation is still generation 1
+	g.Expect(rollbackTestImage(g, storage.GetPrevious())).To(Equal("app:2.0.0"))
+	g.Expect(rollbackTestImage(g, storage.GetPreviousGeneration())).To(Equal("app:1.0.0"))
+
+	// the previous generation is persisted in the store
+	storage, err := utils.NewStorage(deployment.GetNamespacedName(), r.GetManager(), r.GetPatcher())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rollbackTestImage(g, storage.GetPreviousGeneration())).To(Equal("app:1.0.0"))
+
+	rolledBack, _, _, err := r.maybeRollback(ctx, deployment, current.DeepCopy(), storage, r.GetLog())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rolledBack).To(BeTrue())
+
+	// the StatefulSet is patched back to generation 1, keeping the hash of generation 2
+	sts := &appsv1.StatefulSet{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(current), sts)).To(Succeed())
+	g.Expect(sts.Spec.Template.Spec.Containers[0].Image).To(Equal("app:1.0.0"))
+	g.Expect(sts.Labels[coh.LabelCoherenceHash]).To(Equal("2"))
+	g.Expect(sts.Annotations[coh.AnnotationRolledBackRevision]).To(Equal("rev-2"))
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	g.Expect(latest.Status.IsRolledBack(2)).To(BeTrue())
+
+	// further reconciles of the rolled back generation do not replace the previous generation
+	storeRollbackTestGeneration(g, ctx, storage, deployment, 2, "app:2.0.0", 2)
+	g.Expect(rollbackTestImage(g, storage.GetPreviousGeneration())).To(Equal("app:1.0.0"))
+}
+
+func TestRollbackRefusedWhenPreviousGenerationIsCurrent(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	deployment, current, pod := newRollbackTestResources()
+	r, c, storage := newRollbackTestReconciler(g, deployment, current, pod)
+
+	storeRollbackTestGeneration(g, ctx, storage, deployment, 1, "app:1.0.0", 1)
+	storeRollbackTestGeneration(g, ctx, storage, deployment, 2, "app:2.0.0", 1)
+
+	// the previous generation has the hash of the current generation, so it is the failed version
+	deployment.Generation = 1
+	rolledBack, _, _, err := r.maybeRollback(ctx, deployment, current.DeepCopy(), storage, r.GetLog())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rolledBack).To(BeFalse())
+
+	sts := &appsv1.StatefulSet{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(current), sts)).To(Succeed())
+	g.Expect(sts.Spec.Template.Spec.Containers[0].Image).To(Equal("app:2.0.0"))
+	g.Expect(sts.Annotations).NotTo(HaveKey(coh.AnnotationRolledBackRevision))
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	g.Expect(latest.Status.Conditions.GetCondition(coh.ConditionTypeRolledBack)).To(BeNil())
+}
+
+func newRollbackTestReconciler(g *WithT, resource client.Object, objs ...client.Object) (*ReconcileStatefulSet, client.Client, utils.Storage) {
+	c := stubs.NewClient(resource, objs...)
+	mgr := stubs.NewManager(c, events.NewFakeRecorder(100))
+	r := &ReconcileStatefulSet{}
+	r.Kind = coh.ResourceTypeStatefulSet
+	r.Template = &appsv1.StatefulSet{}
+	r.SetCommonReconciler(controllerName, mgr, clients.ClientSet{})
+
+	storage, err := utils.NewStorage(types.NamespacedName{Namespace: resource.GetNamespace(), Name: resource.GetName()},
+		mgr, patching.NewResourcePatcher(mgr, r.GetLog(), types.StrategicMergePatchType))
+	g.Expect(err).NotTo(HaveOccurred())
+	return r, c, storage
+}
+
+// newRollbackTestResources returns a Coherence resource at generation 2 with rollback enabled, its StatefulSet
+// part way through upgrading to generation 2 and an upgraded Pod that has not been ready for ten minutes.
+func newRollbackTestResources() (*coh.Coherence, *appsv1.StatefulSet, *corev1.Pod) {
+	deployment := stubs.NewCoherence(coh.CoherenceStatefulSetResourceSpec{
+		CoherenceResourceSpec: coh.CoherenceResourceSpec{Replicas: ptr.To(int32(3)), Image: ptr.To("app:2.0.0")},
+		Rollback:              &coh.RollbackSpec{ReadyTimeout: &metav1.Duration{Duration: 5 * time.Minute}},
+	})
+	deployment.Generation = 2
+
+	current := newRollbackTestStatefulSet(deployment, 2, "app:2.0.0")
+	current.Status = appsv1.StatefulSetStatus{
+		Replicas:           3,
+		ReadyReplicas:      2,
+		CurrentReplicas:    2,
+		UpdatedReplicas:    1,
+		ObservedGeneration: current.Generation,
+		CurrentRevision:    "rev-1",
+		UpdateRevision:     "rev-2",
+	}
+
+	pod := stubs.NewPod(deployment, 2)
+	pod.CreationTimestamp = metav1.NewTime(time.Now().Add(-10 * time.Minute))
+	pod.Labels["controller-revision-hash"] = "rev-2"
+	pod.Status.Conditions[0].Status = corev1.ConditionFalse
+	return deployment, current, pod
+}
+
+// newRollbackTestStatefulSet returns the desired StatefulSet of a generation of the Coherence resource.
+func newRollbackTestStatefulSet(deployment *coh.Coherence, generation int64, image string) *appsv1.StatefulSet {
+	sts := stubs.NewStatefulSet(deployment, 3)
+	sts.TypeMeta = metav1.TypeMeta{APIVersion: "apps/v1", Kind: "StatefulSet"}
+	sts.Labels = map[string]string{coh.LabelCoherenceHash: strconv.FormatInt(generation, 10)}
+	sts.Spec.Template.Spec.Containers = []corev1.Container{{Name: coh.ContainerNameCoherence, Image: image}}
+	sts.Status = appsv1.StatefulSetStatus{}
+	return sts
+}
+
+// storeRollbackTestGeneration stores the desired resources for a generation of the Coherence resource
+// the specified number of times, as each reconcile of the generation does.
+func storeRollbackTestGeneration(g *WithT, ctx context.Context, storage utils.Storage, deployment *coh.Coherence,
+	generation int64, image string, count int) {
+	d := deployment.DeepCopy()
+	d.Generation = generation
+	sts := newRollbackTestStatefulSet(d, generation, image)
+	for i := 0; i < count; i++ {
+		res := coh.Resources{Items: []coh.Resource{{Kind: coh.ResourceTypeStatefulSet, Name: sts.Name, Spec: sts.DeepCopy()}}}
+		g.Expect(storage.Store(ctx, res, d)).To(Succeed())
+	}
+}
+
+func rollbackTestImage(g *WithT, resources coh.Resources) string {
+	resource, found := resources.GetResource(coh.ResourceTypeStatefulSet, "storage")
+	g.Expect(found).To(BeTrue())
+	sts := &appsv1.StatefulSet{}
+	g.Expect(resource.As(sts)).To(Succeed())
+	return sts.Spec.Template.Spec.Containers[0].Image
+}
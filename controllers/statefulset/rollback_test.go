@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package statefulset_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/statefulset"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFindFailedUpgradePods(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Now()
+	spec := &coh.RollbackSpec{ReadyTimeout: &metav1.Duration{Duration: 5 * time.Minute}}
+	sts := newCanaryTestStatefulSet(4)
+
+	// storage-0 and storage-1 are not upgraded, storage-1 is not ready but is not part of the upgrade
+	pods := newCanaryTestPods(4, now.Add(-time.Hour))
+	pods[1].Status.Conditions[0].Status = corev1.ConditionFalse
+	for i := range pods {
+		pods[i].CreationTimestamp = metav1.NewTime(now.Add(-time.Hour))
+	}
+	// storage-3 is upgraded and ready, storage-2 is upgraded and has not been ready for ten minutes
+	pods[2].Labels["controller-revision-hash"] = canaryTestUpdate
+	pods[2].CreationTimestamp = metav1.NewTime(now.Add(-10 * time.Minute))
+	pods[2].Status.Conditions[0].Status = corev1.ConditionFalse
+	pods[3].Labels["controller-revision-hash"] = canaryTestUpdate
+
+	reason, failed, _ := statefulset.FindFailedUpgradePods(spec, sts, corev1.PodList{Items: pods}, now)
+	g.Expect(reason).To(Equal(coh.ReasonRollbackNotReady))
+	g.Expect(failed).To(HaveLen(1))
+	g.Expect(failed[0].Name).To(Equal("storage-2"))
+
+	// rollback is disabled
+	spec.Enabled = new(bool)
+	_, failed, _ = statefulset.FindFailedUpgradePods(spec, sts, corev1.PodList{Items: pods}, now)
+	g.Expect(failed).To(BeEmpty())
+}
+
+func TestFindFailedUpgradePodsWaitsForReadyTimeout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Now()
+	spec := &coh.RollbackSpec{ReadyTimeout: &metav1.Duration{Duration: 5 * time.Minute}}
+	sts := newCanaryTestStatefulSet(3)
+
+	pods := newCanaryTestPods(3, now)
+	pods[2].Labels["controller-revision-hash"] = canaryTestUpdate
+	pods[2].CreationTimestamp = metav1.NewTime(now.Add(-2 * time.Minute))
+	pods[2].Status.Conditions[0].Status = corev1.ConditionFalse
+
+	reason, failed, wait := statefulset.FindFailedUpgradePods(spec, sts, corev1.PodList{Items: pods}, now)
+	g.Expect(reason).To(BeEmpty())
+	g.Expect(failed).To(BeEmpty())
+	g.Expect(wait).To(Equal(3 * time.Minute))
+
+	// no upgrade is in progress
+	sts.Status.UpdateRevision = sts.Status.CurrentRevision
+	_, failed, wait = statefulset.FindFailedUpgradePods(spec, sts, corev1.PodList{Items: pods}, now.Add(time.Hour))
+	g.Expect(failed).To(BeEmpty())
+	g.Expect(wait).To(BeZero())
+}
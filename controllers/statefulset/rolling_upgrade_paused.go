@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package statefulset
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/status"
+	"github.com/oracle/coherence-operator/pkg/events"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// EventReasonRollingUpgradePaused is the event reason when rolling upgrades are paused.
+	EventReasonRollingUpgradePaused = "RollingUpgradePaused"
+	// EventReasonRollingUpgradeResumed is the event reason when rolling upgrades are resumed.
+	EventReasonRollingUpgradeResumed = "RollingUpgradeResumed"
+)
+
+// UpdateRollingUpgradePaused freezes the partition of a StatefulSet rolling upgrade when the Coherence
+// resource's rolling upgrades are paused and releases it when they are resumed. The desired StatefulSet
+// is updated with the frozen partition, so that patching the StatefulSet does not reset it.
+// The partition from before the pause is kept in an annotation on the StatefulSet and is restored
+// when rolling upgrades are resumed.
+// The RollingUpgradePaused condition is set while paused and removed when resumed.
+// Operator managed upgrades are paused by not calling the upgrade strategy, so only the condition
+// is updated for those.
+func UpdateRollingUpgradePaused(ctx context.Context, sm *status.StatusManager, recorder events.OwnedEventRecorder, deployment coh.CoherenceResource,
+	current, desired *appsv1.StatefulSet) error {
+	spec, found := deployment.GetStatefulSetSpec()
+	if !found {
+		return nil
+	}
+
+	paused := spec.IsRollingUpdatePaused()
+	condition := deployment.GetStatus().Conditions.GetCondition(coh.ConditionTypeRollingUpgradePaused)
+	if !paused && condition == nil {
+		// not paused, and was not previously paused, so nothing to release
+		return nil
+	}
+
+	var partition int32
+	var prePause string
+	if paused {
+		partition = pausedPartition(current)
+		prePause = prePausePartition(current)
+	} else {
+		partition = resumedPartition(current, desired)
+	}
+
+	if desired.Spec.UpdateStrategy.Type == appsv1.RollingUpdateStatefulSetStrategyType {
+		if desired.Spec.UpdateStrategy.RollingUpdate == nil {
+			desired.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{}
+		}
+		desired.Spec.UpdateStrategy.RollingUpdate.Partition = ptr.To(partition)
+		if paused {
+			if desired.Annotations == nil {
+				desired.Annotations = make(map[string]string)
+			}
+			desired.Annotations[coh.AnnotationPrePausePartition] = prePause
+		}
+
+		if current.Spec.UpdateStrategy.Type == appsv1.RollingUpdateStatefulSetStrategyType &&
+			(currentPartition(current) != partition || current.Annotations[coh.AnnotationPrePausePartition] != prePause) {
+			log.Info("Updating StatefulSet rolling upgrade partition", "Namespace", current.Namespace, "Name", current.Name,
+				"Paused", paused, "Partition", partition)
+			patch := client.MergeFrom(current.DeepCopy())
+			if current.Spec.UpdateStrategy.RollingUpdate == nil {
+				current.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{}
+			}
+			current.Spec.UpdateStrategy.RollingUpdate.Partition = ptr.To(partition)
+			if paused {
+				if current.Annotations == nil {
+					current.Annotations = make(map[string]string)
+				}
+				current.Annotations[coh.AnnotationPrePausePartition] = prePause
+			} else {
+				delete(current.Annotations, coh.AnnotationPrePausePartition)
+			}
+			if err := sm.Client.Patch(ctx, current, patch); err != nil {
+				return errors.Wrapf(err, "failed to update the rolling upgrade partition of StatefulSet/%s", current.Name)
+			}
+		}
+	}
+
+	if !paused {
+		recorder.Info(EventReasonRollingUpgradeResumed, "rolling upgrades have been resumed")
+		return sm.RemoveCondition(ctx, deployment, coh.ConditionTypeRollingUpgradePaused)
+	}
+
+	msg := rollingUpgradePausedMessage(current)
+	if condition == nil || condition.Status != corev1.ConditionTrue || condition.Message != msg {
+		if condition == nil || condition.Status != corev1.ConditionTrue {
+			recorder.Info(EventReasonRollingUpgradePaused, msg)
+		}
+		pausedCondition := coh.Condition{Type: coh.ConditionTypeRollingUpgradePaused, Status: corev1.ConditionTrue, Reason: coh.ReasonPaused, Message: msg}
+		return sm.SetCondition(ctx, deployment, pausedCondition)
+	}
+	return nil
+}
+
+// pausedPartition returns the partition that freezes the rolling upgrade of a StatefulSet at its current point.
+// If no upgrade is in progress the partition is the replica count, so that an update made while paused
+// does not upgrade any Pods. If an upgrade is in progress an existing partition is kept, otherwise the
+// partition is the ordinal of the last updated Pod, as a StatefulSet upgrades Pods in descending ordinal order.
+func pausedPartition(sts *appsv1.StatefulSet) int32 {
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	if sts.Status.CurrentRevision == sts.Status.UpdateRevision {
+		return replicas
+	}
+	if partition := currentPartition(sts); partition > 0 {
+		return partition
+	}
+	return max(replicas-sts.Status.UpdatedReplicas, 0)
+}
+
+// prePausePartition returns the rolling upgrade partition of a StatefulSet from before rolling upgrades
+// were paused, which is the current partition unless it has already been recorded in the annotation.
+func prePausePartition(sts *appsv1.StatefulSet) string {
+	if partition, found := sts.Annotations[coh.AnnotationPrePausePartition]; found {
+		return partition
+	}
+	return strconv.Itoa(int(currentPartition(sts)))
+}
+
+// resumedPartition returns the rolling upgrade partition of a StatefulSet when rolling upgrades are resumed.
+// This is the partition from before the pause, if one was recorded, otherwise the partition of the
+// desired StatefulSet.
+func resumedPartition(current, desired *appsv1.StatefulSet) int32 {
+	if s, found := current.Annotations[coh.AnnotationPrePausePartition]; found {
+		if partition, err := strconv.ParseInt(s, 10, 32); err == nil {
+			return int32(partition)
+		}
+	}
+	return currentPartition(desired)
+}
+
+// currentPartition returns the rolling upgrade partition of a StatefulSet.
+func currentPartition(sts *appsv1.StatefulSet) int32 {
+	if ru := sts.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil {
+		return *ru.Partition
+	}
+	return 0
+}
+
+// rollingUpgradePausedMessage returns the RollingUpgradePaused condition message showing the
+// number of Pods on the current and update revisions.
+func rollingUpgradePausedMessage(sts *appsv1.StatefulSet) string {
+	currentPods := sts.Status.CurrentReplicas
+	if sts.Status.CurrentRevision == sts.Status.UpdateRevision {
+		// the current and updated replicas are the same Pods
+		currentPods = 0
+	}
+	return fmt.Sprintf("rolling upgrades are paused with %d Pods on the current revision and %d Pods on the update revision",
+		currentPods, sts.Status.UpdatedReplicas)
+}
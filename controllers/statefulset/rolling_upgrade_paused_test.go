@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package statefulset_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/statefulset"
+	"github.com/oracle/coherence-operator/pkg/events"
+	"github.com/oracle/coherence-operator/pkg/fakes/stubs"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestPauseRollingUpgradeInProgress(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	deployment := newPausedTestCoherence(true)
+	current := newPausedTestStatefulSet(deployment, 5, 3, 2, 0)
+	c := stubs.NewClient(deployment, current)
+
+	desired := current.DeepCopy()
+	err := statefulset.UpdateRollingUpgradePaused(ctx, stubs.NewStatusManager(c), events.OwnedEventRecorder{}, deployment, current, desired)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// the partition is frozen at the lowest updated ordinal
+	g.Expect(desired.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(ptr.To(int32(3))))
+	sts := &appsv1.StatefulSet{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(current), sts)).To(Succeed())
+	g.Expect(sts.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(ptr.To(int32(3))))
+	// the partition from before the pause is kept so that it can be restored
+	g.Expect(sts.Annotations).To(HaveKeyWithValue(coh.AnnotationPrePausePartition, "0"))
+	g.Expect(desired.Annotations).To(HaveKeyWithValue(coh.AnnotationPrePausePartition, "0"))
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	condition := latest.Status.Conditions.GetCondition(coh.ConditionTypeRollingUpgradePaused)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+	g.Expect(condition.Reason).To(Equal(coh.ReasonPaused))
+	g.Expect(condition.Message).To(ContainSubstring("3 Pods on the current revision and 2 Pods on the update revision"))
+}
+
+func TestPauseRollingUpgradeNotInProgress(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	deployment := newPausedTestCoherence(true)
+	current := newPausedTestStatefulSet(deployment, 5, 5, 5, 0)
+	current.Status.UpdateRevision = current.Status.CurrentRevision
+	c := stubs.NewClient(deployment, current)
+
+	desired := current.DeepCopy()
+	err := statefulset.UpdateRollingUpgradePaused(ctx, stubs.NewStatusManager(c), events.OwnedEventRecorder{}, deployment, current, desired)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// an update made while paused must not upgrade any Pods
+	g.Expect(desired.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(ptr.To(int32(5))))
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	condition := latest.Status.Conditions.GetCondition(coh.ConditionTypeRollingUpgradePaused)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Message).To(ContainSubstring("0 Pods on the current revision and 5 Pods on the update revision"))
+}
+
+func TestResumeRollingUpgrade(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	deployment := newPausedTestCoherence(false)
+	deployment.Status.Conditions.SetCondition(coh.Condition{Type: coh.ConditionTypeRollingUpgradePaused, Status: corev1.ConditionTrue, Reason: coh.ReasonPaused})
+	// the partition was set by scaling before rolling upgrades were paused
+	current := newPausedTestStatefulSet(deployment, 5, 3, 2, 3)
+	current.Annotations = map[string]string{coh.AnnotationPrePausePartition: "4"}
+	c := stubs.NewClient(deployment, current)
+
+	desired := current.DeepCopy()
+	desired.Annotations = nil
+	desired.Spec.UpdateStrategy.RollingUpdate.Partition = ptr.To(int32(0))
+	err := statefulset.UpdateRollingUpgradePaused(ctx, stubs.NewStatusManager(c), events.OwnedEventRecorder{}, deployment, current, desired)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// the partition from before the pause is restored
+	g.Expect(desired.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(ptr.To(int32(4))))
+	sts := &appsv1.StatefulSet{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(current), sts)).To(Succeed())
+	g.Expect(sts.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(ptr.To(int32(4))))
+	g.Expect(sts.Annotations).NotTo(HaveKey(coh.AnnotationPrePausePartition))
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	g.Expect(latest.Status.Conditions.GetCondition(coh.ConditionTypeRollingUpgradePaused)).To(BeNil())
+}
+
+func TestResumeRollingUpgradeWithoutPrePausePartition(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	deployment := newPausedTestCoherence(false)
+	deployment.Status.Conditions.SetCondition(coh.Condition{Type: coh.ConditionTypeRollingUpgradePaused, Status: corev1.ConditionTrue, Reason: coh.ReasonPaused})
+	current := newPausedTestStatefulSet(deployment, 5, 3, 2, 3)
+	c := stubs.NewClient(deployment, current)
+
+	desired := current.DeepCopy()
+	desired.Spec.UpdateStrategy.RollingUpdate.Partition = ptr.To(int32(1))
+	err := statefulset.UpdateRollingUpgradePaused(ctx, stubs.NewStatusManager(c), events.OwnedEventRecorder{}, deployment, current, desired)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// the partition of the desired StatefulSet is used
+	sts := &appsv1.StatefulSet{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(current), sts)).To(Succeed())
+	g.Expect(sts.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(ptr.To(int32(1))))
+}
+
+func TestRollingUpgradeNotPaused(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	deployment := newPausedTestCoherence(false)
+	// a partition set by scaling must not be changed
+	current := newPausedTestStatefulSet(deployment, 5, 3, 2, 4)
+	c := stubs.NewClient(deployment, current)
+
+	desired := current.DeepCopy()
+	err := statefulset.UpdateRollingUpgradePaused(ctx, stubs.NewStatusManager(c), events.OwnedEventRecorder{}, deployment, current, desired)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	sts := &appsv1.StatefulSet{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(current), sts)).To(Succeed())
+	g.Expect(sts.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(ptr.To(int32(4))))
+	g.Expect(desired.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(ptr.To(int32(4))))
+}
+
+func newPausedTestCoherence(paused bool) *coh.Coherence {
+	return stubs.NewCoherence(coh.CoherenceStatefulSetResourceSpec{RollingUpdatePaused: ptr.To(paused)})
+}
+
+func newPausedTestStatefulSet(deployment *coh.Coherence, replicas, currentReplicas, updatedReplicas, partition int32) *appsv1.StatefulSet {
+	sts := stubs.NewStatefulSet(deployment, replicas)
+	sts.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{
+		Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: ptr.To(partition)},
+	}
+	sts.Status.CurrentReplicas = currentReplicas
+	sts.Status.UpdatedReplicas = updatedReplicas
+	sts.Status.CurrentRevision = "storage-1111"
+	sts.Status.UpdateRevision = "storage-2222"
+	return sts
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package statefulset
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/pkg/management"
+	"github.com/oracle/coherence-operator/pkg/probe"
+)
+
+// SafeScaleDownBatchSize returns the number of members that can be removed at once from a cluster
+// while keeping every partitioned service at or above the required HA status level. The pods are the
+// names of the Pods that will be removed when scaling down, in the order the StatefulSet removes them.
+// If no members can be removed a message describing why is returned.
+//
+// Members can only be removed while every partitioned service with backups is at or above the required
+// level and is not redistributing partitions. A single member can then always be removed, as the Safe
+// scaling policy does. A larger batch is allowed if, for every service, the members in the batch are on
+// no more machines, racks or sites than the service has backups, at the level the service is currently
+// safe at, so at least one copy of every partition remains, and the remaining members are still on at least
+// two nodes, machines, racks or sites at the required level, so the services can return to the required level.
+// The batch is limited to maxSize members if maxSize is greater than zero.
+func SafeScaleDownBatchSize(ha probe.ClusterHAStatus, pods []string, level coh.HALevel, maxSize int32) (int32, string) {
+	if len(pods) == 0 {
+		return 0, "there are no Pods to remove"
+	}
+
+	var services []management.ServicePartitionData
+	for _, svc := range ha.Services {
+		if svc.BackupCount <= 0 {
+			// a service without backups loses data whenever a member is removed, whatever the batch size
+			continue
+		}
+		if svc.HAStatusCode < level.StatusCode() {
+			return 0, fmt.Sprintf("service %s is %s, below the required %s", svc.Name, svc.HAStatus, level)
+		}
+		if svc.RemainingDistributionCount > 0 {
+			return 0, fmt.Sprintf("service %s is redistributing partitions", svc.Name)
+		}
+		services = append(services, svc)
+	}
+
+	members := make(map[string]management.MemberData)
+	for _, md := range ha.Members {
+		members[md.MemberName] = md
+	}
+
+	limit := int32(len(pods))
+	if maxSize > 0 && limit > maxSize {
+		limit = maxSize
+	}
+
+	batch := int32(1)
+	for size := int32(2); size <= limit; size++ {
+		if !canRemoveMembers(ha.Members, members, services, pods[:size], level) {
+			break
+		}
+		batch = size
+	}
+	return batch, ""
+}
+
+// canRemoveMembers returns true if the members of the specified Pods can all be removed at once.
+func canRemoveMembers(all []management.MemberData, members map[string]management.MemberData,
+	services []management.ServicePartitionData, pods []string, level coh.HALevel) bool {
+
+	removing := make(map[string]bool)
+	for _, pod := range pods {
+		if _, found := members[pod]; !found {
+			// the placement of the member is not known
+			return false
+		}
+		removing[pod] = true
+	}
+
+	before := make(map[string]bool)
+	after := make(map[string]bool)
+	for _, md := range all {
+		unit := placementUnit(md, level)
+		before[unit] = true
+		if !removing[md.MemberName] {
+			after[unit] = true
+		}
+	}
+	if len(after) < 2 && len(after) < len(before) {
+		// the services could not return to the required level, which needs
+		// members on at least two nodes, machines, racks or sites
+		return false
+	}
+
+	for _, svc := range services {
+		current, _ := coh.HALevelForStatusCode(svc.HAStatusCode)
+		removed := make(map[string]bool)
+		for _, pod := range pods {
+			removed[placementUnit(members[pod], current)] = true
+		}
+		if len(removed) > svc.BackupCount {
+			// every copy of some partitions may be on the removed members
+			return false
+		}
+	}
+	return true
+}
+
+// placementUnit returns the name of the node, machine, rack or site of a member for an HA status level.
+// If the member does not have a name at the level, for example it has no rack name, the member is treated
+// as being in a unit of its own, which only allows it to be removed on its own.
+func placementUnit(md management.MemberData, level coh.HALevel) string {
+	var names []string
+	switch level {
+	case coh.HALevelSiteSafe:
+		names = []string{md.SiteName}
+	case coh.HALevelRackSafe:
+		names = []string{md.SiteName, md.RackName}
+	case coh.HALevelMachineSafe:
+		machine := md.MachineName
+		if machine == "" && md.MachineID != 0 {
+			machine = "#" + strconv.Itoa(md.MachineID)
+		}
+		names = []string{md.SiteName, md.RackName, machine}
+	default:
+		return "member/" + md.MemberName
+	}
+	if names[len(names)-1] == "" {
+		return "member/" + md.MemberName
+	}
+	return string(level) + "/" + strings.Join(names, "/")
+}
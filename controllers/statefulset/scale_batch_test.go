@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package statefulset_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/statefulset"
+	"github.com/oracle/coherence-operator/pkg/management"
+	"github.com/oracle/coherence-operator/pkg/probe"
+)
+
+func TestSafeScaleDownBatchSizeBySite(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// six members across three sites, two members per site
+	ha := newBatchTestHAStatus(6, 2, newBatchTestService("PartitionedCache", management.HAStatusCodeSiteSafe, 1))
+	pods := []string{"storage-5", "storage-4", "storage-3"}
+
+	// both members of site-2 can be removed together, but not the member of site-1 as well
+	batch, reason := statefulset.SafeScaleDownBatchSize(ha, pods, coh.HALevelSiteSafe, 0)
+	g.Expect(batch).To(Equal(int32(2)))
+	g.Expect(reason).To(BeEmpty())
+
+	// the batch is limited to the maximum size
+	batch, _ = statefulset.SafeScaleDownBatchSize(ha, pods, coh.HALevelSiteSafe, 1)
+	g.Expect(batch).To(Equal(int32(1)))
+}
+
+func TestSafeScaleDownBatchSizeWithMoreBackups(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// with two backups the members of two sites can be removed together, which leaves a single
+	// site, so is allowed when NODE_SAFE is required, but when SITE_SAFE is required one member
+	// of site-1 must remain
+	ha := newBatchTestHAStatus(6, 2, newBatchTestService("PartitionedCache", management.HAStatusCodeSiteSafe, 2))
+	pods := []string{"storage-5", "storage-4", "storage-3", "storage-2"}
+
+	batch, _ := statefulset.SafeScaleDownBatchSize(ha, pods, coh.HALevelNodeSafe, 0)
+	g.Expect(batch).To(Equal(int32(4)))
+
+	batch, _ = statefulset.SafeScaleDownBatchSize(ha, pods, coh.HALevelSiteSafe, 0)
+	g.Expect(batch).To(Equal(int32(3)))
+}
+
+func TestSafeScaleDownBatchSizeWhenNodeSafe(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// a NODE_SAFE service only allows one member to be removed at a time
+	ha := newBatchTestHAStatus(6, 2, newBatchTestService("PartitionedCache", management.HAStatusCodeNodeSafe, 1))
+	batch, reason := statefulset.SafeScaleDownBatchSize(ha, []string{"storage-5", "storage-4"}, coh.HALevelNodeSafe, 0)
+	g.Expect(batch).To(Equal(int32(1)))
+	g.Expect(reason).To(BeEmpty())
+}
+
+func TestSafeScaleDownBatchSizeBelowRequiredLevel(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ha := newBatchTestHAStatus(6, 2, newBatchTestService("PartitionedCache", management.HAStatusCodeMachineSafe, 1))
+	batch, reason := statefulset.SafeScaleDownBatchSize(ha, []string{"storage-5"}, coh.HALevelSiteSafe, 0)
+	g.Expect(batch).To(BeZero())
+	g.Expect(reason).To(Equal("service PartitionedCache is MACHINE_SAFE, below the required SITE_SAFE"))
+
+	ha.Services[0].HAStatusCode = management.HAStatusCodeSiteSafe
+	ha.Services[0].HAStatus = "SITE_SAFE"
+	ha.Services[0].RemainingDistributionCount = 10
+	batch, reason = statefulset.SafeScaleDownBatchSize(ha, []string{"storage-5"}, coh.HALevelSiteSafe, 0)
+	g.Expect(batch).To(BeZero())
+	g.Expect(reason).To(Equal("service PartitionedCache is redistributing partitions"))
+}
+
+func TestSafeScaleDownBatchSizeWithUnknownMember(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// the member for storage-4 is not in the cluster so its placement is not known
+	ha := newBatchTestHAStatus(6, 2, newBatchTestService("PartitionedCache", management.HAStatusCodeSiteSafe, 1))
+	ha.Members = append(ha.Members[:4], ha.Members[5])
+	batch, _ := statefulset.SafeScaleDownBatchSize(ha, []string{"storage-5", "storage-4"}, coh.HALevelSiteSafe, 0)
+	g.Expect(batch).To(Equal(int32(1)))
+}
+
+// newBatchTestHAStatus returns the HA status of a cluster with the specified number of members,
+// each on its own machine, with perSite consecutive members in each site and rack.
+func newBatchTestHAStatus(count, perSite int, services ...management.ServicePartitionData) probe.ClusterHAStatus {
+	var members []management.MemberData
+	for i := 0; i < count; i++ {
+		members = append(members, management.MemberData{
+			MemberName:  fmt.Sprintf("storage-%d", i),
+			MachineName: fmt.Sprintf("node-%d", i),
+			RackName:    fmt.Sprintf("rack-%d", i/perSite),
+			SiteName:    fmt.Sprintf("site-%d", i/perSite),
+		})
+	}
+	return probe.ClusterHAStatus{Members: members, Services: services}
+}
+
+func newBatchTestService(name string, code, backups int) management.ServicePartitionData {
+	level, _ := coh.HALevelForStatusCode(code)
+	return management.ServicePartitionData{
+		Name: name,
+		Type: "DistributedCache",
+		PartitionData: management.PartitionData{
+			HAStatus:         string(level),
+			HAStatusCode:     code,
+			BackupCount:      backups,
+			ServiceNodeCount: 6,
+		},
+	}
+}
@@ -10,14 +10,18 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/drift"
 	"github.com/oracle/coherence-operator/controllers/reconciler"
+	"github.com/oracle/coherence-operator/controllers/status"
 	"github.com/oracle/coherence-operator/pkg/clients"
 	"github.com/oracle/coherence-operator/pkg/events"
+	"github.com/oracle/coherence-operator/pkg/metrics"
 	"github.com/oracle/coherence-operator/pkg/operator"
 	"github.com/oracle/coherence-operator/pkg/patching"
 	"github.com/oracle/coherence-operator/pkg/probe"
@@ -28,6 +32,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -173,6 +178,7 @@ func (in *ReconcileStatefulSet) ReconcileAllResourceOfKind(ctx context.Context,
 					case probe.ServiceSuspendFailed:
 						in.GetEventRecorder().Eventf(deployment, nil, corev1.EventTypeWarning, reconciler.EventReasonScaling, "",
 							"failed suspending Coherence services in statefuleset %s", request.Name)
+						in.updateScalingBlocked(ctx, deployment, coh.ReasonSuspendFailed, "waiting to suspend Coherence services before scaling down to zero")
 						return reconcile.Result{RequeueAfter: time.Minute}, fmt.Errorf("failed to suspend services prior to scaling down to zero")
 					case probe.ServiceSuspendSkipped:
 						logger.Info("skipping suspension of Coherence services prior to deletion of StatefulSet")
@@ -229,16 +235,27 @@ func (in *ReconcileStatefulSet) execActions(ctx context.Context, sts *appsv1.Sta
 
 		for _, action := range spec.Actions {
 			if action.Probe != nil {
+				inputs := map[string]string{"action": action.Name, "type": "probe"}
 				if ok := coherenceProbe.ExecuteProbe(ctx, sts, deployment.GetWkaServiceName(), action.Probe); !ok {
 					log.Info("Action probe execution failed.", "probe", action.Probe)
+					recordHistory(ctx, in.GetStatusManager(), deployment, coh.NewHistoryEntry(coh.HistoryOperationAction,
+						coh.HistoryResultFailed, "action probe execution failed", inputs))
+				} else {
+					recordHistory(ctx, in.GetStatusManager(), deployment, coh.NewHistoryEntry(coh.HistoryOperationAction,
+						coh.HistoryResultSucceeded, "action probe executed", inputs))
 				}
 			}
 			if action.Job != nil {
+				inputs := map[string]string{"action": action.Name, "type": "job"}
 				job := buildActionJob(action.Name, action.Job, deployment)
 				if err := in.GetClient().Create(ctx, job); err != nil {
 					log.Info("Action job creation failed", "error", err.Error())
+					recordHistory(ctx, in.GetStatusManager(), deployment, coh.NewHistoryEntry(coh.HistoryOperationAction,
+						coh.HistoryResultFailed, "action job creation failed: "+err.Error(), inputs))
 				} else {
 					log.Info(fmt.Sprintf("Created action job '%s'", job.Name))
+					recordHistory(ctx, in.GetStatusManager(), deployment, coh.NewHistoryEntry(coh.HistoryOperationAction,
+						coh.HistoryResultSucceeded, fmt.Sprintf("created action job '%s'", job.Name), inputs))
 				}
 			}
 		}
@@ -331,10 +348,27 @@ func (in *ReconcileStatefulSet) updateStatefulSet(ctx context.Context, deploymen
 		return reconcile.Result{RequeueAfter: time.Minute}, nil
 	}
 
+	// a failed rolling upgrade is rolled back to the previous state instead of applying the latest state
+	rolledBack, wait, result, err := in.maybeRollback(ctx, deployment, current, storage, logger)
+	if rolledBack || err != nil {
+		return result, err
+	}
+
 	desired := resource.Spec.(*appsv1.StatefulSet)
+	if c, ok := deployment.(*coh.Coherence); ok {
+		if replicas, autoscaled := c.GetAutoscaledReplicas(); autoscaled {
+			// the autoscaler sets the replicas in the status, the replicas in the spec are not changed
+			desired.Spec.Replicas = &replicas
+		}
+	}
 	desiredReplicas := in.getReplicas(desired)
 	currentReplicas := in.getReplicas(current)
 
+	if (desiredReplicas >= currentReplicas || desiredReplicas == 0) && current.Status.CurrentRevision == current.Status.UpdateRevision {
+		// no rolling upgrade or scale down is waiting for a maintenance window
+		clearWaitingForMaintenanceWindow(ctx, in.GetStatusManager(), deployment)
+	}
+
 	if currentReplicas != desiredReplicas {
 		// If scaling and the existing StatefulSet was created by an earlier Operator version then we
 		// patch the StatefulSet rather than scale. This will stop an instant upgrade of the Pods.
@@ -368,6 +402,10 @@ func (in *ReconcileStatefulSet) updateStatefulSet(ctx context.Context, deploymen
 		result, err = in.patchStatefulSet(ctx, deployment, current, desired, storage, logger)
 	}
 
+	if wait > 0 && (result.RequeueAfter == 0 || wait < result.RequeueAfter) {
+		// check again when the upgraded Pods that are not ready will exceed the rollback ready timeout
+		result.RequeueAfter = wait
+	}
 	return result, err
 }
 
@@ -383,16 +421,43 @@ func (in *ReconcileStatefulSet) patchAndScaleStatefulSet(ctx context.Context, de
 
 // Patch the StatefulSet if required, returning a bool to indicate whether a patch was applied.
 func (in *ReconcileStatefulSet) maybePatchStatefulSet(ctx context.Context, deployment coh.CoherenceResource, current, desired *appsv1.StatefulSet, storage utils.Storage, allowScale bool, logger logr.Logger) (reconcile.Result, error) {
+	// freeze or release the StatefulSet rolling upgrade partition before anything else is patched
+	evts := events.NewOwnedEventRecorder(deployment, in.GetEventRecorder())
+	if err := UpdateRollingUpgradePaused(ctx, in.GetStatusManager(), evts, deployment, current, desired); err != nil {
+		return in.HandleErrAndRequeue(ctx, err, deployment, fmt.Sprintf(FailedToPatchMessage, deployment.GetName(), err.Error()), logger)
+	}
+
 	hashMatches := in.HashLabelsMatch(current, storage)
-	in.GetLog().Info("Maybe patching stateful set, checked hash", "Match", hashMatches, "namespace", current.GetNamespace(), "name", current.GetName())
+	configHashMatches := configHashMatches(current, desired)
+	in.GetLog().Info("Maybe patching stateful set, checked hash", "Match", hashMatches, "ConfigMatch", configHashMatches, "namespace", current.GetNamespace(), "name", current.GetName())
+	// a change to the content of mounted Secrets or ConfigMaps does not change the hash,
+	// so the StatefulSet must also be patched if the config hash has changed
+	hashMatches = hashMatches && configHashMatches
+	if hashMatches && drift.GetPolicy(deployment) == coh.DriftPolicyCorrect {
+		// the StatefulSet may have been changed outside the Operator, if so patch it
+		// back to the desired state using the same StatusHA checks as any other update
+		paths, err := drift.Diff(desired, current, drift.IgnoredPaths(deployment, coh.ResourceTypeStatefulSet)...)
+		if err != nil {
+			return reconcile.Result{}, errors.Wrapf(err, "failed to check drift of StatefulSet/%s", current.GetName())
+		}
+		if len(paths) > 0 {
+			logger.Info("Correcting drift of StatefulSet", "Paths", paths)
+			hashMatches = false
+		}
+	}
 	if hashMatches {
 		// Nothing to patch, see if we need to do a rolling upgrade of Pods
 		// if the Operator is controlling the upgrade
-		p := probe.CoherenceProbe{Client: in.GetClient(), Config: in.GetManager().GetConfig()}
-		strategy := GetUpgradeStrategy(deployment, p)
+		p := in.newCoherenceProbe(deployment)
+		strategy := GetUpgradeStrategy(deployment, p, in.GetStatusManager())
 		if strategy.IsOperatorManaged() {
 			// The Operator is managing the rolling upgrade, not the StatefulSet
 			in.GetLog().Info("Operator managed upgrade", "namespace", current.GetNamespace(), "name", current.GetName())
+			if spec, _ := deployment.GetStatefulSetSpec(); spec.IsRollingUpdatePaused() {
+				// rolling upgrades are paused, so do not upgrade any more Pods
+				in.GetLog().Info("Operator managed upgrade is paused", "namespace", current.GetNamespace(), "name", current.GetName())
+				return reconcile.Result{}, nil
+			}
 			if current.Spec.Replicas == nil {
 				// The StatefulSet replicas is nil (which should never actually be the case using the Operator,
 				// but someone could have manually hacked it). In this case a StatefulSet defaults to a
@@ -415,6 +480,16 @@ func (in *ReconcileStatefulSet) maybePatchStatefulSet(ctx context.Context, deplo
 				return reconcile.Result{}, nil
 			}
 
+			switch strategy.(type) {
+			case ByNodeUpgradeStrategy, ByNodeLabelUpgradeStrategy:
+				// Pods are only upgraded by Node while a maintenance window is open
+				if wait := WaitForMaintenanceWindow(ctx, in.GetStatusManager(), evts, deployment, "upgrade Pods", time.Now()); wait > 0 {
+					in.GetLog().Info("Operator managed upgrade is waiting for a maintenance window", "namespace", current.GetNamespace(),
+						"name", current.GetName(), "Wait", wait)
+					return reconcile.Result{RequeueAfter: wait}, nil
+				}
+			}
+
 			// If we get here there are still Pods to be updated
 			in.GetLog().Info("Operator managed upgrade, starting rolling upgrade", "namespace", current.GetNamespace(), "name", current.GetName())
 			return strategy.RollingUpgrade(ctx, current, deployment.GetWkaServiceName(), in.GetClientSet().KubeClient)
@@ -534,7 +609,13 @@ func (in *ReconcileStatefulSet) maybePatchStatefulSet(ctx context.Context, deplo
 		return reconcile.Result{}, nil
 	}
 
-	if deploymentSpec.CheckHABeforeUpdate() {
+	rolledBack := deployment.GetStatus().IsRolledBack(deployment.GetGeneration())
+	switch {
+	case rolledBack:
+		// rolling back a failed upgrade, the failed Pods are not ready so the cluster cannot be StatusHA,
+		// the patch does not delete any ready Pods, they are rolled back by the normal upgrade process
+		logger.Info("Rolling back StatefulSet without a StatusHA check")
+	case deploymentSpec.CheckHABeforeUpdate():
 		// Check we have the expected number of ready replicas
 		if readyReplicas != currentReplicas {
 			logger.Info("Re-queuing update request. StatefulSet Status not all replicas are ready", "Ready", readyReplicas, "CurrentReplicas", currentReplicas)
@@ -542,13 +623,13 @@ func (in *ReconcileStatefulSet) maybePatchStatefulSet(ctx context.Context, deplo
 		}
 
 		// perform the StatusHA check...
-		checker := probe.CoherenceProbe{Client: in.GetClient(), Config: in.GetManager().GetConfig()}
+		checker := in.newCoherenceProbe(deployment)
 		ha := checker.IsStatusHA(ctx, deployment, current)
 		if !ha {
 			logger.Info("Coherence cluster is not StatusHA - re-queuing update request.")
 			return reconcile.Result{RequeueAfter: time.Minute}, nil
 		}
-	} else {
+	default:
 		// the user specifically set a forced update!
 		logger.V(0).Info("WARNING - Updating StatefulSet without a StatusHA test, update was forced")
 	}
@@ -581,14 +662,30 @@ func (in *ReconcileStatefulSet) maybePatchStatefulSet(ctx context.Context, deplo
 	return reconcile.Result{}, nil
 }
 
+// configHashMatches returns true if the config hash annotation on the Pod template of the current
+// StatefulSet matches the annotation on the desired StatefulSet.
+func configHashMatches(current, desired *appsv1.StatefulSet) bool {
+	return current.Spec.Template.Annotations[coh.AnnotationConfigHash] == desired.Spec.Template.Annotations[coh.AnnotationConfigHash]
+}
+
 // suspendServices suspends Coherence services in the target deployment.
 func (in *ReconcileStatefulSet) suspendServices(ctx context.Context, deployment coh.CoherenceResource, current *appsv1.StatefulSet) probe.ServiceSuspendStatus {
-	p := probe.CoherenceProbe{
-		Client:        in.GetClient(),
-		Config:        in.GetManager().GetConfig(),
-		EventRecorder: events.NewOwnedEventRecorder(deployment, in.GetEventRecorder()),
+	p := in.newCoherenceProbe(deployment)
+	suspended := p.SuspendServices(ctx, deployment, current)
+
+	var result coh.HistoryResult
+	switch suspended {
+	case probe.ServiceSuspendSuccessful:
+		result = coh.HistoryResultSucceeded
+	case probe.ServiceSuspendFailed:
+		result = coh.HistoryResultFailed
+	default:
+		result = coh.HistoryResultSkipped
 	}
-	return p.SuspendServices(ctx, deployment, current)
+	inputs := map[string]string{"replicas": strconv.Itoa(int(in.getReplicas(current)))}
+	recordHistory(ctx, in.GetStatusManager(), deployment, coh.NewHistoryEntry(coh.HistoryOperationSuspend, result,
+		fmt.Sprintf("suspension of services in StatefulSet %s %s", current.Name, suspended), inputs))
+	return suspended
 }
 
 // Scale will scale a StatefulSet up or down
@@ -597,6 +694,16 @@ func (in *ReconcileStatefulSet) scale(ctx context.Context, deployment coh.Cohere
 	logger := in.GetLog().WithValues("Namespace", deployment.GetNamespace(), "Name", deployment.GetName())
 	logger.Info("Scaling StatefulSet", "Current", current, "Desired", desired)
 
+	if desired < current && desired > 0 {
+		// scaling down is only allowed while a maintenance window is open, scaling down to zero is never delayed
+		evts := events.NewOwnedEventRecorder(deployment, in.GetEventRecorder())
+		operation := fmt.Sprintf("scale down from %d to %d replicas", current, desired)
+		if wait := WaitForMaintenanceWindow(ctx, in.GetStatusManager(), evts, deployment, operation, time.Now()); wait > 0 {
+			logger.Info("Scaling down is waiting for a maintenance window", "Current", current, "Desired", desired, "Wait", wait)
+			return reconcile.Result{RequeueAfter: wait}, nil
+		}
+	}
+
 	spec, _ := deployment.GetStatefulSetSpec()
 	policy := spec.GetEffectiveScalingPolicy()
 
@@ -615,6 +722,11 @@ func (in *ReconcileStatefulSet) scale(ctx context.Context, deployment coh.Cohere
 			return in.parallelScale(ctx, deployment, sts, desired)
 		}
 		return in.safeScale(ctx, deployment, sts, desired, current)
+	case coh.SafeBatchScaling:
+		if desired > current {
+			return in.parallelScale(ctx, deployment, sts, desired)
+		}
+		return in.safeBatchScale(ctx, deployment, sts, desired, current)
 	default:
 		// shouldn't get here, but better safe than sorry
 		return in.safeScale(ctx, deployment, sts, desired, current)
@@ -638,7 +750,7 @@ func (in *ReconcileStatefulSet) safeScale(ctx context.Context, deployment coh.Co
 		logger.Info("Coherence cluster is not StatusHA - Re-queuing scaling request. Stateful set not ready", "Ready", sts.Status.ReadyReplicas, "Replicas", current)
 	}
 
-	checker := probe.CoherenceProbe{Client: in.GetClient(), Config: in.GetManager().GetConfig()}
+	checker := in.newCoherenceProbe(deployment)
 	ha := current == 1 || checker.IsStatusHA(ctx, deployment, sts)
 
 	if ha {
@@ -650,29 +762,186 @@ func (in *ReconcileStatefulSet) safeScale(ctx context.Context, deployment coh.Co
 			replicas = current - 1
 		}
 
-		logger.Info("Coherence cluster is StatusHA, safely scaling", "Current", current, "Replicas", replicas, "Desired", desired)
+		inputs := scaleHistoryInputs(coh.SafeScaling, current, desired)
+		return in.safeScaleStep(ctx, deployment, sts, current, replicas, desired, inputs)
+	}
 
-		// use the parallel method to just scale by one
-		_, err := in.parallelScale(ctx, deployment, sts, replicas)
-		if err == nil {
-			if replicas == desired {
-				// we're at the desired size so finished scaling
-				return reconcile.Result{}, nil
-			}
-			// scaled by one but not yet at the desired size - requeue request after one minute
-			return reconcile.Result{RequeueAfter: time.Minute}, nil
+	// Not StatusHA
+	msg := fmt.Sprintf("waiting for the cluster to be StatusHA%s to scale from %d to %d replicas", requiredHALevelMessage(deployment), current, desired)
+	return in.deferSafeScale(ctx, deployment, msg, scaleHistoryInputs(coh.SafeScaling, current, desired)), nil
+}
+
+// safeBatchScale will scale a StatefulSet down by the largest batch of members that can be removed while
+// keeping every partitioned service at the required HA status level, and requeue the request.
+// If the HA status of the cluster cannot be obtained the StatefulSet is scaled down by one.
+func (in *ReconcileStatefulSet) safeBatchScale(ctx context.Context, deployment coh.CoherenceResource, sts *appsv1.StatefulSet, desired int32, current int32) (reconcile.Result, error) {
+	logger := in.GetLog().WithValues("Namespace", deployment.GetNamespace(), "Name", deployment.GetName())
+	logger.Info("Safe batch scaling StatefulSet", "Current", current, "Desired", desired)
+
+	c, ok := deployment.(*coh.Coherence)
+	if !ok || current == 1 {
+		return in.safeScale(ctx, deployment, sts, desired, current)
+	}
+
+	inputs := scaleHistoryInputs(coh.SafeBatchScaling, current, desired)
+	checker := in.newCoherenceProbe(deployment)
+	if !checker.IsStatusHA(ctx, deployment, sts) {
+		msg := fmt.Sprintf("waiting for the cluster to be StatusHA%s to scale from %d to %d replicas", requiredHALevelMessage(deployment), current, desired)
+		return in.deferSafeScale(ctx, deployment, msg, inputs), nil
+	}
+
+	ha, err := checker.GetClusterHAStatus(ctx, c, sts)
+	if err != nil {
+		logger.Info("Failed to obtain the cluster HA status, scaling down by one", "Error", err.Error())
+		return in.safeScale(ctx, deployment, sts, desired, current)
+	}
+
+	var pods []string
+	for ordinal := current - 1; ordinal >= desired; ordinal-- {
+		pods = append(pods, fmt.Sprintf("%s-%d", sts.Name, ordinal))
+	}
+	level := c.Spec.Scaling.GetRequiredHALevel()
+	batch, reason := SafeScaleDownBatchSize(ha, pods, level, c.Spec.Scaling.GetMaxBatchSize())
+	inputs["requiredHALevel"] = string(level)
+	if batch == 0 {
+		msg := fmt.Sprintf("waiting for the cluster to be %s to scale from %d to %d replicas: %s", level, current, desired, reason)
+		return in.deferSafeScale(ctx, deployment, msg, inputs), nil
+	}
+
+	inputs["batchSize"] = strconv.Itoa(int(batch))
+	return in.safeScaleStep(ctx, deployment, sts, current, current-batch, desired, inputs)
+}
+
+// safeScaleStep requests approval for one step of safe scaling from the current to the specified replicas,
+// and if approved scales the StatefulSet, requeuing the request if the desired replicas have not been reached.
+func (in *ReconcileStatefulSet) safeScaleStep(ctx context.Context, deployment coh.CoherenceResource, sts *appsv1.StatefulSet,
+	current, replicas, desired int32, inputs map[string]string) (reconcile.Result, error) {
+	logger := in.GetLog().WithValues("Namespace", deployment.GetNamespace(), "Name", deployment.GetName())
+	inputs["replicas"] = strconv.Itoa(int(replicas))
+
+	spec, _ := deployment.GetStatefulSetSpec()
+	evts := events.NewOwnedEventRecorder(deployment, in.GetEventRecorder())
+	decision, msg, approvalResult := requestApproval(ctx, in.GetStatusManager(), evts, deployment, spec.ApprovalWebhook,
+		coh.ApprovalActionScale, in.getScaleDownPods(ctx, sts, replicas, current), inputs)
+	if decision != coh.ApprovalDecisionApprove {
+		logger.Info("Scaling was not approved", "Current", current, "Replicas", replicas, "Desired", desired, "Reason", msg)
+		reason := coh.ReasonApprovalDeferred
+		if decision == coh.ApprovalDecisionDeny {
+			reason = coh.ReasonApprovalDenied
+		}
+		in.updateScalingBlocked(ctx, deployment, reason, msg)
+		recordHistory(ctx, in.GetStatusManager(), deployment, coh.NewHistoryEntry(coh.HistoryOperationScale, coh.HistoryResultDeferred, msg, inputs))
+		return approvalResult, nil
+	}
+
+	logger.Info("Coherence cluster is StatusHA, safely scaling", "Current", current, "Replicas", replicas, "Desired", desired)
+	in.updateScalingBlocked(ctx, deployment, coh.ReasonNotBlocked, "")
+
+	// use the parallel method to just scale by one step
+	_, err := in.parallelScale(ctx, deployment, sts, replicas)
+	if err == nil {
+		recordHistory(ctx, in.GetStatusManager(), deployment, coh.NewHistoryEntry(coh.HistoryOperationScale, coh.HistoryResultSucceeded,
+			fmt.Sprintf("safely scaled from %d to %d replicas", current, replicas), inputs))
+		if replicas == desired {
+			// we're at the desired size so finished scaling
+			return reconcile.Result{}, nil
 		}
-		// failed
-		return in.HandleErrAndRequeue(ctx, err, deployment, fmt.Sprintf(FailedToScaleMessage, deployment.GetName(), current, replicas, err.Error()), logger)
+		// scaled by one step but not yet at the desired size - requeue request after one minute
+		return reconcile.Result{RequeueAfter: time.Minute}, nil
 	}
+	// failed
+	recordHistory(ctx, in.GetStatusManager(), deployment, coh.NewHistoryEntry(coh.HistoryOperationScale, coh.HistoryResultFailed,
+		fmt.Sprintf("failed to scale from %d to %d replicas: %s", current, replicas, err.Error()), inputs))
+	return in.HandleErrAndRequeue(ctx, err, deployment, fmt.Sprintf(FailedToScaleMessage, deployment.GetName(), current, replicas, err.Error()), logger)
+}
 
-	// Not StatusHA - wait at least one minute
+// requiredHALevelMessage returns the part of a message describing the required HA status level of a
+// deployment, or an empty string if there is no required level.
+func requiredHALevelMessage(deployment coh.CoherenceResource) string {
+	spec, found := deployment.GetStatefulSetSpec()
+	if !found || !spec.Scaling.IsHALevelRequired() {
+		return ""
+	}
+	return fmt.Sprintf(" at the required HA status level %s", spec.Scaling.GetRequiredHALevel())
+}
+
+// deferSafeScale defers safe scaling because the cluster is not safe to scale, setting the ScalingBlocked
+// condition and recording the deferral in the history, and returns the result to requeue the request.
+// newCoherenceProbe creates a CoherenceProbe that sends events for, and records the HA status level
+// checks in the status of, the specified deployment.
+func (in *ReconcileStatefulSet) newCoherenceProbe(deployment coh.CoherenceResource) probe.CoherenceProbe {
+	return probe.CoherenceProbe{
+		Client:        in.GetClient(),
+		Config:        in.GetManager().GetConfig(),
+		EventRecorder: events.NewOwnedEventRecorder(deployment, in.GetEventRecorder()),
+		StatusManager: in.GetStatusManager(),
+	}
+}
+
+func (in *ReconcileStatefulSet) deferSafeScale(ctx context.Context, deployment coh.CoherenceResource, msg string, inputs map[string]string) reconcile.Result {
+	// wait at least one minute
 	retryIn := in.statusHARetry
 	if retryIn < time.Minute {
 		retryIn = time.Minute
 	}
-	logger.Info("Coherence cluster is not StatusHA - Re-queuing scaling request", "Retry", retryIn)
-	return reconcile.Result{RequeueAfter: retryIn}, nil
+	in.GetLog().Info("Coherence cluster is not StatusHA - Re-queuing scaling request", "Namespace", deployment.GetNamespace(),
+		"Name", deployment.GetName(), "Retry", retryIn, "Reason", msg)
+	in.updateScalingBlocked(ctx, deployment, coh.ReasonNotStatusHA, msg)
+	recordHistory(ctx, in.GetStatusManager(), deployment, coh.NewHistoryEntry(coh.HistoryOperationScale, coh.HistoryResultDeferred, msg, inputs))
+	return reconcile.Result{RequeueAfter: retryIn}
+}
+
+// getScaleDownPods returns the Pods that will be removed when a StatefulSet is scaled down from
+// the current to the specified replicas, a StatefulSet removes the Pods with the highest ordinals.
+// A Pod that cannot be found is not returned.
+func (in *ReconcileStatefulSet) getScaleDownPods(ctx context.Context, sts *appsv1.StatefulSet, replicas, current int32) []corev1.Pod {
+	var pods []corev1.Pod
+	for ordinal := current - 1; ordinal >= replicas; ordinal-- {
+		pod := corev1.Pod{}
+		key := types.NamespacedName{Namespace: sts.Namespace, Name: fmt.Sprintf("%s-%d", sts.Name, ordinal)}
+		if err := in.GetClient().Get(ctx, key, &pod); err == nil {
+			pods = append(pods, pod)
+		}
+	}
+	return pods
+}
+
+// scaleHistoryInputs returns the history entry inputs for a safe scaling operation.
+func scaleHistoryInputs(policy coh.ScalingPolicy, current, desired int32) map[string]string {
+	return map[string]string{
+		"policy":          string(policy),
+		"currentReplicas": strconv.Itoa(int(current)),
+		"desiredReplicas": strconv.Itoa(int(desired)),
+	}
+}
+
+// recordHistory adds an entry to the history in the status of a Coherence resource.
+// A failure is logged, as it does not affect the operation being recorded.
+func recordHistory(ctx context.Context, sm *status.StatusManager, deployment coh.CoherenceResource, entry coh.HistoryEntry) {
+	if sm == nil {
+		// there is no status manager when upgrade strategies are used without a manager
+		return
+	}
+	if err := sm.AddHistory(ctx, deployment, entry); err != nil {
+		log.Info("Failed to record history", "Namespace", deployment.GetNamespace(), "Name", deployment.GetName(),
+			"Operation", entry.Operation, "Error", err.Error())
+	}
+}
+
+// updateScalingBlocked sets the ScalingBlocked condition of a Coherence resource, the condition is
+// true for any reason other than coh.ReasonNotBlocked. A failure is logged, as it does not affect scaling.
+func (in *ReconcileStatefulSet) updateScalingBlocked(ctx context.Context, deployment coh.CoherenceResource, reason coh.ConditionReason, msg string) {
+	if _, ok := deployment.(*coh.Coherence); !ok {
+		// the standard conditions are only maintained by the Coherence resource scaling process
+		return
+	}
+	c := coh.Condition{Type: coh.ConditionTypeScalingBlocked, Status: corev1.ConditionTrue, Reason: reason, Message: msg}
+	if reason == coh.ReasonNotBlocked {
+		c.Status = corev1.ConditionFalse
+	}
+	if err := in.UpdateCoherenceStatusNonPhaseCondition(ctx, deployment.GetNamespacedName(), c); err != nil {
+		in.GetLog().Info("Failed to update ScalingBlocked condition", "Namespace", deployment.GetNamespace(), "Name", deployment.GetName(), "Error", err.Error())
+	}
 }
 
 // parallelScale will scale the StatefulSet by the required amount in one request.
@@ -725,6 +994,7 @@ func (in *ReconcileStatefulSet) parallelScale(ctx context.Context, deployment co
 
 	// ThreeWayPatch theStatefulSet to trigger it to scale
 	_, err := in.ThreeWayPatch(ctx, sts.Name, sts, sts, stsDesired)
+	metrics.RecordScalingOperation(deployment.GetNamespace(), deployment.GetName(), currentReplicas, replicas, err)
 	if err != nil {
 		// send a failed scale event
 		msg := fmt.Sprintf("failed to scale StatefulSet %s from %d to %d", sts.Name, in.getReplicas(sts), replicas)
@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2020, 2025, Oracle and/or its affiliates.
+ * Copyright (c) 2020, 2026, Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * http://oss.oracle.com/licenses/upl.
  */
@@ -8,15 +8,21 @@ package statefulset
 
 import (
 	"context"
+	"fmt"
 	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/status"
+	"github.com/oracle/coherence-operator/pkg/metrics"
 	"github.com/oracle/coherence-operator/pkg/nodes"
 	"github.com/oracle/coherence-operator/pkg/operator"
 	"github.com/oracle/coherence-operator/pkg/probe"
+	"github.com/oracle/coherence-operator/pkg/tracing"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"strings"
 	"time"
 )
 
@@ -32,7 +38,7 @@ type UpgradeStrategy interface {
 	RollingUpgrade(context.Context, *appsv1.StatefulSet, string, kubernetes.Interface) (reconcile.Result, error)
 }
 
-func GetUpgradeStrategy(c coh.CoherenceResource, p probe.CoherenceProbe) UpgradeStrategy {
+func GetUpgradeStrategy(c coh.CoherenceResource, p probe.CoherenceProbe, sm *status.StatusManager) UpgradeStrategy {
 	spec, _ := c.GetStatefulSetSpec()
 	if spec.RollingUpdateStrategy != nil {
 		name := *spec.RollingUpdateStrategy
@@ -43,7 +49,10 @@ func GetUpgradeStrategy(c coh.CoherenceResource, p probe.CoherenceProbe) Upgrade
 			sp := spec.GetScalingProbe()
 			return ByNodeUpgradeStrategy{
 				cp:           p,
+				sm:           sm,
 				scalingProbe: sp,
+				approval:     spec.ApprovalWebhook,
+				deployment:   c,
 			}
 		}
 		if name == coh.UpgradeByNodeLabel {
@@ -51,16 +60,32 @@ func GetUpgradeStrategy(c coh.CoherenceResource, p probe.CoherenceProbe) Upgrade
 			if spec.RollingUpdateLabel == nil {
 				return ByNodeUpgradeStrategy{
 					cp:           p,
+					sm:           sm,
 					scalingProbe: sp,
+					approval:     spec.ApprovalWebhook,
+					deployment:   c,
 				}
 			} else {
 				return ByNodeLabelUpgradeStrategy{
 					label:        *spec.RollingUpdateLabel,
 					cp:           p,
+					sm:           sm,
 					scalingProbe: sp,
+					approval:     spec.ApprovalWebhook,
+					deployment:   c,
 				}
 			}
 		}
+		if name == coh.UpgradeCanary {
+			return CanaryUpgradeStrategy{
+				cp:           p,
+				sm:           sm,
+				scalingProbe: spec.GetScalingProbe(),
+				canary:       spec.RollingUpdateCanary,
+				approval:     spec.ApprovalWebhook,
+				deployment:   c,
+			}
+		}
 	}
 	// default is by Pod
 	return ByPodUpgradeStrategy{}
@@ -102,11 +127,14 @@ var _ UpgradeStrategy = ByNodeUpgradeStrategy{}
 
 type ByNodeUpgradeStrategy struct {
 	cp           probe.CoherenceProbe
+	sm           *status.StatusManager
 	scalingProbe *coh.Probe
+	approval     *coh.ApprovalWebhookSpec
+	deployment   coh.CoherenceResource
 }
 
 func (in ByNodeUpgradeStrategy) RollingUpgrade(ctx context.Context, sts *appsv1.StatefulSet, svc string, c kubernetes.Interface) (reconcile.Result, error) {
-	return rollingUpgrade(in.cp, in.scalingProbe, &PodNodeName{}, "NodeName", ctx, sts, svc, c)
+	return rollingUpgrade(in.cp, in.sm, in.scalingProbe, in.approval, in.deployment, &PodNodeName{}, "NodeName", coh.UpgradeByNode, ctx, sts, svc, c)
 }
 
 func (in ByNodeUpgradeStrategy) IsOperatorManaged() bool {
@@ -119,12 +147,15 @@ var _ UpgradeStrategy = ByNodeLabelUpgradeStrategy{}
 
 type ByNodeLabelUpgradeStrategy struct {
 	cp           probe.CoherenceProbe
+	sm           *status.StatusManager
 	scalingProbe *coh.Probe
+	approval     *coh.ApprovalWebhookSpec
+	deployment   coh.CoherenceResource
 	label        string
 }
 
 func (in ByNodeLabelUpgradeStrategy) RollingUpgrade(ctx context.Context, sts *appsv1.StatefulSet, svc string, c kubernetes.Interface) (reconcile.Result, error) {
-	return rollingUpgrade(in.cp, in.scalingProbe, &PodNodeLabel{Label: in.label}, in.label, ctx, sts, svc, c)
+	return rollingUpgrade(in.cp, in.sm, in.scalingProbe, in.approval, in.deployment, &PodNodeLabel{Label: in.label}, in.label, coh.UpgradeByNodeLabel, ctx, sts, svc, c)
 }
 
 func (in ByNodeLabelUpgradeStrategy) IsOperatorManaged() bool {
@@ -177,8 +208,14 @@ func (p *PodNodeLabel) GetNodeId(ctx context.Context, c kubernetes.Interface, po
 
 // ----- helper methods ----------------------------------------------------------------------------
 
-func rollingUpgrade(cp probe.CoherenceProbe, scalingProbe *coh.Probe, fn PodNodeIdSupplier, idName string, ctx context.Context, sts *appsv1.StatefulSet, svc string, c kubernetes.Interface) (reconcile.Result, error) {
-	var err error
+func rollingUpgrade(cp probe.CoherenceProbe, sm *status.StatusManager, scalingProbe *coh.Probe, approvalWebhook *coh.ApprovalWebhookSpec, deployment coh.CoherenceResource, fn PodNodeIdSupplier, idName string, strategy coh.RollingUpdateStrategyType,
+	ctx context.Context, sts *appsv1.StatefulSet, svc string, c kubernetes.Interface) (result reconcile.Result, err error) {
+	start := time.Now()
+	ctx, span := tracing.StartForResource(ctx, "RollingUpgrade", coh.ResourceTypeCoherence.Name(),
+		types.NamespacedName{Namespace: sts.Namespace, Name: sts.Name},
+		tracing.AttributeUpgradeStrategy.String(string(strategy)))
+	defer func() { tracing.End(span, err) }()
+
 	var replicas int32
 
 	if sts.Spec.Replicas == nil {
@@ -221,6 +258,10 @@ func rollingUpgrade(cp probe.CoherenceProbe, scalingProbe *coh.Probe, fn PodNode
 	}
 
 	revision := sts.Status.UpdateRevision
+	if deployment == nil {
+		// the StatefulSet has the same name as the Coherence resource that owns it
+		deployment = &coh.Coherence{ObjectMeta: metav1.ObjectMeta{Namespace: sts.Namespace, Name: sts.Name}}
+	}
 
 	podsToUpdate := corev1.PodList{}
 	if len(pods.Items) > 1 {
@@ -243,6 +284,9 @@ func rollingUpgrade(cp probe.CoherenceProbe, scalingProbe *coh.Probe, fn PodNode
 			}
 			log.Info("All Pods have a single Node identifier and cannot be Safe, no Pods will be upgraded", "Namespace", sts.Namespace,
 				"Name", sts.Name, "Replicas", len(pods.Items), "NodeId", idName, "IdValue", id)
+			recordHistory(ctx, sm, deployment, coh.NewHistoryEntry(coh.HistoryOperationUpgradeStep, coh.HistoryResultSkipped,
+				fmt.Sprintf("all Pods have the same %s %s and cannot be upgraded safely", idName, id),
+				upgradeHistoryInputs(strategy, revision, idName, id, nil)))
 			return reconcile.Result{}, nil
 		}
 
@@ -268,14 +312,46 @@ func rollingUpgrade(cp probe.CoherenceProbe, scalingProbe *coh.Probe, fn PodNode
 
 	if len(podsToUpdate.Items) > 0 {
 		// We have Pods to be upgraded
-		nodeId, _ := fn.GetNodeId(ctx, c, pods.Items[0])
+		nodeId, _ := fn.GetNodeId(ctx, c, podsToUpdate.Items[0])
 		// Check Pods are "safe"
-		if cp.ExecuteProbeForSubSetOfPods(ctx, sts, svc, scalingProbe, pods, podsToUpdate) {
+		safe := cp.ExecuteProbeForSubSetOfPods(ctx, sts, svc, scalingProbe, pods, podsToUpdate)
+		reason := fmt.Sprintf("Pods for %s %s failed the StatusHA check", idName, nodeId)
+		if safe {
+			// the services must also be at the required HA status level, if there is one
+			levelSafe, msg := cp.IsRequiredHALevel(ctx, deployment, sts)
+			if !levelSafe {
+				safe = false
+				reason = fmt.Sprintf("Pods for %s %s were not upgraded, %s", idName, nodeId, msg)
+			}
+		}
+		if safe {
+			inputs := upgradeHistoryInputs(strategy, revision, idName, nodeId, podsToUpdate.Items)
+			decision, msg, approvalResult := requestApproval(ctx, sm, cp.EventRecorder, deployment, approvalWebhook,
+				coh.ApprovalActionDeletePods, podsToUpdate.Items, inputs)
+			if decision != coh.ApprovalDecisionApprove {
+				log.Info("Upgrade of Pods was not approved", "Namespace", sts.Namespace, "Name", sts.Name, "NodeId", idName, "IdValue", nodeId, "Reason", msg)
+				metrics.RecordRollingUpgradeStep(sts.Namespace, sts.Name, string(strategy), metrics.ResultDeferred, time.Since(start))
+				recordHistory(ctx, sm, deployment, coh.NewHistoryEntry(coh.HistoryOperationUpgradeStep, coh.HistoryResultDeferred, msg, inputs))
+				return approvalResult, nil
+			}
 			// delete the Pods
 			log.Info("Upgrading all Pods for Node identifier", "Namespace", sts.Namespace, "Name", sts.Name, "NodeId", idName, "IdValue", nodeId, "Count", len(podsToUpdate.Items))
 			err = deletePods(ctx, podsToUpdate, c)
+			result := metrics.ResultSucceeded
+			if err != nil {
+				result = metrics.ResultFailed
+				recordHistory(ctx, sm, deployment, coh.NewHistoryEntry(coh.HistoryOperationUpgradeStep, coh.HistoryResultFailed,
+					fmt.Sprintf("failed to delete Pods for %s %s: %s", idName, nodeId, err.Error()), inputs))
+			} else {
+				recordHistory(ctx, sm, deployment, coh.NewHistoryEntry(coh.HistoryOperationUpgradeStep, coh.HistoryResultSucceeded,
+					fmt.Sprintf("deleted %d Pods for %s %s", len(podsToUpdate.Items), idName, nodeId), inputs))
+			}
+			metrics.RecordRollingUpgradeStep(sts.Namespace, sts.Name, string(strategy), result, time.Since(start))
 		} else {
-			log.Info("Pods failed Status HA check, upgrade is deferred for one minute", "Namespace", sts.Namespace, "Name", sts.Name, "NodeId", idName, "IdValue", nodeId)
+			log.Info("Pods failed Status HA check, upgrade is deferred for one minute", "Namespace", sts.Namespace, "Name", sts.Name, "NodeId", idName, "IdValue", nodeId, "Reason", reason)
+			metrics.RecordRollingUpgradeStep(sts.Namespace, sts.Name, string(strategy), metrics.ResultDeferred, time.Since(start))
+			recordHistory(ctx, sm, deployment, coh.NewHistoryEntry(coh.HistoryOperationUpgradeStep, coh.HistoryResultDeferred,
+				reason, upgradeHistoryInputs(strategy, revision, idName, nodeId, podsToUpdate.Items)))
 			return reconcile.Result{RequeueAfter: time.Minute}, nil
 		}
 	}
@@ -286,8 +362,27 @@ func rollingUpgrade(cp probe.CoherenceProbe, scalingProbe *coh.Probe, fn PodNode
 	return reconcile.Result{}, err
 }
 
+// upgradeHistoryInputs returns the history entry inputs for a rolling upgrade step.
+func upgradeHistoryInputs(strategy coh.RollingUpdateStrategyType, revision, idName, id string, pods []corev1.Pod) map[string]string {
+	inputs := map[string]string{
+		"strategy": string(strategy),
+		"revision": revision,
+		idName:     id,
+	}
+	if len(pods) > 0 {
+		names := make([]string, 0, len(pods))
+		for _, pod := range pods {
+			names = append(names, pod.Name)
+		}
+		inputs["pods"] = strings.Join(names, ",")
+	}
+	return inputs
+}
+
 // deletePods will delete the pods in a pod list
-func deletePods(ctx context.Context, pods corev1.PodList, c kubernetes.Interface) error {
+func deletePods(ctx context.Context, pods corev1.PodList, c kubernetes.Interface) (err error) {
+	ctx, span := tracing.Start(ctx, "DeletePods", tracing.AttributePodCount.Int(len(pods.Items)))
+	defer func() { tracing.End(span, err) }()
 	for _, pod := range pods.Items {
 		log.Info("Attempting to delete Pod to trigger upgrade", "Namespace", pod.Namespace, "Name", pod.Name)
 		if err := c.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{}); err != nil {
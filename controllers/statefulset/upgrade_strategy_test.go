@@ -27,7 +27,7 @@ func TestUseUpgradeStrategyByPodIfNotSet(t *testing.T) {
 	}
 
 	p := probe.CoherenceProbe{}
-	s := statefulset.GetUpgradeStrategy(c, p)
+	s := statefulset.GetUpgradeStrategy(c, p, nil)
 
 	g.Expect(s).To(BeAssignableToTypeOf(statefulset.ByPodUpgradeStrategy{}))
 	g.Expect(s.IsOperatorManaged()).To(BeFalse())
@@ -46,7 +46,7 @@ func TestUseUpgradeStrategyByPod(t *testing.T) {
 	}
 
 	p := probe.CoherenceProbe{}
-	s := statefulset.GetUpgradeStrategy(c, p)
+	s := statefulset.GetUpgradeStrategy(c, p, nil)
 
 	g.Expect(s).To(BeAssignableToTypeOf(statefulset.ByPodUpgradeStrategy{}))
 	g.Expect(s.IsOperatorManaged()).To(BeFalse())
@@ -65,7 +65,7 @@ func TestUseUpgradeStrategyByNode(t *testing.T) {
 	}
 
 	p := probe.CoherenceProbe{}
-	s := statefulset.GetUpgradeStrategy(c, p)
+	s := statefulset.GetUpgradeStrategy(c, p, nil)
 
 	g.Expect(s).To(BeAssignableToTypeOf(statefulset.ByNodeUpgradeStrategy{}))
 	g.Expect(s.IsOperatorManaged()).To(BeTrue())
@@ -84,7 +84,7 @@ func TestUseUpgradeStrategyManual(t *testing.T) {
 	}
 
 	p := probe.CoherenceProbe{}
-	s := statefulset.GetUpgradeStrategy(c, p)
+	s := statefulset.GetUpgradeStrategy(c, p, nil)
 
 	g.Expect(s).To(BeAssignableToTypeOf(statefulset.ManualUpgradeStrategy{}))
 	g.Expect(s.IsOperatorManaged()).To(BeFalse())
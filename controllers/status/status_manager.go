@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2020, 2025, Oracle and/or its affiliates.
+ * Copyright (c) 2020, 2026, Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * http://oss.oracle.com/licenses/upl.
  */
@@ -13,6 +13,8 @@ import (
 	"github.com/oracle/coherence-operator/pkg/operator"
 	"github.com/oracle/coherence-operator/pkg/patching"
 	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -59,18 +61,176 @@ func (sm *StatusManager) UpdateDeploymentStatusHash(ctx context.Context, namespa
 	return sm.patchStatus(ctx, deployment, updated)
 }
 
-func (sm *StatusManager) patchStatus(ctx context.Context, original, updated *coh.Coherence) error {
-	patch, err := sm.Patcher.CreateTwoWayPatchOfType(types.MergePatchType, original.Name, updated, original)
+// UpdateSnapshotStatus updates the scheduled snapshot fields in the status of a Coherence resource
+func (sm *StatusManager) UpdateSnapshotStatus(ctx context.Context, namespacedName types.NamespacedName, scheduleStart, lastScheduled, lastSnapshotTime *metav1.Time, lastSnapshot string) error {
+	// Get the latest version of the Coherence resource
+	deployment := &coh.Coherence{}
+	err := sm.Client.Get(ctx, namespacedName, deployment)
+	if err != nil {
+		return errors.Wrapf(err, "getting Coherence resource %s/%s", namespacedName.Namespace, namespacedName.Name)
+	}
+
+	// Update the snapshot status
+	updated := deployment.DeepCopy()
+	updated.Status.SnapshotScheduleStartTime = scheduleStart
+	updated.Status.LastScheduledSnapshotTime = lastScheduled
+	updated.Status.LastSnapshotTime = lastSnapshotTime
+	updated.Status.LastSnapshot = lastSnapshot
+
+	// Update the resource
+	return sm.patchStatus(ctx, deployment, updated)
+}
+
+// UpdateAutoscaleStatus updates the autoscale status of a Coherence resource
+func (sm *StatusManager) UpdateAutoscaleStatus(ctx context.Context, namespacedName types.NamespacedName, autoscale *coh.AutoscaleStatus) error {
+	// Get the latest version of the Coherence resource
+	deployment := &coh.Coherence{}
+	err := sm.Client.Get(ctx, namespacedName, deployment)
+	if err != nil {
+		return errors.Wrapf(err, "getting Coherence resource %s/%s", namespacedName.Namespace, namespacedName.Name)
+	}
+
+	// Update the autoscale status
+	updated := deployment.DeepCopy()
+	updated.Status.Autoscale = autoscale
+
+	// Update the resource
+	return sm.patchStatus(ctx, deployment, updated)
+}
+
+// UpdateMembersStatus updates the cluster members and services in the status of a Coherence resource
+// and sets or, if the condition is nil, removes the ServicesEndangered condition. Setting the
+// ServicesEndangered condition does not change the phase.
+func (sm *StatusManager) UpdateMembersStatus(ctx context.Context, namespacedName types.NamespacedName, members []coh.CoherenceMemberStatus,
+	services []coh.CoherenceServiceStatus, endangered *coh.Condition) error {
+	// Get the latest version of the Coherence resource
+	deployment := &coh.Coherence{}
+	err := sm.Client.Get(ctx, namespacedName, deployment)
+	if err != nil {
+		return errors.Wrapf(err, "getting Coherence resource %s/%s", namespacedName.Namespace, namespacedName.Name)
+	}
+
+	// Update the members, services and ServicesEndangered condition
+	updated := deployment.DeepCopy()
+	updated.Status.Members = members
+	updated.Status.Services = services
+	if endangered == nil {
+		updated.Status.Conditions.RemoveCondition(coh.ConditionTypeServicesEndangered)
+	} else {
+		updated.Status.Conditions.SetCondition(*endangered)
+	}
+
+	// Update the resource
+	return sm.patchStatus(ctx, deployment, updated)
+}
+
+// UpdateDriftedCondition sets or, if the condition is nil, removes the Drifted condition
+// in the status of a Coherence resource. Setting the Drifted condition does not change the phase.
+func (sm *StatusManager) UpdateDriftedCondition(ctx context.Context, namespacedName types.NamespacedName, c *coh.Condition) error {
+	// Get the latest version of the Coherence resource
+	deployment := &coh.Coherence{}
+	err := sm.Client.Get(ctx, namespacedName, deployment)
+	if err != nil {
+		return errors.Wrapf(err, "getting Coherence resource %s/%s", namespacedName.Namespace, namespacedName.Name)
+	}
+
+	// Update the Drifted condition
+	updated := deployment.DeepCopy()
+	var changed bool
+	if c == nil {
+		changed = updated.Status.Conditions.RemoveCondition(coh.ConditionTypeDrifted)
+	} else {
+		changed = updated.Status.Conditions.SetCondition(*c)
+	}
+	if !changed {
+		return nil
+	}
+
+	// Update the resource
+	return sm.patchStatus(ctx, deployment, updated)
+}
+
+// AddHistory adds an entry to the history in the status of a Coherence or CoherenceJob resource.
+// Nothing is updated if the entry repeats the most recent history entry.
+func (sm *StatusManager) AddHistory(ctx context.Context, resource coh.CoherenceResource, entry coh.HistoryEntry) error {
+	return sm.updateLatest(ctx, resource, func(status *coh.CoherenceResourceStatus) bool {
+		return status.AddHistory(entry)
+	})
+}
+
+// SetCondition sets a condition in the status of a Coherence or CoherenceJob resource
+// without changing the phase.
+func (sm *StatusManager) SetCondition(ctx context.Context, resource coh.CoherenceResource, condition coh.Condition) error {
+	return sm.updateLatest(ctx, resource, func(status *coh.CoherenceResourceStatus) bool {
+		return status.Conditions.SetCondition(condition)
+	})
+}
+
+// RemoveCondition removes a condition from the status of a Coherence or CoherenceJob resource
+// without changing the phase.
+func (sm *StatusManager) RemoveCondition(ctx context.Context, resource coh.CoherenceResource, t coh.ConditionType) error {
+	return sm.updateLatest(ctx, resource, func(status *coh.CoherenceResourceStatus) bool {
+		return status.Conditions.RemoveCondition(t)
+	})
+}
+
+// SetApproval sets the most recent approval webhook decision in the status of a Coherence
+// or CoherenceJob resource.
+func (sm *StatusManager) SetApproval(ctx context.Context, resource coh.CoherenceResource, approval coh.ApprovalStatus) error {
+	return sm.updateLatest(ctx, resource, func(status *coh.CoherenceResourceStatus) bool {
+		return status.SetApproval(approval)
+	})
+}
+
+// SetHALevel sets the result of the most recent HA status level check in the status of a
+// Coherence or CoherenceJob resource.
+func (sm *StatusManager) SetHALevel(ctx context.Context, resource coh.CoherenceResource, level coh.HALevelStatus) error {
+	return sm.updateLatest(ctx, resource, func(status *coh.CoherenceResourceStatus) bool {
+		return status.SetHALevel(level)
+	})
+}
+
+// updateLatest fetches the latest version of a Coherence or CoherenceJob resource and patches its
+// status if the update function changes the status. The resource is only used to determine the type
+// and key of the resource to update. Nothing is updated if the resource has been deleted.
+func (sm *StatusManager) updateLatest(ctx context.Context, resource coh.CoherenceResource, update func(*coh.CoherenceResourceStatus) bool) error {
+	// Get the latest version of the resource
+	latest := resource.DeepCopyResource()
+	err := sm.Client.Get(ctx, resource.GetNamespacedName(), latest)
+	switch {
+	case err != nil && apierrors.IsNotFound(err):
+		// resource not found - possibly deleted
+		return nil
+	case err != nil:
+		return errors.Wrapf(err, "getting resource %s/%s", resource.GetNamespace(), resource.GetName())
+	case latest.GetDeletionTimestamp() != nil:
+		// resource is being deleted
+		return nil
+	}
+
+	updated := latest.DeepCopyResource()
+	if !update(updated.GetStatus()) {
+		return nil
+	}
+
+	// Update the resource
+	return sm.patchStatus(ctx, latest, updated)
+}
+
+func (sm *StatusManager) patchStatus(ctx context.Context, original, updated coh.CoherenceResource) error {
+	// keep the standard conditions consistent with the updated status
+	updated.GetStatus().UpdateStandardConditions(updated)
+	patch, err := sm.Patcher.CreateTwoWayPatchOfType(types.MergePatchType, original.GetName(), updated, original)
 	if err != nil {
-		return errors.Wrapf(err, "creating status patch for Coherence resource %s/%s", original.Namespace, original.Name)
+		return errors.Wrapf(err, "creating status patch for resource %s/%s", original.GetNamespace(), original.GetName())
 	}
 	if patch != nil {
-		sm.Log.Info("Patching status", "Namespace", original.Namespace, "Name", original.Name, "Patch", patch)
+		sm.Log.Info("Patching status", "Namespace", original.GetNamespace(), "Name", original.GetName(), "Patch", patch)
 		err = sm.Client.Status().Patch(ctx, original, patch)
 		if err != nil {
-			return errors.Wrapf(err, "updating status for Coherence resource %s/%s", original.Namespace, original.Name)
+			return errors.Wrapf(err, "updating status for resource %s/%s", original.GetNamespace(), original.GetName())
 		}
-		sm.Log.Info("Patched status", "Namespace", original.Namespace, "Name", original.Name, "Patch", patch)
+		sm.Log.Info("Patched status", "Namespace", original.GetNamespace(), "Name", original.GetName(), "Patch", patch)
 	}
 	return nil
 }
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package status_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/pkg/fakes/stubs"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestAddHistory(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	deployment := &coh.Coherence{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "storage"}}
+	c := stubs.NewClient(deployment)
+
+	// the resource passed in only needs to identify the resource to update
+	key := &coh.Coherence{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "storage"}}
+	entry := coh.NewHistoryEntry(coh.HistoryOperationUpgradeStep, coh.HistoryResultSucceeded, "deleted 2 Pods",
+		map[string]string{"pods": "storage-0,storage-1"})
+	sm := stubs.NewStatusManager(c)
+	g.Expect(sm.AddHistory(ctx, key, entry)).To(Succeed())
+	g.Expect(sm.AddHistory(ctx, key, entry)).To(Succeed())
+
+	latest := &coh.Coherence{}
+	g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+	g.Expect(latest.Status.History).To(HaveLen(1))
+	g.Expect(latest.Status.History[0].Operation).To(Equal(coh.HistoryOperationUpgradeStep))
+	g.Expect(latest.Status.History[0].Inputs).To(HaveKeyWithValue("pods", "storage-0,storage-1"))
+}
+
+func TestAddHistoryWhenResourceDeleted(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := fake.NewClientBuilder().WithScheme(stubs.NewScheme()).Build()
+
+	key := &coh.Coherence{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "storage"}}
+	entry := coh.NewHistoryEntry(coh.HistoryOperationScale, coh.HistoryResultDeferred, "not StatusHA", nil)
+	g.Expect(stubs.NewStatusManager(c).AddHistory(context.Background(), key, entry)).To(Succeed())
+}
+
+func TestSetAndRemoveConditionOfJob(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	job := &coh.CoherenceJob{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "test-job"}}
+	c := stubs.NewClient(job)
+	sm := stubs.NewStatusManager(c)
+
+	condition := coh.Condition{Type: coh.ConditionTypeRollingUpgradePaused, Status: corev1.ConditionTrue,
+		Reason: coh.ReasonPaused, Message: "paused"}
+	g.Expect(sm.SetCondition(ctx, job, condition)).To(Succeed())
+
+	latest := &coh.CoherenceJob{}
+	g.Expect(c.Get(ctx, job.GetNamespacedName(), latest)).To(Succeed())
+	g.Expect(latest.Status.Conditions.GetCondition(coh.ConditionTypeRollingUpgradePaused)).NotTo(BeNil())
+
+	g.Expect(sm.RemoveCondition(ctx, job, coh.ConditionTypeRollingUpgradePaused)).To(Succeed())
+	g.Expect(c.Get(ctx, job.GetNamespacedName(), latest)).To(Succeed())
+	g.Expect(latest.Status.Conditions.GetCondition(coh.ConditionTypeRollingUpgradePaused)).To(BeNil())
+}
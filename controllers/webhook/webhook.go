@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+// Package webhook contains the optional validating web-hooks for the Coherence and CoherenceJob resources.
+package webhook
+
+import (
+	"context"
+
+	coh "github.com/oracle/coherence-operator/api/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// +kubebuilder:webhook:path=/validate-coherence-oracle-com-v1-coherence,mutating=false,failurePolicy=fail,sideEffects=None,groups=coherence.oracle.com,resources=coherence,verbs=create;update,versions=v1,name=coherence.coherence.oracle.com,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-coherence-oracle-com-v1-coherencejob,mutating=false,failurePolicy=fail,sideEffects=None,groups=coherence.oracle.com,resources=coherencejob,verbs=create;update,versions=v1,name=coherencejob.coherence.oracle.com,admissionReviewVersions=v1
+
+// blank assignments to verify that the validators implement admission.Validator.
+// If the admission.Validator API was to change then we'd get a compile error here.
+var _ admission.Validator[*coh.Coherence] = &CoherenceValidator{}
+var _ admission.Validator[*coh.CoherenceJob] = &CoherenceJobValidator{}
+
+// SetupWebhookWithManager registers the validating web-hooks with the manager's web-hook server.
+func SetupWebhookWithManager(mgr ctrl.Manager, jobs bool) error {
+	err := ctrl.NewWebhookManagedBy(mgr, &coh.Coherence{}).
+		WithValidator(&CoherenceValidator{}).
+		Complete()
+	if err != nil || !jobs {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr, &coh.CoherenceJob{}).
+		WithValidator(&CoherenceJobValidator{}).
+		Complete()
+}
+
+// CoherenceValidator validates Coherence resources.
+type CoherenceValidator struct{}
+
+// ValidateCreate validates a Coherence resource on creation.
+func (in *CoherenceValidator) ValidateCreate(_ context.Context, deployment *coh.Coherence) (admission.Warnings, error) {
+	return nil, toError(coh.ResourceTypeCoherence, deployment.GetName(), coh.ValidateCoherenceCreate(deployment))
+}
+
+// ValidateUpdate validates a Coherence resource on update.
+func (in *CoherenceValidator) ValidateUpdate(_ context.Context, oldDeployment, deployment *coh.Coherence) (admission.Warnings, error) {
+	return nil, toError(coh.ResourceTypeCoherence, deployment.GetName(), coh.ValidateCoherenceUpdate(deployment, oldDeployment))
+}
+
+// ValidateDelete validates a Coherence resource on deletion, deletions are always allowed.
+func (in *CoherenceValidator) ValidateDelete(_ context.Context, _ *coh.Coherence) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// CoherenceJobValidator validates CoherenceJob resources.
+type CoherenceJobValidator struct{}
+
+// ValidateCreate validates a CoherenceJob resource on creation.
+func (in *CoherenceJobValidator) ValidateCreate(_ context.Context, job *coh.CoherenceJob) (admission.Warnings, error) {
+	return nil, toError(coh.ResourceTypeCoherenceJob, job.GetName(), coh.ValidateCoherenceJobCreate(job))
+}
+
+// ValidateUpdate validates a CoherenceJob resource on update.
+func (in *CoherenceJobValidator) ValidateUpdate(_ context.Context, oldJob, job *coh.CoherenceJob) (admission.Warnings, error) {
+	return nil, toError(coh.ResourceTypeCoherenceJob, job.GetName(), coh.ValidateCoherenceJobUpdate(job, oldJob))
+}
+
+// ValidateDelete validates a CoherenceJob resource on deletion, deletions are always allowed.
+func (in *CoherenceJobValidator) ValidateDelete(_ context.Context, _ *coh.CoherenceJob) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// toError converts a validation error list to an Invalid API error, or nil if the list is empty.
+func toError(kind coh.ResourceType, name string, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(coh.GroupVersion.WithKind(kind.Name()).GroupKind(), name, errs)
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package webhook_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/webhook"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestShouldAllowValidCoherenceCreate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	v := &webhook.CoherenceValidator{}
+	_, err := v.ValidateCreate(context.Background(), newCoherence(3))
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestShouldRejectCoherenceCreateWithNegativeReplicas(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	v := &webhook.CoherenceValidator{}
+	_, err := v.ValidateCreate(context.Background(), newCoherence(-1))
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	g.Expect(err.Error()).To(ContainSubstring("spec.replicas"))
+}
+
+func TestShouldRejectCoherenceUpdateWithNegativeReplicas(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	v := &webhook.CoherenceValidator{}
+	_, err := v.ValidateUpdate(context.Background(), newCoherence(3), newCoherence(-1))
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+}
+
+func TestShouldAllowCoherenceDelete(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	v := &webhook.CoherenceValidator{}
+	_, err := v.ValidateDelete(context.Background(), newCoherence(-1))
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestShouldRejectCoherenceJobCreateWithNegativeReplicas(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	job := &coh.CoherenceJob{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test"},
+	}
+	job.Spec.Replicas = ptr.To(int32(-1))
+
+	v := &webhook.CoherenceJobValidator{}
+	_, err := v.ValidateCreate(context.Background(), job)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	g.Expect(err.Error()).To(ContainSubstring("spec.replicas"))
+}
+
+func newCoherence(replicas int32) *coh.Coherence {
+	c := &coh.Coherence{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test"},
+	}
+	c.Spec.Replicas = ptr.To(replicas)
+	return c
+}
@@ -0,0 +1,417 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package com.oracle.coherence.k8s;
+
+import java.io.ByteArrayInputStream;
+import java.io.IOException;
+import java.io.InputStream;
+
+import java.net.URI;
+import java.net.http.HttpClient;
+import java.net.http.HttpRequest;
+import java.net.http.HttpResponse;
+
+import java.nio.charset.StandardCharsets;
+import java.nio.file.Path;
+
+import java.security.GeneralSecurityException;
+import java.security.MessageDigest;
+
+import java.time.Duration;
+import java.time.ZoneOffset;
+import java.time.ZonedDateTime;
+import java.time.format.DateTimeFormatter;
+
+import java.util.ArrayList;
+import java.util.List;
+import java.util.Map;
+import java.util.TreeMap;
+
+import javax.crypto.Mac;
+import javax.crypto.spec.SecretKeySpec;
+import javax.xml.parsers.DocumentBuilderFactory;
+
+import org.w3c.dom.Document;
+import org.w3c.dom.Element;
+import org.w3c.dom.NodeList;
+
+/**
+ * A minimal client for an S3 compatible object store.
+ * <p>
+ * Requests are signed using AWS signature version 4 with an unsigned payload,
+ * which is supported by AWS S3 and by S3 compatible stores such as MinIO.
+ */
+public class S3Client {
+    /**
+     * The signing algorithm.
+     */
+    private static final String ALGORITHM = "AWS4-HMAC-SHA256";
+
+    /**
+     * The payload hash used for requests with an unsigned payload.
+     */
+    private static final String UNSIGNED_PAYLOAD = "UNSIGNED-PAYLOAD";
+
+    /**
+     * The hex characters.
+     */
+    private static final char[] HEX = "0123456789abcdef".toCharArray();
+
+    /**
+     * The format of the request date-time.
+     */
+    private static final DateTimeFormatter DATE_TIME = DateTimeFormatter.ofPattern("yyyyMMdd'T'HHmmss'Z'");
+
+    /**
+     * The format of the request date.
+     */
+    private static final DateTimeFormatter DATE = DateTimeFormatter.ofPattern("yyyyMMdd");
+
+    /**
+     * The object store endpoint.
+     */
+    private final URI endpoint;
+
+    /**
+     * The region used to sign requests.
+     */
+    private final String region;
+
+    /**
+     * The bucket name.
+     */
+    private final String bucket;
+
+    /**
+     * {@code true} to use path style requests.
+     */
+    private final boolean pathStyle;
+
+    /**
+     * The access key.
+     */
+    private final String accessKey;
+
+    /**
+     * The secret key.
+     */
+    private final String secretKey;
+
+    /**
+     * The http client.
+     */
+    private final HttpClient client;
+
+    /**
+     * Create a {@link S3Client}.
+     *
+     * @param endpoint   the object store endpoint
+     * @param region     the region used to sign requests
+     * @param bucket     the bucket name
+     * @param pathStyle  {@code true} to use path style requests
+     * @param accessKey  the access key
+     * @param secretKey  the secret key
+     */
+    public S3Client(URI endpoint, String region, String bucket, boolean pathStyle, String accessKey, String secretKey) {
+        this.endpoint = endpoint;
+        this.region = region;
+        this.bucket = bucket;
+        this.pathStyle = pathStyle;
+        this.accessKey = accessKey;
+        this.secretKey = secretKey;
+        this.client = HttpClient.newBuilder()
+                .version(HttpClient.Version.HTTP_1_1)
+                .connectTimeout(Duration.ofSeconds(30))
+                .build();
+    }
+
+    /**
+     * Upload the contents of a file to an object.
+     *
+     * @param key   the object key
+     * @param file  the file to upload
+     *
+     * @throws IOException if the upload fails
+     */
+    public void putObject(String key, Path file) throws IOException {
+        send("PUT", key, Map.of(), HttpRequest.BodyPublishers.ofFile(file), HttpResponse.BodyHandlers.ofString());
+    }
+
+    /**
+     * Returns the contents of an object.
+     * <p>
+     * The caller must close the returned stream.
+     *
+     * @param key  the object key
+     *
+     * @return the contents of the object
+     *
+     * @throws IOException if the object cannot be read
+     */
+    public InputStream getObject(String key) throws IOException {
+        return send("GET", key, Map.of(), HttpRequest.BodyPublishers.noBody(), HttpResponse.BodyHandlers.ofInputStream());
+    }
+
+    /**
+     * Delete an object.
+     *
+     * @param key  the object key
+     *
+     * @throws IOException if the object cannot be deleted
+     */
+    public void deleteObject(String key) throws IOException {
+        send("DELETE", key, Map.of(), HttpRequest.BodyPublishers.noBody(), HttpResponse.BodyHandlers.ofString());
+    }
+
+    /**
+     * Returns the keys of all the objects with a key prefix.
+     *
+     * @param prefix  the key prefix
+     *
+     * @return the keys of all the objects with the key prefix
+     *
+     * @throws IOException if the objects cannot be listed
+     */
+    public List<String> listKeys(String prefix) throws IOException {
+        return list(prefix, null, "Contents", "Key");
+    }
+
+    /**
+     * Returns the distinct key prefixes, up to the next delimiter, of all the objects with a key prefix.
+     *
+     * @param prefix     the key prefix
+     * @param delimiter  the delimiter
+     *
+     * @return the distinct key prefixes, including the delimiter
+     *
+     * @throws IOException if the objects cannot be listed
+     */
+    public List<String> listCommonPrefixes(String prefix, String delimiter) throws IOException {
+        return list(prefix, delimiter, "CommonPrefixes", "Prefix");
+    }
+
+    /**
+     * List the objects with a key prefix using the ListObjectsV2 API, following continuation tokens.
+     *
+     * @param prefix     the key prefix
+     * @param delimiter  the optional delimiter
+     * @param element    the result element to return values from
+     * @param child      the child of the result element containing the value
+     *
+     * @return the listed values
+     *
+     * @throws IOException if the objects cannot be listed
+     */
+    private List<String> list(String prefix, String delimiter, String element, String child) throws IOException {
+        List<String> results = new ArrayList<>();
+        String token = null;
+        do {
+            Map<String, String> query = new TreeMap<>();
+            query.put("list-type", "2");
+            query.put("prefix", prefix);
+            if (delimiter != null) {
+                query.put("delimiter", delimiter);
+            }
+            if (token != null) {
+                query.put("continuation-token", token);
+            }
+
+            String xml = send("GET", "", query, HttpRequest.BodyPublishers.noBody(), HttpResponse.BodyHandlers.ofString());
+            Document doc = parse(xml);
+            NodeList nodes = doc.getElementsByTagName(element);
+            for (int i = 0; i < nodes.getLength(); i++) {
+                NodeList values = ((Element) nodes.item(i)).getElementsByTagName(child);
+                if (values.getLength() > 0) {
+                    results.add(values.item(0).getTextContent());
+                }
+            }
+            token = Boolean.parseBoolean(text(doc, "IsTruncated")) ? text(doc, "NextContinuationToken") : null;
+        }
+        while (token != null && !token.isEmpty());
+        return results;
+    }
+
+    /**
+     * Send a signed request.
+     *
+     * @param method     the http method
+     * @param key        the object key, or an empty string for a bucket request
+     * @param query      the query parameters
+     * @param publisher  the request body
+     * @param handler    the response body handler
+     * @param <T>        the type of the response body
+     *
+     * @return the response body
+     *
+     * @throws IOException if the request fails or returns an error status
+     */
+    private <T> T send(String method, String key, Map<String, String> query,
+                       HttpRequest.BodyPublisher publisher, HttpResponse.BodyHandler<T> handler) throws IOException {
+        String host = pathStyle ? authority(endpoint) : bucket + "." + authority(endpoint);
+        String basePath = endpoint.getRawPath() == null ? "" : stripTrailingSlash(endpoint.getRawPath());
+        String path = basePath + (pathStyle ? "/" + encode(bucket, true) : "") + "/" + encode(key, false);
+
+        StringBuilder queryString = new StringBuilder();
+        for (Map.Entry<String, String> entry : new TreeMap<>(query).entrySet()) {
+            if (queryString.length() > 0) {
+                queryString.append('&');
+            }
+            queryString.append(encode(entry.getKey(), true)).append('=').append(encode(entry.getValue(), true));
+        }
+
+        ZonedDateTime now = ZonedDateTime.now(ZoneOffset.UTC);
+        String dateTime = DATE_TIME.format(now);
+        String authorization = authorization(method, host, path, queryString.toString(), dateTime, DATE.format(now));
+
+        URI uri = URI.create(endpoint.getScheme() + "://" + host + path
+                             + (queryString.length() == 0 ? "" : "?" + queryString));
+
+        HttpRequest request = HttpRequest.newBuilder(uri)
+                .method(method, publisher)
+                .header("x-amz-content-sha256", UNSIGNED_PAYLOAD)
+                .header("x-amz-date", dateTime)
+                .header("Authorization", authorization)
+                .build();
+
+        HttpResponse<T> response;
+        try {
+            response = client.send(request, handler);
+        }
+        catch (InterruptedException e) {
+            Thread.currentThread().interrupt();
+            throw new IOException("Interrupted sending " + method + " request to " + uri, e);
+        }
+
+        int status = response.statusCode();
+        if (status < 200 || status >= 300) {
+            T body = response.body();
+            if (body instanceof InputStream) {
+                ((InputStream) body).close();
+            }
+            throw new IOException(method + " request to " + uri + " failed with status " + status
+                                  + (body instanceof String ? ": " + body : ""));
+        }
+        return response.body();
+    }
+
+    /**
+     * Create the AWS signature version 4 authorization header value.
+     *
+     * @param method    the http method
+     * @param host      the host header value
+     * @param path      the encoded request path
+     * @param query     the canonical query string
+     * @param dateTime  the request date-time
+     * @param date      the request date
+     *
+     * @return the authorization header value
+     */
+    String authorization(String method, String host, String path, String query, String dateTime, String date) {
+        String signedHeaders = "host;x-amz-content-sha256;x-amz-date";
+        String canonicalRequest = method + "\n"
+                + path + "\n"
+                + query + "\n"
+                + "host:" + host + "\n"
+                + "x-amz-content-sha256:" + UNSIGNED_PAYLOAD + "\n"
+                + "x-amz-date:" + dateTime + "\n"
+                + "\n"
+                + signedHeaders + "\n"
+                + UNSIGNED_PAYLOAD;
+
+        String scope = date + "/" + region + "/s3/aws4_request";
+        String stringToSign = ALGORITHM + "\n" + dateTime + "\n" + scope + "\n" + hex(sha256(canonicalRequest));
+
+        byte[] key = hmac(("AWS4" + secretKey).getBytes(StandardCharsets.UTF_8), date);
+        key = hmac(key, region);
+        key = hmac(key, "s3");
+        key = hmac(key, "aws4_request");
+        String signature = hex(hmac(key, stringToSign));
+
+        return ALGORITHM + " Credential=" + accessKey + "/" + scope
+                + ", SignedHeaders=" + signedHeaders
+                + ", Signature=" + signature;
+    }
+
+    /**
+     * URI encode a value as required by AWS signature version 4.
+     *
+     * @param value        the value to encode
+     * @param encodeSlash  {@code true} to encode the '/' character
+     *
+     * @return the encoded value
+     */
+    static String encode(String value, boolean encodeSlash) {
+        StringBuilder sb = new StringBuilder();
+        for (byte b : value.getBytes(StandardCharsets.UTF_8)) {
+            char c = (char) (b & 0xFF);
+            if ((c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')
+                    || c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash)) {
+                sb.append(c);
+            }
+            else {
+                sb.append(String.format("%%%02X", b & 0xFF));
+            }
+        }
+        return sb.toString();
+    }
+
+    private static String authority(URI uri) {
+        int port = uri.getPort();
+        boolean defaultPort = port == -1
+                || ("http".equalsIgnoreCase(uri.getScheme()) && port == 80)
+                || ("https".equalsIgnoreCase(uri.getScheme()) && port == 443);
+        return defaultPort ? uri.getHost() : uri.getHost() + ":" + port;
+    }
+
+    private static String stripTrailingSlash(String s) {
+        return s.endsWith("/") ? s.substring(0, s.length() - 1) : s;
+    }
+
+    private static Document parse(String xml) throws IOException {
+        try {
+            DocumentBuilderFactory factory = DocumentBuilderFactory.newInstance();
+            factory.setFeature("http://apache.org/xml/features/disallow-doctype-decl", true);
+            return factory.newDocumentBuilder().parse(new ByteArrayInputStream(xml.getBytes(StandardCharsets.UTF_8)));
+        }
+        catch (Exception e) {
+            throw new IOException("Failed to parse S3 response", e);
+        }
+    }
+
+    private static String text(Document doc, String name) {
+        NodeList nodes = doc.getElementsByTagName(name);
+        return nodes.getLength() == 0 ? null : nodes.item(0).getTextContent();
+    }
+
+    private static byte[] sha256(String value) {
+        try {
+            return MessageDigest.getInstance("SHA-256").digest(value.getBytes(StandardCharsets.UTF_8));
+        }
+        catch (GeneralSecurityException e) {
+            throw new IllegalStateException(e);
+        }
+    }
+
+    private static byte[] hmac(byte[] key, String value) {
+        try {
+            Mac mac = Mac.getInstance("HmacSHA256");
+            mac.init(new SecretKeySpec(key, "HmacSHA256"));
+            return mac.doFinal(value.getBytes(StandardCharsets.UTF_8));
+        }
+        catch (GeneralSecurityException e) {
+            throw new IllegalStateException(e);
+        }
+    }
+
+    private static String hex(byte[] bytes) {
+        StringBuilder sb = new StringBuilder(bytes.length * 2);
+        for (byte b : bytes) {
+            sb.append(HEX[(b >> 4) & 0xF]).append(HEX[b & 0xF]);
+        }
+        return sb.toString();
+    }
+}
@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package com.oracle.coherence.k8s;
+
+import java.io.IOException;
+import java.io.InputStream;
+import java.io.OutputStream;
+
+import java.nio.file.Files;
+import java.nio.file.Path;
+
+import java.util.List;
+
+import com.oracle.coherence.persistence.PersistenceManager;
+
+import com.tangosol.io.ReadBuffer;
+import com.tangosol.persistence.AbstractSnapshotArchiver;
+import com.tangosol.persistence.Snapshot;
+import com.tangosol.util.Base;
+
+/**
+ * A Coherence snapshot archiver that archives snapshots to an S3 compatible object store.
+ * <p>
+ * Each store of an archived snapshot is written to the bucket as an object with the key
+ * {@code <prefix>/<cluster>/<service>/<snapshot>/<store>}.
+ */
+public class S3SnapshotArchiver
+        extends AbstractSnapshotArchiver {
+
+    /**
+     * The key delimiter.
+     */
+    private static final String DELIMITER = "/";
+
+    /**
+     * The S3 client.
+     */
+    private final S3Client client;
+
+    /**
+     * The key prefix of all snapshots archived by this archiver.
+     */
+    private final String basePrefix;
+
+    /**
+     * Create a {@link S3SnapshotArchiver}.
+     *
+     * @param clusterName  the name of the cluster
+     * @param serviceName  the name of the service
+     * @param client       the S3 client
+     * @param prefix       the optional key prefix
+     */
+    public S3SnapshotArchiver(String clusterName, String serviceName, S3Client client, String prefix) {
+        super(clusterName, serviceName);
+        this.client = client;
+        String base = clusterName + DELIMITER + serviceName + DELIMITER;
+        if (prefix != null && !prefix.isBlank()) {
+            base = trimDelimiters(prefix) + DELIMITER + base;
+        }
+        this.basePrefix = base;
+    }
+
+    @Override
+    protected String[] listInternal() {
+        try {
+            return client.listCommonPrefixes(basePrefix, DELIMITER).stream()
+                    .map(p -> p.substring(basePrefix.length(), p.length() - DELIMITER.length()))
+                    .toArray(String[]::new);
+        }
+        catch (IOException e) {
+            throw Base.ensureRuntimeException(e, "Failed to list archived snapshots");
+        }
+    }
+
+    @Override
+    protected Snapshot getSnapshotInternal(String snapshot) {
+        try {
+            String prefix = snapshotPrefix(snapshot);
+            List<String> keys = client.listKeys(prefix);
+            if (keys.isEmpty()) {
+                return null;
+            }
+            String[] stores = keys.stream()
+                    .map(k -> k.substring(prefix.length()))
+                    .toArray(String[]::new);
+            return new Snapshot(snapshot, stores);
+        }
+        catch (IOException e) {
+            throw Base.ensureRuntimeException(e, "Failed to get archived snapshot " + snapshot);
+        }
+    }
+
+    @Override
+    protected boolean removeInternal(String snapshot) {
+        try {
+            List<String> keys = client.listKeys(snapshotPrefix(snapshot));
+            for (String key : keys) {
+                client.deleteObject(key);
+            }
+            return !keys.isEmpty();
+        }
+        catch (IOException e) {
+            throw Base.ensureRuntimeException(e, "Failed to remove archived snapshot " + snapshot);
+        }
+    }
+
+    @Override
+    protected void archiveInternal(Snapshot snapshot, PersistenceManager<ReadBuffer> mgr) {
+        String prefix = snapshotPrefix(snapshot.getName());
+        for (String store : snapshot.listStores()) {
+            Path file = null;
+            try {
+                // the store is written to a temporary file so that it is not held in memory while uploading
+                file = Files.createTempFile("coherence-snapshot-", ".store");
+                try (OutputStream out = Files.newOutputStream(file)) {
+                    mgr.write(store, out);
+                }
+                client.putObject(prefix + store, file);
+            }
+            catch (IOException e) {
+                throw Base.ensureRuntimeException(e, "Failed to archive store " + store + " of snapshot " + snapshot.getName());
+            }
+            finally {
+                deleteQuietly(file);
+            }
+        }
+    }
+
+    @Override
+    protected void retrieveInternal(Snapshot snapshot, PersistenceManager<ReadBuffer> mgr) {
+        String prefix = snapshotPrefix(snapshot.getName());
+        for (String store : snapshot.listStores()) {
+            try (InputStream in = client.getObject(prefix + store)) {
+                mgr.read(store, in);
+            }
+            catch (IOException e) {
+                throw Base.ensureRuntimeException(e, "Failed to retrieve store " + store + " of snapshot " + snapshot.getName());
+            }
+        }
+    }
+
+    /**
+     * Returns the key prefix of the stores of a snapshot.
+     *
+     * @param snapshot  the name of the snapshot
+     *
+     * @return the key prefix of the stores of the snapshot
+     */
+    String snapshotPrefix(String snapshot) {
+        return basePrefix + snapshot + DELIMITER;
+    }
+
+    private static String trimDelimiters(String s) {
+        String trimmed = s.trim();
+        while (trimmed.startsWith(DELIMITER)) {
+            trimmed = trimmed.substring(1);
+        }
+        while (trimmed.endsWith(DELIMITER)) {
+            trimmed = trimmed.substring(0, trimmed.length() - 1);
+        }
+        return trimmed;
+    }
+
+    private static void deleteQuietly(Path file) {
+        if (file != null) {
+            try {
+                Files.deleteIfExists(file);
+            }
+            catch (IOException e) {
+                // ignored
+            }
+        }
+    }
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package com.oracle.coherence.k8s;
+
+import java.net.URI;
+
+import com.tangosol.coherence.config.Config;
+import com.tangosol.persistence.SnapshotArchiver;
+import com.tangosol.persistence.SnapshotArchiverFactory;
+
+/**
+ * A {@link SnapshotArchiverFactory} that creates {@link S3SnapshotArchiver} instances.
+ * <p>
+ * The object store is configured using system properties set by the Operator runner and the
+ * credentials are read from environment variables populated from the credentials Secret.
+ */
+public class S3SnapshotArchiverFactory
+        implements SnapshotArchiverFactory {
+
+    /**
+     * The system property containing the object store endpoint.
+     */
+    public static final String PROP_ENDPOINT = "coherence.operator.archiver.s3.endpoint";
+
+    /**
+     * The system property containing the bucket name.
+     */
+    public static final String PROP_BUCKET = "coherence.operator.archiver.s3.bucket";
+
+    /**
+     * The system property containing the optional key prefix.
+     */
+    public static final String PROP_PREFIX = "coherence.operator.archiver.s3.prefix";
+
+    /**
+     * The system property containing the region.
+     */
+    public static final String PROP_REGION = "coherence.operator.archiver.s3.region";
+
+    /**
+     * The system property that enables path style requests.
+     */
+    public static final String PROP_PATH_STYLE = "coherence.operator.archiver.s3.path.style";
+
+    /**
+     * The environment variable containing the access key.
+     */
+    public static final String ENV_ACCESS_KEY = "COHERENCE_ARCHIVER_S3_ACCESS_KEY";
+
+    /**
+     * The environment variable containing the secret key.
+     */
+    public static final String ENV_SECRET_KEY = "COHERENCE_ARCHIVER_S3_SECRET_KEY";
+
+    /**
+     * The default region.
+     */
+    public static final String DEFAULT_REGION = "us-east-1";
+
+    @Override
+    public SnapshotArchiver createSnapshotArchiver(String clusterName, String serviceName) {
+        String endpoint = Config.getProperty(PROP_ENDPOINT);
+        String bucket = Config.getProperty(PROP_BUCKET);
+        if (endpoint == null || endpoint.isBlank() || bucket == null || bucket.isBlank()) {
+            throw new IllegalStateException("The S3 snapshot archiver is not configured, the "
+                    + PROP_ENDPOINT + " and " + PROP_BUCKET + " properties must be set");
+        }
+
+        S3Client client = new S3Client(URI.create(endpoint),
+                                       Config.getProperty(PROP_REGION, DEFAULT_REGION),
+                                       bucket,
+                                       Config.getBoolean(PROP_PATH_STYLE, true),
+                                       System.getenv(ENV_ACCESS_KEY),
+                                       System.getenv(ENV_SECRET_KEY));
+
+        return new S3SnapshotArchiver(clusterName, serviceName, client, Config.getProperty(PROP_PREFIX));
+    }
+}
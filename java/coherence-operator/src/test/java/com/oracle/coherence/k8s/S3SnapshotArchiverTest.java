@@ -0,0 +1,256 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package com.oracle.coherence.k8s;
+
+import java.io.IOException;
+import java.io.InputStream;
+import java.io.OutputStream;
+
+import java.net.InetSocketAddress;
+import java.net.URI;
+import java.net.URLDecoder;
+
+import java.nio.charset.StandardCharsets;
+
+import java.util.ArrayList;
+import java.util.HashMap;
+import java.util.List;
+import java.util.Map;
+import java.util.Set;
+import java.util.TreeSet;
+import java.util.concurrent.ConcurrentSkipListMap;
+
+import com.oracle.coherence.persistence.PersistenceManager;
+
+import com.tangosol.io.ReadBuffer;
+import com.tangosol.persistence.Snapshot;
+
+import com.sun.net.httpserver.HttpExchange;
+import com.sun.net.httpserver.HttpServer;
+
+import org.junit.jupiter.api.AfterEach;
+import org.junit.jupiter.api.BeforeEach;
+import org.junit.jupiter.api.Test;
+
+import static org.hamcrest.MatcherAssert.assertThat;
+import static org.hamcrest.Matchers.arrayContainingInAnyOrder;
+import static org.hamcrest.Matchers.containsInAnyOrder;
+import static org.hamcrest.Matchers.emptyArray;
+import static org.hamcrest.Matchers.is;
+import static org.hamcrest.Matchers.notNullValue;
+import static org.hamcrest.Matchers.nullValue;
+import static org.hamcrest.Matchers.startsWith;
+
+import static org.mockito.ArgumentMatchers.any;
+import static org.mockito.ArgumentMatchers.anyString;
+import static org.mockito.Mockito.doAnswer;
+import static org.mockito.Mockito.mock;
+
+/**
+ * Tests for {@link S3SnapshotArchiver} using an in-process stand-in for an S3 compatible
+ * object store such as MinIO.
+ */
+public class S3SnapshotArchiverTest {
+
+    private static final String BUCKET = "snapshots";
+
+    private FakeObjectStore store;
+
+    @BeforeEach
+    public void startStore() throws IOException {
+        store = new FakeObjectStore();
+    }
+
+    @AfterEach
+    public void stopStore() {
+        store.stop();
+    }
+
+    @Test
+    public void shouldArchiveAndRetrieveSnapshot() throws Exception {
+        S3SnapshotArchiver archiver = createArchiver("/backups/");
+
+        Map<String, byte[]> written = new HashMap<>();
+        written.put("store-1", "data-one".getBytes(StandardCharsets.UTF_8));
+        written.put("store-2", "data-two".getBytes(StandardCharsets.UTF_8));
+
+        archiver.archiveInternal(new Snapshot("snap", new String[] {"store-1", "store-2"}), writingManager(written));
+
+        assertThat(store.objects.keySet(), containsInAnyOrder("backups/test-cluster/PartitionedCache/snap/store-1",
+                                                              "backups/test-cluster/PartitionedCache/snap/store-2"));
+        assertThat(store.authorization, startsWith("AWS4-HMAC-SHA256 Credential=access/"));
+
+        assertThat(archiver.listInternal(), arrayContainingInAnyOrder("snap"));
+
+        Snapshot snapshot = archiver.getSnapshotInternal("snap");
+        assertThat(snapshot, is(notNullValue()));
+        assertThat(snapshot.listStores(), arrayContainingInAnyOrder("store-1", "store-2"));
+
+        Map<String, byte[]> read = new HashMap<>();
+        archiver.retrieveInternal(snapshot, readingManager(read));
+        assertThat(new String(read.get("store-1"), StandardCharsets.UTF_8), is("data-one"));
+        assertThat(new String(read.get("store-2"), StandardCharsets.UTF_8), is("data-two"));
+    }
+
+    @Test
+    public void shouldRemoveSnapshot() throws Exception {
+        S3SnapshotArchiver archiver = createArchiver(null);
+        Map<String, byte[]> written = Map.of("store-1", new byte[] {1, 2, 3});
+
+        archiver.archiveInternal(new Snapshot("one", new String[] {"store-1"}), writingManager(written));
+        archiver.archiveInternal(new Snapshot("two", new String[] {"store-1"}), writingManager(written));
+        assertThat(archiver.listInternal(), arrayContainingInAnyOrder("one", "two"));
+
+        assertThat(archiver.removeInternal("one"), is(true));
+        assertThat(archiver.removeInternal("one"), is(false));
+        assertThat(archiver.listInternal(), arrayContainingInAnyOrder("two"));
+        assertThat(archiver.getSnapshotInternal("one"), is(nullValue()));
+    }
+
+    @Test
+    public void shouldListNoSnapshots() {
+        assertThat(createArchiver(null).listInternal(), is(emptyArray()));
+    }
+
+    @Test
+    public void shouldEncodeKeys() {
+        assertThat(S3Client.encode("a b/c~d", false), is("a%20b/c~d"));
+        assertThat(S3Client.encode("a b/c~d", true), is("a%20b%2Fc~d"));
+    }
+
+    private S3SnapshotArchiver createArchiver(String prefix) {
+        S3Client client = new S3Client(store.endpoint(), "us-east-1", BUCKET, true, "access", "secret");
+        return new S3SnapshotArchiver("test-cluster", "PartitionedCache", client, prefix);
+    }
+
+    @SuppressWarnings("unchecked")
+    private PersistenceManager<ReadBuffer> writingManager(Map<String, byte[]> stores) throws IOException {
+        PersistenceManager<ReadBuffer> mgr = mock(PersistenceManager.class);
+        doAnswer(invocation -> {
+            OutputStream out = invocation.getArgument(1);
+            out.write(stores.get((String) invocation.getArgument(0)));
+            return null;
+        }).when(mgr).write(anyString(), any(OutputStream.class));
+        return mgr;
+    }
+
+    @SuppressWarnings("unchecked")
+    private PersistenceManager<ReadBuffer> readingManager(Map<String, byte[]> stores) throws IOException {
+        PersistenceManager<ReadBuffer> mgr = mock(PersistenceManager.class);
+        doAnswer(invocation -> {
+            InputStream in = invocation.getArgument(1);
+            stores.put(invocation.getArgument(0), in.readAllBytes());
+            return null;
+        }).when(mgr).read(anyString(), any(InputStream.class));
+        return mgr;
+    }
+
+    /**
+     * A minimal in-memory S3 compatible object store supporting a single bucket
+     * with path style put, get, delete and ListObjectsV2 requests.
+     */
+    private static class FakeObjectStore {
+        private final Map<String, byte[]> objects = new ConcurrentSkipListMap<>();
+
+        private final HttpServer server;
+
+        private volatile String authorization;
+
+        FakeObjectStore() throws IOException {
+            server = HttpServer.create(new InetSocketAddress("127.0.0.1", 0), 0);
+            server.createContext("/" + BUCKET, this::handle);
+            server.start();
+        }
+
+        URI endpoint() {
+            return URI.create("http://127.0.0.1:" + server.getAddress().getPort());
+        }
+
+        void stop() {
+            server.stop(0);
+        }
+
+        private void handle(HttpExchange exchange) throws IOException {
+            authorization = exchange.getRequestHeaders().getFirst("Authorization");
+            String path = exchange.getRequestURI().getPath();
+            String key = path.length() > BUCKET.length() + 2 ? path.substring(BUCKET.length() + 2) : "";
+            Map<String, String> query = query(exchange.getRequestURI().getRawQuery());
+
+            switch (exchange.getRequestMethod()) {
+            case "PUT":
+                objects.put(key, exchange.getRequestBody().readAllBytes());
+                respond(exchange, 200, new byte[0]);
+                break;
+            case "DELETE":
+                objects.remove(key);
+                respond(exchange, 204, null);
+                break;
+            case "GET":
+                if ("2".equals(query.get("list-type"))) {
+                    respond(exchange, 200, list(query.getOrDefault("prefix", ""), query.get("delimiter")));
+                }
+                else if (objects.containsKey(key)) {
+                    respond(exchange, 200, objects.get(key));
+                }
+                else {
+                    respond(exchange, 404, "<Error><Code>NoSuchKey</Code></Error>".getBytes(StandardCharsets.UTF_8));
+                }
+                break;
+            default:
+                respond(exchange, 405, new byte[0]);
+            }
+        }
+
+        private byte[] list(String prefix, String delimiter) {
+            List<String> keys = new ArrayList<>();
+            Set<String> prefixes = new TreeSet<>();
+            for (String key : objects.keySet()) {
+                if (!key.startsWith(prefix)) {
+                    continue;
+                }
+                int index = delimiter == null ? -1 : key.indexOf(delimiter, prefix.length());
+                if (index >= 0) {
+                    prefixes.add(key.substring(0, index + delimiter.length()));
+                }
+                else {
+                    keys.add(key);
+                }
+            }
+
+            StringBuilder xml = new StringBuilder("<ListBucketResult><IsTruncated>false</IsTruncated>");
+            keys.forEach(k -> xml.append("<Contents><Key>").append(k).append("</Key></Contents>"));
+            prefixes.forEach(p -> xml.append("<CommonPrefixes><Prefix>").append(p).append("</Prefix></CommonPrefixes>"));
+            xml.append("</ListBucketResult>");
+            return xml.toString().getBytes(StandardCharsets.UTF_8);
+        }
+
+        private static Map<String, String> query(String rawQuery) {
+            Map<String, String> map = new HashMap<>();
+            if (rawQuery != null) {
+                for (String param : rawQuery.split("&")) {
+                    String[] parts = param.split("=", 2);
+                    map.put(URLDecoder.decode(parts[0], StandardCharsets.UTF_8),
+                            parts.length > 1 ? URLDecoder.decode(parts[1], StandardCharsets.UTF_8) : "");
+                }
+            }
+            return map;
+        }
+
+        private static void respond(HttpExchange exchange, int status, byte[] body) throws IOException {
+            if (body == null) {
+                exchange.sendResponseHeaders(status, -1);
+            }
+            else {
+                exchange.sendResponseHeaders(status, body.length == 0 ? -1 : body.length);
+                try (OutputStream out = exchange.getResponseBody()) {
+                    out.write(body);
+                }
+            }
+            exchange.close();
+        }
+    }
+}
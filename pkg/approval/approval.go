@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+// Package approval contains the client used to request the approval of disruptive
+// rolling upgrade and scaling steps from an external approval webhook.
+package approval
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// maxResponseSize is the maximum size of an approval response that will be read.
+	maxResponseSize = 64 * 1024
+	// maxRedirects is the maximum number of redirects that will be followed.
+	maxRedirects = 10
+)
+
+// Request is the JSON body POSTed to an approval webhook.
+type Request struct {
+	// Resource is the Coherence resource that the action will be performed on.
+	Resource Resource `json:"resource"`
+	// Action is the action that will be performed, either DeletePods or Scale.
+	Action coh.ApprovalAction `json:"action"`
+	// Pods are the Pods that the action will delete or, when scaling down, remove.
+	Pods []Pod `json:"pods,omitempty"`
+	// Inputs are the inputs of the action, for example the current and desired replicas of a scale.
+	Inputs map[string]string `json:"inputs,omitempty"`
+}
+
+// Resource identifies the Coherence resource in an approval request.
+type Resource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+	Generation int64  `json:"generation,omitempty"`
+}
+
+// Pod identifies a Pod in an approval request.
+type Pod struct {
+	Name     string `json:"name"`
+	Node     string `json:"node,omitempty"`
+	Revision string `json:"revision,omitempty"`
+}
+
+// Response is the JSON body returned by an approval webhook.
+type Response struct {
+	// Decision is the decision, one of Approve, Deny or Defer.
+	Decision coh.ApprovalDecision `json:"decision"`
+	// Reason is the reason for the decision.
+	Reason string `json:"reason,omitempty"`
+	// RetryAfterSeconds is the optional number of seconds to wait before requesting approval
+	// of a deferred action again.
+	RetryAfterSeconds *int32 `json:"retryAfterSeconds,omitempty"`
+}
+
+// IsApproved returns true if the action is approved.
+func (in Response) IsApproved() bool {
+	return in.Decision == coh.ApprovalDecisionApprove
+}
+
+// GetRetryAfter returns how long to wait before requesting approval of a deferred action again.
+func (in Response) GetRetryAfter(spec *coh.ApprovalWebhookSpec) time.Duration {
+	if in.RetryAfterSeconds != nil && *in.RetryAfterSeconds > 0 {
+		return time.Duration(*in.RetryAfterSeconds) * time.Second
+	}
+	return spec.GetRetryAfter()
+}
+
+// NewRequest creates an approval request for an action on a Coherence resource.
+func NewRequest(resource coh.CoherenceResource, action coh.ApprovalAction, pods []corev1.Pod, inputs map[string]string) Request {
+	kind := coh.ResourceTypeCoherence
+	if resource.GetType() == coh.CoherenceTypeJob {
+		kind = coh.ResourceTypeCoherenceJob
+	}
+	request := Request{
+		Resource: Resource{
+			APIVersion: coh.GroupVersion.String(),
+			Kind:       kind.Name(),
+			Namespace:  resource.GetNamespace(),
+			Name:       resource.GetName(),
+			Generation: resource.GetGeneration(),
+		},
+		Action: action,
+		Inputs: inputs,
+	}
+	for _, pod := range pods {
+		request.Pods = append(request.Pods, Pod{
+			Name:     pod.Name,
+			Node:     pod.Spec.NodeName,
+			Revision: pod.Labels["controller-revision-hash"],
+		})
+	}
+	return request
+}
+
+// NewHTTPClient creates the http client used to call an approval webhook. Requests time out after the
+// timeout of the spec. The certificate of an https endpoint is verified using the CA bundle of the spec,
+// if one is set, otherwise using the system trust roots. If the spec only allows https, redirects to
+// any other scheme are refused.
+func NewHTTPClient(spec *coh.ApprovalWebhookSpec) (*http.Client, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if spec != nil && len(spec.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(spec.CABundle) {
+			return nil, errors.New("the approval webhook CA bundle does not contain any PEM encoded certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	httpsOnly := spec.IsHTTPSOnly()
+	return &http.Client{
+		Transport: transport,
+		Timeout:   spec.GetTimeout(),
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if httpsOnly && req.URL.Scheme != "https" {
+				return fmt.Errorf("the approval webhook redirected to %s which is not an https URL", req.URL.Redacted())
+			}
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("the approval webhook redirected more than %d times", maxRedirects)
+			}
+			return nil
+		},
+	}, nil
+}
+
+// GetBearerToken returns the bearer token to send to an approval webhook, read from the Secret configured
+// in the spec, in the specified namespace. An empty token is returned if no Secret is configured.
+func GetBearerToken(ctx context.Context, c client.Reader, namespace string, spec *coh.ApprovalWebhookSpec) (string, error) {
+	if spec == nil || spec.BearerTokenSecret == nil {
+		return "", nil
+	}
+	ref := spec.BearerTokenSecret
+	if c == nil {
+		return "", fmt.Errorf("cannot read the approval webhook bearer token Secret %s", ref.Name)
+	}
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+		return "", errors.Wrapf(err, "reading the approval webhook bearer token Secret %s", ref.Name)
+	}
+	token, found := secret.Data[ref.Key]
+	if !found || len(token) == 0 {
+		return "", fmt.Errorf("the approval webhook bearer token Secret %s does not contain the key %s", ref.Name, ref.Key)
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+// Call POSTs an approval request to the approval webhook, sending the token, if not empty, as a bearer
+// token. If the http client is nil a client is created for the spec. An action is never approved if the
+// webhook cannot be called, or returns an invalid response, in that case the action is deferred and
+// the reason is the error.
+func Call(ctx context.Context, cl *http.Client, spec *coh.ApprovalWebhookSpec, token string, request Request) Response {
+	response, err := call(ctx, cl, spec, token, request)
+	if err != nil {
+		return Response{Decision: coh.ApprovalDecisionDefer, Reason: err.Error()}
+	}
+	return response
+}
+
+func call(ctx context.Context, cl *http.Client, spec *coh.ApprovalWebhookSpec, token string, request Request) (Response, error) {
+	var response Response
+	if spec == nil || spec.URL == "" {
+		return response, errors.New("no approval webhook URL is configured")
+	}
+	if u, err := url.Parse(spec.URL); err != nil {
+		return response, errors.Wrap(err, "parsing approval webhook URL")
+	} else if spec.IsHTTPSOnly() && u.Scheme != "https" {
+		return response, fmt.Errorf("the approval webhook URL %s is not an https URL", u.Redacted())
+	}
+	if cl == nil {
+		var err error
+		if cl, err = NewHTTPClient(spec); err != nil {
+			return response, err
+		}
+		defer cl.CloseIdleConnections()
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return response, errors.Wrap(err, "creating approval request")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, spec.URL, bytes.NewReader(body))
+	if err != nil {
+		return response, errors.Wrap(err, "creating approval request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := cl.Do(req)
+	if err != nil {
+		return response, errors.Wrap(err, "calling approval webhook")
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return response, errors.Wrap(err, "reading approval response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return response, fmt.Errorf("approval webhook returned status %d", resp.StatusCode)
+	}
+	if err = json.Unmarshal(data, &response); err != nil {
+		return response, errors.Wrap(err, "parsing approval response")
+	}
+
+	switch response.Decision {
+	case coh.ApprovalDecisionApprove, coh.ApprovalDecisionDeny, coh.ApprovalDecisionDefer:
+		return response, nil
+	default:
+		return response, fmt.Errorf("approval webhook returned an invalid decision %q", response.Decision)
+	}
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package approval_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/pkg/approval"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestApprovalRequest(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var received approval.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		g.Expect(r.Method).To(Equal(http.MethodPost))
+		g.Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+		g.Expect(json.NewDecoder(r.Body).Decode(&received)).To(Succeed())
+		_, _ = w.Write([]byte(`{"decision":"Approve","reason":"change CHG-1234 is open"}`))
+	}))
+	defer server.Close()
+
+	deployment := &coh.Coherence{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "storage", Generation: 3}}
+	pods := []corev1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{Name: "storage-1", Labels: map[string]string{"controller-revision-hash": "storage-2222"}},
+		Spec:       corev1.PodSpec{NodeName: "node-1"},
+	}}
+	inputs := map[string]string{"revision": "storage-2222"}
+	request := approval.NewRequest(deployment, coh.ApprovalActionDeletePods, pods, inputs)
+
+	response := approval.Call(context.Background(), nil, &coh.ApprovalWebhookSpec{URL: server.URL}, "", request)
+	g.Expect(response.IsApproved()).To(BeTrue())
+	g.Expect(response.Reason).To(Equal("change CHG-1234 is open"))
+
+	g.Expect(received.Resource).To(Equal(approval.Resource{
+		APIVersion: "coherence.oracle.com/v1",
+		Kind:       "Coherence",
+		Namespace:  "test",
+		Name:       "storage",
+		Generation: 3,
+	}))
+	g.Expect(received.Action).To(Equal(coh.ApprovalActionDeletePods))
+	g.Expect(received.Pods).To(Equal([]approval.Pod{{Name: "storage-1", Node: "node-1", Revision: "storage-2222"}}))
+	g.Expect(received.Inputs).To(Equal(inputs))
+}
+
+func TestApprovalDeniedAndDeferred(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	reply := `{"decision":"Deny","reason":"change freeze"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(reply))
+	}))
+	defer server.Close()
+
+	spec := &coh.ApprovalWebhookSpec{URL: server.URL, RetryAfter: &metav1.Duration{Duration: 5 * time.Minute}}
+	request := approval.Request{Action: coh.ApprovalActionScale}
+
+	response := approval.Call(context.Background(), nil, spec, "", request)
+	g.Expect(response.IsApproved()).To(BeFalse())
+	g.Expect(response.Decision).To(Equal(coh.ApprovalDecisionDeny))
+	g.Expect(response.Reason).To(Equal("change freeze"))
+
+	reply = `{"decision":"Defer","reason":"waiting for sign-off"}`
+	response = approval.Call(context.Background(), nil, spec, "", request)
+	g.Expect(response.Decision).To(Equal(coh.ApprovalDecisionDefer))
+	g.Expect(response.GetRetryAfter(spec)).To(Equal(5 * time.Minute))
+
+	reply = `{"decision":"Defer","retryAfterSeconds":30}`
+	response = approval.Call(context.Background(), nil, spec, "", request)
+	g.Expect(response.Decision).To(Equal(coh.ApprovalDecisionDefer))
+	g.Expect(response.GetRetryAfter(spec)).To(Equal(30 * time.Second))
+}
+
+func TestApprovalDeferredOnFailure(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	status := http.StatusInternalServerError
+	reply := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(reply))
+	}))
+	defer server.Close()
+
+	spec := &coh.ApprovalWebhookSpec{URL: server.URL}
+	request := approval.Request{Action: coh.ApprovalActionScale}
+
+	response := approval.Call(context.Background(), nil, spec, "", request)
+	g.Expect(response.Decision).To(Equal(coh.ApprovalDecisionDefer))
+	g.Expect(response.Reason).To(ContainSubstring("status 500"))
+	g.Expect(response.GetRetryAfter(spec)).To(Equal(coh.DefaultApprovalRetryAfter))
+
+	status = http.StatusOK
+	reply = `{"decision":"Maybe"}`
+	response = approval.Call(context.Background(), nil, spec, "", request)
+	g.Expect(response.Decision).To(Equal(coh.ApprovalDecisionDefer))
+	g.Expect(response.Reason).To(ContainSubstring("invalid decision"))
+
+	reply = `not json`
+	response = approval.Call(context.Background(), nil, spec, "", request)
+	g.Expect(response.Decision).To(Equal(coh.ApprovalDecisionDefer))
+
+	response = approval.Call(context.Background(), nil, &coh.ApprovalWebhookSpec{}, "", request)
+	g.Expect(response.Decision).To(Equal(coh.ApprovalDecisionDefer))
+}
+
+func TestApprovalWithCABundleAndBearerToken(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var authorization string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{"decision":"Approve"}`))
+	}))
+	defer server.Close()
+
+	request := approval.Request{Action: coh.ApprovalActionScale}
+
+	// the server certificate is not trusted without the CA bundle
+	spec := &coh.ApprovalWebhookSpec{URL: server.URL, HTTPSOnly: ptr.To(true)}
+	response := approval.Call(context.Background(), nil, spec, "", request)
+	g.Expect(response.Decision).To(Equal(coh.ApprovalDecisionDefer))
+	g.Expect(response.Reason).To(ContainSubstring("certificate"))
+
+	spec.CABundle = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	response = approval.Call(context.Background(), nil, spec, "secret-token", request)
+	g.Expect(response.IsApproved()).To(BeTrue())
+	g.Expect(authorization).To(Equal("Bearer secret-token"))
+
+	spec.CABundle = []byte("not a certificate")
+	response = approval.Call(context.Background(), nil, spec, "", request)
+	g.Expect(response.Decision).To(Equal(coh.ApprovalDecisionDefer))
+	g.Expect(response.Reason).To(ContainSubstring("CA bundle"))
+}
+
+func TestApprovalHTTPSOnly(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		_, _ = w.Write([]byte(`{"decision":"Approve"}`))
+	}))
+	defer server.Close()
+
+	spec := &coh.ApprovalWebhookSpec{URL: server.URL, HTTPSOnly: ptr.To(true)}
+	response := approval.Call(context.Background(), nil, spec, "", approval.Request{Action: coh.ApprovalActionScale})
+	g.Expect(response.Decision).To(Equal(coh.ApprovalDecisionDefer))
+	g.Expect(response.Reason).To(ContainSubstring("not an https URL"))
+	g.Expect(called).To(BeFalse())
+
+	// an https endpoint cannot redirect to an http endpoint
+	redirect := httptest.NewTLSServer(http.RedirectHandler(server.URL, http.StatusTemporaryRedirect))
+	defer redirect.Close()
+
+	spec.URL = redirect.URL
+	spec.CABundle = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: redirect.Certificate().Raw})
+	response = approval.Call(context.Background(), nil, spec, "", approval.Request{Action: coh.ApprovalActionScale})
+	g.Expect(response.Decision).To(Equal(coh.ApprovalDecisionDefer))
+	g.Expect(response.Reason).To(ContainSubstring("not an https URL"))
+	g.Expect(called).To(BeFalse())
+}
+
+func TestGetBearerToken(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "approval"},
+		Data:       map[string][]byte{"token": []byte("secret-token\n")},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	token, err := approval.GetBearerToken(ctx, c, "test", &coh.ApprovalWebhookSpec{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(token).To(BeEmpty())
+
+	spec := &coh.ApprovalWebhookSpec{BearerTokenSecret: &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "approval"},
+		Key:                  "token",
+	}}
+	token, err = approval.GetBearerToken(ctx, c, "test", spec)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(token).To(Equal("secret-token"))
+
+	spec.BearerTokenSecret.Key = "missing"
+	_, err = approval.GetBearerToken(ctx, c, "test", spec)
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = approval.GetBearerToken(ctx, c, "other", spec)
+	g.Expect(err).To(HaveOccurred())
+}
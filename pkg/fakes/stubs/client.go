@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+// Package stubs contains fake clients, test resources and stub Coherence endpoints for unit tests.
+// Unlike the fakes package it does not depend on the controllers, so it can also be used by the
+// tests inside the controller packages.
+package stubs
+
+import (
+	"github.com/go-logr/logr"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/status"
+	"github.com/oracle/coherence-operator/pkg/patching"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// NewScheme returns a scheme with the Kubernetes and Coherence types registered.
+func NewScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(coh.AddToScheme(scheme))
+	return scheme
+}
+
+// NewClient returns a fake client containing a Coherence or CoherenceJob resource, whose status
+// can be updated, and any other objects.
+func NewClient(resource client.Object, objs ...client.Object) client.Client {
+	return fake.NewClientBuilder().
+		WithScheme(NewScheme()).
+		WithObjects(append([]client.Object{resource}, objs...)...).
+		WithStatusSubresource(resource).
+		Build()
+}
+
+// NewStatusManager returns a status.StatusManager that updates the status of resources using the specified client.
+func NewStatusManager(c client.Client) *status.StatusManager {
+	return &status.StatusManager{
+		Client:  c,
+		Log:     logr.Discard(),
+		Patcher: patching.NewResourcePatcher(NewManager(c, nil), logr.Discard(), types.MergePatchType),
+	}
+}
+
+// Manager is a manager.Manager that only provides a client, its scheme and an event recorder.
+// Calling any other method panics.
+type Manager struct {
+	manager.Manager
+	Client client.Client
+	Events events.EventRecorder
+}
+
+// NewManager returns a Manager using the specified client and event recorder.
+func NewManager(c client.Client, recorder events.EventRecorder) *Manager {
+	return &Manager{Client: c, Events: recorder}
+}
+
+func (in *Manager) GetClient() client.Client                     { return in.Client }
+func (in *Manager) GetScheme() *runtime.Scheme                   { return in.Client.Scheme() }
+func (in *Manager) GetEventRecorder(string) events.EventRecorder { return in.Events }
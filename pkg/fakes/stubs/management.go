@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package stubs
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/pkg/operator"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// ManagementMembersPath is the Coherence management over REST path of the cluster members.
+	ManagementMembersPath = "/management/coherence/cluster/members"
+	// ManagementServicesPath is the Coherence management over REST path of the cluster services.
+	ManagementServicesPath = "/management/coherence/cluster/services"
+)
+
+// ManagementPartitionPath returns the Coherence management over REST path of the partition data of a service.
+func ManagementPartitionPath(service string) string {
+	return ManagementServicesPath + "/" + service + "/partition"
+}
+
+// ManagementServer is a stub Coherence management over REST endpoint. Each path returns the response
+// set for it, any other path returns the default status, which is initially http.StatusNotFound.
+type ManagementServer struct {
+	*httptest.Server
+	// Host is the host name of the server.
+	Host string
+	// Port is the port of the server.
+	Port int32
+
+	lock          sync.Mutex
+	responses     map[string]managementResponse
+	requests      map[string]int
+	defaultStatus int
+}
+
+type managementResponse struct {
+	status int
+	body   string
+}
+
+// NewManagementServer starts a stub Coherence management over REST endpoint that is closed when the test ends.
+func NewManagementServer(t testing.TB) *ManagementServer {
+	s := &ManagementServer{
+		responses:     make(map[string]managementResponse),
+		requests:      make(map[string]int),
+		defaultStatus: http.StatusNotFound,
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+
+	host, port, err := net.SplitHostPort(s.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Host = host
+	s.Port = int32(p)
+	return s
+}
+
+// SetResponse sets the status and JSON body returned for a path.
+func (in *ManagementServer) SetResponse(path string, status int, body string) {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+	in.responses[path] = managementResponse{status: status, body: body}
+}
+
+// SetDefaultStatus sets the status returned for a path without a response.
+func (in *ManagementServer) SetDefaultStatus(status int) {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+	in.defaultStatus = status
+}
+
+// Requests returns the number of requests made to a path.
+func (in *ManagementServer) Requests(path string) int {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+	return in.requests[path]
+}
+
+func (in *ManagementServer) serve(w http.ResponseWriter, r *http.Request) {
+	in.lock.Lock()
+	in.requests[r.URL.Path]++
+	response, found := in.responses[r.URL.Path]
+	status := in.defaultStatus
+	in.lock.Unlock()
+
+	if !found {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.status)
+	_, _ = w.Write([]byte(response.body))
+}
+
+// NewManagedCoherence returns a Coherence resource named storage in the test namespace with management
+// enabled, its StatefulSet and a single ready Pod whose management endpoint is the specified server.
+func NewManagedCoherence(server *ManagementServer) (*coh.Coherence, *appsv1.StatefulSet, *corev1.Pod) {
+	deployment := NewCoherence(coh.CoherenceStatefulSetResourceSpec{
+		CoherenceResourceSpec: coh.CoherenceResourceSpec{
+			Replicas: ptr.To(int32(1)),
+			Coherence: &coh.CoherenceSpec{
+				Management: &coh.PortSpecWithSSL{Enabled: ptr.To(true)},
+			},
+		},
+	})
+
+	pod := NewPod(deployment, 0)
+	pod.Labels[operator.LabelTestHostName] = server.Host
+	pod.Spec.Containers[0].Ports = []corev1.ContainerPort{{Name: coh.PortNameManagement, ContainerPort: server.Port}}
+	return deployment, NewStatefulSet(deployment, 1), pod
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package stubs
+
+import (
+	"fmt"
+
+	coh "github.com/oracle/coherence-operator/api/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// Namespace is the namespace of the test resources.
+	Namespace = "test"
+	// Name is the name of the test Coherence resource and its StatefulSet.
+	Name = "storage"
+)
+
+// NewCoherence returns a Coherence resource named storage in the test namespace with the specified spec.
+func NewCoherence(spec coh.CoherenceStatefulSetResourceSpec) *coh.Coherence {
+	return &coh.Coherence{
+		ObjectMeta: metav1.ObjectMeta{Namespace: Namespace, Name: Name},
+		Spec:       spec,
+	}
+}
+
+// NewStatefulSet returns the StatefulSet of a deployment with the specified number of replicas, all of which
+// are ready and at the current revision. The Pods of the StatefulSet are selected by the deployment label.
+func NewStatefulSet(deployment coh.CoherenceResource, replicas int32) *appsv1.StatefulSet {
+	selector := map[string]string{coh.LabelCoherenceDeployment: deployment.GetName()}
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: deployment.GetNamespace(), Name: deployment.GetName()},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To(replicas),
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: selector}},
+		},
+		Status: appsv1.StatefulSetStatus{
+			Replicas:        replicas,
+			ReadyReplicas:   replicas,
+			CurrentReplicas: replicas,
+		},
+	}
+}
+
+// NewPod returns the running and ready Pod of a deployment's StatefulSet with the specified ordinal.
+func NewPod(deployment coh.CoherenceResource, ordinal int) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: deployment.GetNamespace(),
+			Name:      fmt.Sprintf("%s-%d", deployment.GetName(), ordinal),
+			Labels:    map[string]string{coh.LabelCoherenceDeployment: deployment.GetName()},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: coh.ContainerNameCoherence}},
+		},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package management
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// RequestTimeout is the timeout for a single Coherence management over REST request.
+	RequestTimeout = time.Second * 30
+	// ReconcileRequestTimeout is the timeout for a single Coherence management over REST request
+	// made inline in a reconcile, which must not block other resources from being reconciled.
+	// Such requests should also be made with SingleAttempt, the reconcile is retried later anyway.
+	ReconcileRequestTimeout = time.Second * 2
+)
+
+// GetHTTPClient returns the http client to use to call Coherence management over REST,
+// which is the specified client if it is not nil, otherwise a client with the specified timeout.
+func GetHTTPClient(cl *http.Client, timeout time.Duration) *http.Client {
+	if cl != nil {
+		return cl
+	}
+	return &http.Client{Timeout: timeout}
+}
+
+// CheckResponse returns an error if a Coherence management over REST request failed,
+// either with an error or with a status other than 200, 202 or 204.
+func CheckResponse(op string, status int, err error) error {
+	switch {
+	case err != nil:
+		return errors.Wrapf(err, "failed to %s", op)
+	case status != http.StatusOK && status != http.StatusAccepted && status != http.StatusNoContent:
+		return fmt.Errorf("failed to %s, management request returned status %d", op, status)
+	default:
+		return nil
+	}
+}
@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2020, 2025, Oracle and/or its affiliates.
+ * Copyright (c) 2020, 2026, Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * http://oss.oracle.com/licenses/upl.
  */
@@ -12,6 +12,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"sort"
 	"time"
 )
 
@@ -24,8 +26,30 @@ const (
 	servicesFormat = "http://%s:%d/management/coherence/cluster/services"
 	// The URL pattern for Coherence management partition assignment query.
 	partitionFormat = "http://%s:%d/management/coherence/cluster/services/%s/partition"
+	// The URL pattern for Coherence management caches query.
+	cachesFormat = "http://%s:%d/management/coherence/cluster/caches?fields=name,service,tier,size"
 )
 
+const (
+	// HAStatusCodeEndangered is the partition HA status code when data may be lost if a member fails.
+	HAStatusCodeEndangered = 0
+	// HAStatusCodeNodeSafe is the partition HA status code when data is safe if any single member fails.
+	HAStatusCodeNodeSafe = 1
+	// HAStatusCodeMachineSafe is the partition HA status code when data is safe if any single machine fails.
+	HAStatusCodeMachineSafe = 2
+	// HAStatusCodeRackSafe is the partition HA status code when data is safe if any single rack fails.
+	HAStatusCodeRackSafe = 3
+	// HAStatusCodeSiteSafe is the partition HA status code when data is safe if any single site fails.
+	HAStatusCodeSiteSafe = 4
+)
+
+// partitionedServiceTypes are the types of Coherence service that have partition assignment data.
+var partitionedServiceTypes = map[string]bool{
+	"DistributedCache": true,
+	"FederatedCache":   true,
+	"PagedTopic":       true,
+}
+
 // RestData is a struct to use to hold the results of a generic Coherence management REST query.
 type RestData struct {
 	Links []map[string]string
@@ -72,6 +96,19 @@ type PartitionData struct {
 	ServiceNodeCount           int                 `json:"serviceNodeCount"`
 }
 
+// IsEndangered returns true if the service has backups configured but is not at least NODE-SAFE.
+// A service without backups can never be NODE-SAFE so is not treated as endangered.
+func (in *PartitionData) IsEndangered() bool {
+	return in != nil && in.BackupCount > 0 && in.HAStatusCode < HAStatusCodeNodeSafe
+}
+
+// ServicePartitionData is the partition assignment data of a partitioned service.
+type ServicePartitionData struct {
+	Name string
+	Type string
+	PartitionData
+}
+
 // MembersData is a struct to use to hold the results of a Coherence management REST members query
 // http://localhost:30000/management/coherence/cluster/members
 type MembersData struct {
@@ -84,16 +121,36 @@ type MembersData struct {
 // This structure only contains a sub-set of the fields available in the response json. If other
 // fields are required they should be added to this struct.
 type MemberData struct {
-	Links        []map[string]string `json:"Links"`
-	SiteName     string              `json:"siteName"`
-	RackName     string              `json:"rackName"`
-	MachineName  string              `json:"machineName"`
-	MachineID    int                 `json:"machineId"`
-	MemberName   string              `json:"memberName"`
-	RoleName     string              `json:"roleName"`
-	ID           int                 `json:"id"`
-	NodeID       string              `json:"nodeId"`
-	LoggingLevel int                 `json:"loggingLevel"`
+	Links             []map[string]string `json:"Links"`
+	SiteName          string              `json:"siteName"`
+	RackName          string              `json:"rackName"`
+	MachineName       string              `json:"machineName"`
+	MachineID         int                 `json:"machineId"`
+	MemberName        string              `json:"memberName"`
+	RoleName          string              `json:"roleName"`
+	ID                int                 `json:"id"`
+	NodeID            string              `json:"nodeId"`
+	LoggingLevel      int                 `json:"loggingLevel"`
+	MemoryMaxMB       int                 `json:"memoryMaxMB"`
+	MemoryAvailableMB int                 `json:"memoryAvailableMB"`
+}
+
+// CachesData is a struct to use to hold the results of a Coherence management REST caches query
+// http://localhost:30000/management/coherence/cluster/caches
+type CachesData struct {
+	Links []map[string]string `json:"Links"`
+	Items []CacheData
+}
+
+// CacheData is a struct to use to hold the results of a Coherence management REST cache query.
+// This structure only contains a sub-set of the fields available in the response json. If other
+// fields are required they should be added to this struct.
+type CacheData struct {
+	Links   []map[string]string `json:"Links"`
+	Name    string              `json:"name"`
+	Service string              `json:"service"`
+	Tier    string              `json:"tier"`
+	Size    int64               `json:"size"`
 }
 
 // GetCluster performs a Management over REST cluster query http://localhost:30000/management/coherence/cluster
@@ -107,44 +164,109 @@ func GetCluster(cl *http.Client, host string, port int32) (*ClusterData, int, er
 
 // GetMembers performs a Management over REST members query http://localhost:30000/management/coherence/cluster/members
 // and return the results, the http response status and any error.
-func GetMembers(cl *http.Client, host string, port int32) (*MembersData, int, error) {
+func GetMembers(cl *http.Client, host string, port int32, opts ...QueryOption) (*MembersData, int, error) {
 	url := fmt.Sprintf(membersFormat, host, port)
 	data := &MembersData{}
-	status, err := query(cl, url, data)
+	status, err := query(cl, url, data, opts...)
 	return data, status, err
 }
 
 // GetServices perform a Management over REST members query http://localhost:30000/management/coherence/cluster/services
 // and return the results, the http response status and any error.
-func GetServices(cl *http.Client, host string, port int32) (*ServicesData, int, error) {
+func GetServices(cl *http.Client, host string, port int32, opts ...QueryOption) (*ServicesData, int, error) {
 	url := fmt.Sprintf(servicesFormat, host, port)
 	data := &ServicesData{}
-	status, err := query(cl, url, data)
+	status, err := query(cl, url, data, opts...)
 	return data, status, err
 }
 
 // GetPartitionAssignment performs a Management over REST members query http://localhost:30000/management/coherence/cluster/services/%s/partition
 // and return the results, the http response status and any error.
-func GetPartitionAssignment(cl *http.Client, host string, port int32, service string) (*PartitionData, int, error) {
-	url := fmt.Sprintf(partitionFormat, host, port, service)
+func GetPartitionAssignment(cl *http.Client, host string, port int32, service string, opts ...QueryOption) (*PartitionData, int, error) {
+	url := fmt.Sprintf(partitionFormat, host, port, url.PathEscape(service))
 	data := &PartitionData{}
-	status, err := query(cl, url, data)
+	status, err := query(cl, url, data, opts...)
+	return data, status, err
+}
+
+// IsPartitionedServiceType returns true if the Coherence service type is a partitioned service type.
+func IsPartitionedServiceType(serviceType string) bool {
+	return partitionedServiceTypes[serviceType]
+}
+
+// GetServicesPartitionData performs a Management over REST services query and then a partition assignment
+// query for each partitioned service, returning the results sorted by service name, the http response status
+// of the first failed request, or of the last request if all succeeded, and any error.
+func GetServicesPartitionData(cl *http.Client, host string, port int32, opts ...QueryOption) ([]ServicePartitionData, int, error) {
+	services, status, err := GetServices(cl, host, port, opts...)
+	if err != nil || status != http.StatusOK {
+		return nil, status, err
+	}
+
+	names := make(map[string]string)
+	for _, svc := range services.Items {
+		if IsPartitionedServiceType(svc.Type) {
+			names[svc.Name] = svc.Type
+		}
+	}
+
+	var data []ServicePartitionData
+	for name, serviceType := range names {
+		pd, pdStatus, err := GetPartitionAssignment(cl, host, port, name, opts...)
+		if err != nil || pdStatus != http.StatusOK {
+			return nil, pdStatus, err
+		}
+		data = append(data, ServicePartitionData{Name: name, Type: serviceType, PartitionData: *pd})
+		status = pdStatus
+	}
+	sort.Slice(data, func(i, j int) bool { return data[i].Name < data[j].Name })
+	return data, status, nil
+}
+
+// GetCaches performs a Management over REST caches query http://localhost:30000/management/coherence/cluster/caches
+// and return the results, the http response status and any error.
+func GetCaches(cl *http.Client, host string, port int32, opts ...QueryOption) (*CachesData, int, error) {
+	url := fmt.Sprintf(cachesFormat, host, port)
+	data := &CachesData{}
+	status, err := query(cl, url, data, opts...)
 	return data, status, err
 }
 
+// QueryOption configures how a Management over REST query is performed.
+type QueryOption func(*queryOptions)
+
+type queryOptions struct {
+	attempts int
+}
+
+// SingleAttempt makes a single attempt at a Management over REST query. By default a query that fails
+// to connect is attempted up to five times, one second apart, which is too slow for a caller that must
+// not block, such as a reconcile that is retried later anyway.
+func SingleAttempt() QueryOption {
+	return func(o *queryOptions) {
+		o.attempts = 1
+	}
+}
+
 // query performs a Management over REST query and parse the json response if the response code is 200
 // returning the response code any any error.
-func query(cl *http.Client, url string, v interface{}) (int, error) {
+func query(cl *http.Client, url string, v interface{}, opts ...QueryOption) (int, error) {
 	var response *http.Response
 	var err error
 
-	// re-try a max of 5 times
-	for i := 0; i < 5; i++ {
+	// re-try a max of 5 times, unless configured otherwise
+	o := queryOptions{attempts: 5}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	for i := 0; i < o.attempts; i++ {
+		if i > 0 {
+			time.Sleep(1 * time.Second)
+		}
 		response, err = cl.Get(url)
 		if err == nil {
 			break
 		}
-		time.Sleep(1 * time.Second)
 	}
 
 	if response != nil {
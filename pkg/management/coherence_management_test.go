@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package management_test
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/oracle/coherence-operator/pkg/management"
+)
+
+func TestGetServicesPartitionData(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	host, port := startManagementServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/management/coherence/cluster/services":
+			_, _ = w.Write([]byte(`{"items": [
+				{"name": "PartitionedCache", "type": "DistributedCache"},
+				{"name": "Scope:Topics", "type": "PagedTopic"},
+				{"name": "Proxy", "type": "Proxy"}
+			]}`))
+		case "/management/coherence/cluster/services/PartitionedCache/partition":
+			_, _ = w.Write([]byte(`{"HAStatus": "NODE-SAFE", "HAStatusCode": 1, "backupCount": 1, "serviceNodeCount": 3, "remainingDistributionCount": 0}`))
+		case "/management/coherence/cluster/services/Scope:Topics/partition":
+			_, _ = w.Write([]byte(`{"HAStatus": "ENDANGERED", "HAStatusCode": 0, "backupCount": 1, "serviceNodeCount": 1, "remainingDistributionCount": 10}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	data, status, err := management.GetServicesPartitionData(http.DefaultClient, host, port)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status).To(Equal(http.StatusOK))
+	g.Expect(data).To(HaveLen(2))
+	g.Expect(data[0].Name).To(Equal("PartitionedCache"))
+	g.Expect(data[0].Type).To(Equal("DistributedCache"))
+	g.Expect(data[0].HAStatus).To(Equal("NODE-SAFE"))
+	g.Expect(data[0].ServiceNodeCount).To(Equal(3))
+	g.Expect(data[0].IsEndangered()).To(BeFalse())
+	g.Expect(data[1].Name).To(Equal("Scope:Topics"))
+	g.Expect(data[1].RemainingDistributionCount).To(Equal(10))
+	g.Expect(data[1].IsEndangered()).To(BeTrue())
+}
+
+func TestGetServicesPartitionDataFailure(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	host, port := startManagementServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() == "/management/coherence/cluster/services" {
+			_, _ = w.Write([]byte(`{"items": [{"name": "PartitionedCache", "type": "DistributedCache"}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	data, status, err := management.GetServicesPartitionData(http.DefaultClient, host, port)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status).To(Equal(http.StatusServiceUnavailable))
+	g.Expect(data).To(BeNil())
+}
+
+func TestGetMembersSingleAttempt(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// the server closes every connection without a response, so the request fails to connect
+	var requests atomic.Int32
+	host, port := startManagementServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		conn, _, err := http.NewResponseController(w).Hijack()
+		if err == nil {
+			_ = conn.Close()
+		}
+	})
+
+	_, _, err := management.GetMembers(http.DefaultClient, host, port, management.SingleAttempt())
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(requests.Load()).To(Equal(int32(1)))
+}
+
+func TestPartitionDataIsEndangered(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var pd *management.PartitionData
+	g.Expect(pd.IsEndangered()).To(BeFalse())
+	g.Expect((&management.PartitionData{BackupCount: 1, HAStatusCode: management.HAStatusCodeEndangered}).IsEndangered()).To(BeTrue())
+	g.Expect((&management.PartitionData{BackupCount: 1, HAStatusCode: management.HAStatusCodeMachineSafe}).IsEndangered()).To(BeFalse())
+	// a service without backups can never be NODE-SAFE
+	g.Expect((&management.PartitionData{BackupCount: 0, HAStatusCode: management.HAStatusCodeEndangered}).IsEndangered()).To(BeFalse())
+}
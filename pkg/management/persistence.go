@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package management
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	// The URL pattern for Coherence management service persistence query.
+	persistenceFormat = "http://%s:%d/management/coherence/cluster/services/%s/persistence"
+	// The URL pattern for Coherence management service snapshots query.
+	snapshotsFormat = persistenceFormat + "/snapshots"
+	// The URL pattern for Coherence management snapshot create and remove operations.
+	snapshotFormat = snapshotsFormat + "/%s"
+	// The URL pattern for Coherence management snapshot recover operation.
+	recoverSnapshotFormat = snapshotFormat + "/recover"
+	// The URL pattern for Coherence management service archived snapshots query.
+	archivesFormat = persistenceFormat + "/archives"
+	// The URL pattern for Coherence management archive and remove archived snapshot operations.
+	archiveFormat = archivesFormat + "/%s"
+	// The URL pattern for Coherence management retrieve archived snapshot operation.
+	retrieveArchiveFormat = archiveFormat + "/retrieve"
+
+	// PersistenceStatusIdle is the persistence operation status when no persistence operation is in progress.
+	PersistenceStatusIdle = "Idle"
+)
+
+// PersistenceData is a struct to use to hold the results of a Coherence management REST service persistence query
+// http://localhost:30000/management/coherence/cluster/services/%s/persistence
+// This structure only contains a sub-set of the fields available in the response json. If other
+// fields are required they should be added to this struct.
+type PersistenceData struct {
+	Links           []map[string]string `json:"Links"`
+	OperationStatus string              `json:"operationStatus"`
+	Idle            bool                `json:"idle"`
+	Snapshots       []string            `json:"snapshots"`
+}
+
+// IsIdle returns true if there is no persistence operation in progress for the service.
+func (in *PersistenceData) IsIdle() bool {
+	return in != nil && (in.Idle || in.OperationStatus == PersistenceStatusIdle)
+}
+
+// SnapshotsData is a struct to use to hold the results of a Coherence management REST snapshots query
+// http://localhost:30000/management/coherence/cluster/services/%s/persistence/snapshots
+type SnapshotsData struct {
+	Links     []map[string]string `json:"Links"`
+	Snapshots []string            `json:"snapshots"`
+}
+
+// Contains returns true if the snapshots data contains the specified snapshot name.
+func (in *SnapshotsData) Contains(name string) bool {
+	if in == nil {
+		return false
+	}
+	for _, s := range in.Snapshots {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ArchivesData is a struct to use to hold the results of a Coherence management REST archived snapshots query
+// http://localhost:30000/management/coherence/cluster/services/%s/persistence/archives
+type ArchivesData struct {
+	Links    []map[string]string `json:"Links"`
+	Archives []string            `json:"archives"`
+}
+
+// Contains returns true if the archives data contains the specified archived snapshot name.
+func (in *ArchivesData) Contains(name string) bool {
+	if in == nil {
+		return false
+	}
+	for _, s := range in.Archives {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPersistence performs a Management over REST query http://localhost:30000/management/coherence/cluster/services/%s/persistence
+// and return the results, the http response status and any error.
+func GetPersistence(cl *http.Client, host string, port int32, service string) (*PersistenceData, int, error) {
+	u := fmt.Sprintf(persistenceFormat, host, port, url.PathEscape(service))
+	data := &PersistenceData{}
+	status, err := query(cl, u, data)
+	return data, status, err
+}
+
+// GetSnapshots performs a Management over REST query http://localhost:30000/management/coherence/cluster/services/%s/persistence/snapshots
+// and return the results, the http response status and any error.
+func GetSnapshots(cl *http.Client, host string, port int32, service string) (*SnapshotsData, int, error) {
+	u := fmt.Sprintf(snapshotsFormat, host, port, url.PathEscape(service))
+	data := &SnapshotsData{}
+	status, err := query(cl, u, data)
+	return data, status, err
+}
+
+// CreateSnapshot starts the creation of a snapshot of a persistent service. Snapshot creation is asynchronous,
+// so callers should use GetPersistence to determine when the operation has completed.
+// The http response status and any error are returned.
+func CreateSnapshot(cl *http.Client, host string, port int32, service, snapshot string) (int, error) {
+	u := fmt.Sprintf(snapshotFormat, host, port, url.PathEscape(service), url.PathEscape(snapshot))
+	return invoke(cl, http.MethodPost, u)
+}
+
+// RemoveSnapshot removes a snapshot from a persistent service.
+// The http response status and any error are returned.
+func RemoveSnapshot(cl *http.Client, host string, port int32, service, snapshot string) (int, error) {
+	u := fmt.Sprintf(snapshotFormat, host, port, url.PathEscape(service), url.PathEscape(snapshot))
+	return invoke(cl, http.MethodDelete, u)
+}
+
+// RecoverSnapshot starts the recovery of a persistent service from a snapshot. The service should be
+// suspended before recovery is started. Recovery is asynchronous, so callers should use GetPersistence
+// to determine when the operation has completed.
+// The http response status and any error are returned.
+func RecoverSnapshot(cl *http.Client, host string, port int32, service, snapshot string) (int, error) {
+	u := fmt.Sprintf(recoverSnapshotFormat, host, port, url.PathEscape(service), url.PathEscape(snapshot))
+	return invoke(cl, http.MethodPost, u)
+}
+
+// GetArchivedSnapshots performs a Management over REST query http://localhost:30000/management/coherence/cluster/services/%s/persistence/archives
+// and return the results, the http response status and any error.
+func GetArchivedSnapshots(cl *http.Client, host string, port int32, service string) (*ArchivesData, int, error) {
+	u := fmt.Sprintf(archivesFormat, host, port, url.PathEscape(service))
+	data := &ArchivesData{}
+	status, err := query(cl, u, data)
+	return data, status, err
+}
+
+// ArchiveSnapshot starts archiving an existing snapshot of a persistent service to the configured archiver.
+// The http response status and any error are returned.
+func ArchiveSnapshot(cl *http.Client, host string, port int32, service, snapshot string) (int, error) {
+	u := fmt.Sprintf(archiveFormat, host, port, url.PathEscape(service), url.PathEscape(snapshot))
+	return invoke(cl, http.MethodPost, u)
+}
+
+// RemoveArchivedSnapshot removes an archived snapshot of a persistent service from the configured archiver.
+// The http response status and any error are returned.
+func RemoveArchivedSnapshot(cl *http.Client, host string, port int32, service, snapshot string) (int, error) {
+	u := fmt.Sprintf(archiveFormat, host, port, url.PathEscape(service), url.PathEscape(snapshot))
+	return invoke(cl, http.MethodDelete, u)
+}
+
+// RetrieveArchivedSnapshot starts retrieving an archived snapshot of a persistent service from the configured
+// archiver so that it is available to be recovered.
+// The http response status and any error are returned.
+func RetrieveArchivedSnapshot(cl *http.Client, host string, port int32, service, snapshot string) (int, error) {
+	u := fmt.Sprintf(retrieveArchiveFormat, host, port, url.PathEscape(service), url.PathEscape(snapshot))
+	return invoke(cl, http.MethodPost, u)
+}
+
+// invoke performs a Management over REST operation using the specified http method, returning
+// the response code and any error. A response code other than 200, 202 or 204 is returned as an error.
+func invoke(cl *http.Client, method, u string) (int, error) {
+	var response *http.Response
+	var err error
+
+	// re-try a max of 5 times if the request could not be sent
+	for i := 0; i < 5; i++ {
+		var request *http.Request
+		if request, err = http.NewRequest(method, u, http.NoBody); err != nil {
+			return http.StatusInternalServerError, err
+		}
+		response, err = cl.Do(request)
+		if err == nil {
+			break
+		}
+		time.Sleep(1 * time.Second)
+	}
+
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+		return response.StatusCode, nil
+	default:
+		return response.StatusCode, fmt.Errorf("management request %s %s failed with status %d", method, u, response.StatusCode)
+	}
+}
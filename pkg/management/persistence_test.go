@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package management_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/oracle/coherence-operator/pkg/management"
+)
+
+func TestGetSnapshots(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var path string
+	host, port := startManagementServer(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		_, _ = w.Write([]byte(`{"snapshots": ["one", "two"]}`))
+	})
+
+	data, status, err := management.GetSnapshots(http.DefaultClient, host, port, "PartitionedCache")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status).To(Equal(http.StatusOK))
+	g.Expect(path).To(Equal("/management/coherence/cluster/services/PartitionedCache/persistence/snapshots"))
+	g.Expect(data.Snapshots).To(ConsistOf("one", "two"))
+	g.Expect(data.Contains("two")).To(BeTrue())
+	g.Expect(data.Contains("three")).To(BeFalse())
+}
+
+func TestGetPersistenceIsIdle(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	host, port := startManagementServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"operationStatus": "Idle", "snapshots": ["one"]}`))
+	})
+
+	data, _, err := management.GetPersistence(http.DefaultClient, host, port, "PartitionedCache")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(data.IsIdle()).To(BeTrue())
+	g.Expect(data.Snapshots).To(ConsistOf("one"))
+}
+
+func TestCreateSnapshotEscapesServiceName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var method, path string
+	host, port := startManagementServer(t, func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.EscapedPath()
+	})
+
+	status, err := management.CreateSnapshot(http.DefaultClient, host, port, "Scope:Service", "snap 1")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status).To(Equal(http.StatusOK))
+	g.Expect(method).To(Equal(http.MethodPost))
+	g.Expect(path).To(Equal("/management/coherence/cluster/services/Scope:Service/persistence/snapshots/snap%201"))
+}
+
+func TestRemoveArchivedSnapshot(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var method, path string
+	host, port := startManagementServer(t, func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.EscapedPath()
+	})
+
+	_, err := management.RemoveArchivedSnapshot(http.DefaultClient, host, port, "PartitionedCache", "snap")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(method).To(Equal(http.MethodDelete))
+	g.Expect(path).To(Equal("/management/coherence/cluster/services/PartitionedCache/persistence/archives/snap"))
+}
+
+func TestRecoverSnapshotFailure(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	host, port := startManagementServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	status, err := management.RecoverSnapshot(http.DefaultClient, host, port, "PartitionedCache", "snap")
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(status).To(Equal(http.StatusBadRequest))
+}
+
+func startManagementServer(t *testing.T, handler http.HandlerFunc) (string, int32) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	host, p, err := net.SplitHostPort(server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, err := strconv.Atoi(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return host, int32(port)
+}
@@ -0,0 +1,276 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+// Package metrics contains the Coherence Operator Prometheus metrics.
+// The metrics are registered with the controller-runtime metrics registry, so they are
+// exposed on the same endpoint as the default controller-runtime metrics.
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// MetricsNamespace is the prefix of the names of all the Coherence Operator metrics.
+	MetricsNamespace = "coherence_operator"
+
+	// LabelNamespace is the label for the namespace of a Coherence resource.
+	LabelNamespace = "namespace"
+	// LabelDeployment is the label for the name of a Coherence resource.
+	LabelDeployment = "deployment"
+	// LabelKind is the label for the kind of Coherence resource.
+	LabelKind = "kind"
+	// LabelPhase is the label for the phase of a Coherence resource.
+	LabelPhase = "phase"
+	// LabelResult is the label for the result of an operation.
+	LabelResult = "result"
+	// LabelDirection is the label for the direction of a scaling operation.
+	LabelDirection = "direction"
+	// LabelStrategy is the label for the rolling upgrade strategy.
+	LabelStrategy = "strategy"
+	// LabelCategory is the label for the category of an error.
+	LabelCategory = "category"
+
+	// ResultHA is the StatusHA check result when the deployment is StatusHA.
+	ResultHA = "ha"
+	// ResultNotHA is the StatusHA check result when the deployment is not StatusHA.
+	ResultNotHA = "not_ha"
+	// ResultSucceeded is the result of a successful operation.
+	ResultSucceeded = "succeeded"
+	// ResultFailed is the result of a failed operation.
+	ResultFailed = "failed"
+	// ResultDeferred is the result of a rolling upgrade step deferred because the deployment is not StatusHA.
+	ResultDeferred = "deferred"
+
+	// DirectionUp is the direction of a scale up.
+	DirectionUp = "up"
+	// DirectionDown is the direction of a scale down.
+	DirectionDown = "down"
+
+	// phaseScaling and phaseRollingUpgrade are the phases that are timed.
+	phaseScaling        = "Scaling"
+	phaseRollingUpgrade = "RollingUpgrade"
+)
+
+var (
+	// StatusHAChecks counts the StatusHA checks by result.
+	StatusHAChecks = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "status_ha_checks_total",
+		Help:      "The number of StatusHA checks of a Coherence deployment by result.",
+	}, []string{LabelNamespace, LabelDeployment, LabelResult})
+
+	// StatusHADuration is the latency of StatusHA checks.
+	StatusHADuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: MetricsNamespace,
+		Name:      "status_ha_check_duration_seconds",
+		Help:      "The time taken to check whether a Coherence deployment is StatusHA.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 12),
+	}, []string{LabelNamespace, LabelDeployment})
+
+	// ScalingOperations counts the changes to the replicas of a StatefulSet by direction and result.
+	ScalingOperations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "scaling_operations_total",
+		Help:      "The number of changes to the replicas of a Coherence deployment by direction and result.",
+	}, []string{LabelNamespace, LabelDeployment, LabelDirection, LabelResult})
+
+	// ScalingDuration is the time a deployment spends in the Scaling phase.
+	ScalingDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: MetricsNamespace,
+		Name:      "scaling_duration_seconds",
+		Help:      "The time taken to scale a Coherence deployment, from entering to leaving the Scaling phase.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 14),
+	}, []string{LabelNamespace, LabelDeployment})
+
+	// RollingUpgradeSteps counts the steps of Operator managed rolling upgrades by strategy and result.
+	RollingUpgradeSteps = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "rolling_upgrade_steps_total",
+		Help:      "The number of rolling upgrade steps of a Coherence deployment by strategy and result.",
+	}, []string{LabelNamespace, LabelDeployment, LabelStrategy, LabelResult})
+
+	// RollingUpgradeStepDuration is the time taken by a rolling upgrade step.
+	RollingUpgradeStepDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: MetricsNamespace,
+		Name:      "rolling_upgrade_step_duration_seconds",
+		Help:      "The time taken by a rolling upgrade step of a Coherence deployment.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 12),
+	}, []string{LabelNamespace, LabelDeployment, LabelStrategy})
+
+	// RollingUpgradeDuration is the time a deployment spends in the RollingUpgrade phase.
+	RollingUpgradeDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: MetricsNamespace,
+		Name:      "rolling_upgrade_duration_seconds",
+		Help:      "The time taken to upgrade a Coherence deployment, from entering to leaving the RollingUpgrade phase.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 14),
+	}, []string{LabelNamespace, LabelDeployment})
+
+	// ServiceSuspensions counts the service suspension requests by result.
+	ServiceSuspensions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "service_suspensions_total",
+		Help:      "The number of requests to suspend the services of a Coherence deployment by result.",
+	}, []string{LabelNamespace, LabelDeployment, LabelResult})
+
+	// Errors counts the reconcile errors by category.
+	Errors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricsNamespace,
+		Name:      "reconcile_errors_total",
+		Help:      "The number of errors reconciling a Coherence resource by category.",
+	}, []string{LabelNamespace, LabelDeployment, LabelCategory})
+
+	// Phase is one for the current phase of a Coherence resource and zero for any previous phase.
+	Phase = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: MetricsNamespace,
+		Name:      "resource_phase",
+		Help:      "The phase of a Coherence resource, one for the current phase and zero for previous phases.",
+	}, []string{LabelNamespace, LabelDeployment, LabelKind, LabelPhase})
+
+	// collectors are all the Coherence Operator collectors.
+	collectors = []prometheus.Collector{
+		StatusHAChecks, StatusHADuration, ScalingOperations, ScalingDuration, RollingUpgradeSteps,
+		RollingUpgradeStepDuration, RollingUpgradeDuration, ServiceSuspensions, Errors, Phase,
+	}
+
+	// phases tracks the current phase of each resource and when it was entered.
+	phases = phaseTracker{entries: make(map[phaseKey]phaseEntry)}
+)
+
+func init() {
+	crmetrics.Registry.MustRegister(collectors...)
+}
+
+// RecordStatusHACheck records the result and latency of a StatusHA check.
+func RecordStatusHACheck(namespace, deployment string, ha bool, duration time.Duration) {
+	result := ResultNotHA
+	if ha {
+		result = ResultHA
+	}
+	StatusHAChecks.WithLabelValues(namespace, deployment, result).Inc()
+	StatusHADuration.WithLabelValues(namespace, deployment).Observe(duration.Seconds())
+}
+
+// RecordScalingOperation records a change to the replicas of a StatefulSet.
+func RecordScalingOperation(namespace, deployment string, current, replicas int32, err error) {
+	direction := DirectionUp
+	if replicas < current {
+		direction = DirectionDown
+	}
+	ScalingOperations.WithLabelValues(namespace, deployment, direction, resultOf(err)).Inc()
+}
+
+// RecordRollingUpgradeStep records the result and duration of a rolling upgrade step.
+func RecordRollingUpgradeStep(namespace, deployment, strategy, result string, duration time.Duration) {
+	RollingUpgradeSteps.WithLabelValues(namespace, deployment, strategy, result).Inc()
+	RollingUpgradeStepDuration.WithLabelValues(namespace, deployment, strategy).Observe(duration.Seconds())
+}
+
+// RecordServiceSuspension records the result of a request to suspend services.
+func RecordServiceSuspension(namespace, deployment, result string) {
+	ServiceSuspensions.WithLabelValues(namespace, deployment, result).Inc()
+}
+
+// RecordError records a reconcile error.
+func RecordError(namespace, deployment, category string) {
+	Errors.WithLabelValues(namespace, deployment, category).Inc()
+}
+
+// SetPhase sets the phase gauge of a Coherence resource. When a resource leaves the Scaling
+// or RollingUpgrade phase the time spent in that phase is recorded.
+func SetPhase(kind string, nn types.NamespacedName, phase string) {
+	if phase == "" {
+		return
+	}
+	previous, since, changed := phases.set(phaseKey{kind: kind, nn: nn}, phase)
+	if !changed {
+		return
+	}
+	if previous != "" {
+		Phase.WithLabelValues(nn.Namespace, nn.Name, kind, previous).Set(0)
+		// the time is only known if the phase was entered while the Operator was running
+		if !since.IsZero() {
+			switch previous {
+			case phaseScaling:
+				ScalingDuration.WithLabelValues(nn.Namespace, nn.Name).Observe(time.Since(since).Seconds())
+			case phaseRollingUpgrade:
+				RollingUpgradeDuration.WithLabelValues(nn.Namespace, nn.Name).Observe(time.Since(since).Seconds())
+			}
+		}
+	}
+	Phase.WithLabelValues(nn.Namespace, nn.Name, kind, phase).Set(1)
+}
+
+// DeleteResource removes all the metrics for a deleted Coherence resource.
+func DeleteResource(kind string, nn types.NamespacedName) {
+	phases.delete(phaseKey{kind: kind, nn: nn})
+	Phase.DeletePartialMatch(prometheus.Labels{LabelNamespace: nn.Namespace, LabelDeployment: nn.Name, LabelKind: kind})
+	labels := prometheus.Labels{LabelNamespace: nn.Namespace, LabelDeployment: nn.Name}
+	for _, c := range collectors {
+		if c == Phase {
+			continue
+		}
+		if v, ok := c.(interface {
+			DeletePartialMatch(prometheus.Labels) int
+		}); ok {
+			v.DeletePartialMatch(labels)
+		}
+	}
+}
+
+func resultOf(err error) string {
+	if err != nil {
+		return ResultFailed
+	}
+	return ResultSucceeded
+}
+
+// ----- phaseTracker ------------------------------------------------------------------------------
+
+type phaseKey struct {
+	kind string
+	nn   types.NamespacedName
+}
+
+type phaseEntry struct {
+	phase string
+	since time.Time
+}
+
+// phaseTracker tracks the current phase of resources and the time the phase was entered.
+type phaseTracker struct {
+	lock    sync.Mutex
+	entries map[phaseKey]phaseEntry
+}
+
+// set sets the phase of a resource, returning the previous phase, the time the previous phase
+// was entered, and whether the phase changed. The first phase seen for a resource has no entry
+// time, as the phase may have been entered before the Operator started.
+func (in *phaseTracker) set(key phaseKey, phase string) (string, time.Time, bool) {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+	previous, found := in.entries[key]
+	if found && previous.phase == phase {
+		return "", time.Time{}, false
+	}
+	entry := phaseEntry{phase: phase}
+	if found {
+		entry.since = time.Now()
+	}
+	in.entries[key] = entry
+	return previous.phase, previous.since, true
+}
+
+func (in *phaseTracker) delete(key phaseKey) {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+	delete(in.entries, key)
+}
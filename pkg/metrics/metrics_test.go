@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package metrics_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/oracle/coherence-operator/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/types"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func TestCollectorsAreRegistered(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	metrics.RecordStatusHACheck("test", "registered", true, time.Millisecond)
+	families, err := crmetrics.Registry.Gather()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var names []string
+	for _, f := range families {
+		names = append(names, f.GetName())
+	}
+	g.Expect(names).To(ContainElements(
+		"coherence_operator_status_ha_checks_total",
+		"coherence_operator_status_ha_check_duration_seconds",
+	))
+}
+
+func TestRecordStatusHACheck(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	metrics.RecordStatusHACheck("test", "ha", true, time.Millisecond)
+	metrics.RecordStatusHACheck("test", "ha", false, time.Millisecond)
+	metrics.RecordStatusHACheck("test", "ha", false, time.Millisecond)
+
+	g.Expect(testutil.ToFloat64(metrics.StatusHAChecks.WithLabelValues("test", "ha", metrics.ResultHA))).To(Equal(1.0))
+	g.Expect(testutil.ToFloat64(metrics.StatusHAChecks.WithLabelValues("test", "ha", metrics.ResultNotHA))).To(Equal(2.0))
+}
+
+func TestRecordScalingOperation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	metrics.RecordScalingOperation("test", "scaling", 1, 3, nil)
+	metrics.RecordScalingOperation("test", "scaling", 3, 2, errors.New("failed"))
+
+	g.Expect(testutil.ToFloat64(metrics.ScalingOperations.WithLabelValues("test", "scaling", metrics.DirectionUp, metrics.ResultSucceeded))).To(Equal(1.0))
+	g.Expect(testutil.ToFloat64(metrics.ScalingOperations.WithLabelValues("test", "scaling", metrics.DirectionDown, metrics.ResultFailed))).To(Equal(1.0))
+}
+
+func TestSetPhase(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	nn := types.NamespacedName{Namespace: "test", Name: "phase"}
+	metrics.SetPhase("Coherence", nn, "Ready")
+	g.Expect(testutil.ToFloat64(metrics.Phase.WithLabelValues("test", "phase", "Coherence", "Ready"))).To(Equal(1.0))
+
+	// the first phase seen has no start time so leaving it is not timed
+	metrics.SetPhase("Coherence", nn, "Scaling")
+	g.Expect(testutil.ToFloat64(metrics.Phase.WithLabelValues("test", "phase", "Coherence", "Ready"))).To(Equal(0.0))
+	g.Expect(testutil.ToFloat64(metrics.Phase.WithLabelValues("test", "phase", "Coherence", "Scaling"))).To(Equal(1.0))
+
+	// leaving the Scaling phase records the scaling duration
+	metrics.SetPhase("Coherence", nn, "Ready")
+	g.Expect(testutil.CollectAndCount(metrics.ScalingDuration, "coherence_operator_scaling_duration_seconds")).To(BeNumerically(">=", 1))
+	g.Expect(testutil.ToFloat64(metrics.Phase.WithLabelValues("test", "phase", "Coherence", "Scaling"))).To(Equal(0.0))
+
+	// deleting the resource removes its metrics
+	metrics.DeleteResource("Coherence", nn)
+	g.Expect(testutil.CollectAndCount(metrics.Phase)).To(Equal(0))
+}
+
+func TestRecordError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	metrics.RecordError("test", "errors", "Transient")
+	g.Expect(testutil.ToFloat64(metrics.Errors.WithLabelValues("test", "errors", "Transient"))).To(Equal(1.0))
+
+	metrics.DeleteResource("Coherence", types.NamespacedName{Namespace: "test", Name: "errors"})
+	g.Expect(testutil.CollectAndCount(metrics.Errors)).To(Equal(0))
+}
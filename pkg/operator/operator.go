@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2020, 2025, Oracle and/or its affiliates.
+ * Copyright (c) 2020, 2026, Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * http://oss.oracle.com/licenses/upl.
  */
@@ -37,6 +37,7 @@ const (
 	FlagCRD                    = "install-crd"
 	FlagJobCRD                 = "install-job-crd"
 	FlagEnableCoherenceJobs    = "enable-jobs"
+	FlagEnableSnapshots        = "enable-snapshots"
 	FlagDevMode                = "coherence-dev-mode"
 	FlagCipherDenyList         = "cipher-deny-list"
 	FlagCipherAllowList        = "cipher-allow-list"
@@ -62,10 +63,15 @@ const (
 	FlagServicePort            = "service-port"
 	FlagSiteLabel              = "site-label"
 	FlagSkipServiceSuspend     = "skip-service-suspend"
+	FlagTracingEndpoint        = "tracing-endpoint"
+	FlagTracingInsecure        = "tracing-insecure"
+	FlagTracingSampleRatio     = "tracing-sample-ratio"
 	FlagOperatorImage          = "operator-image"
 	FlagEnvVar                 = "env"
 	FlagJvmArg                 = "jvm"
 	FlagKubernetesCheckTimeout = "kubernetes-check-timeout"
+	FlagWebhookCertDir         = "webhook-cert-dir"
+	FlagWebhookPort            = "webhook-port"
 
 	// EnvVarWatchNamespace is the environment variable to use to set the watch namespace(s)
 	EnvVarWatchNamespace = "WATCH_NAMESPACE"
@@ -87,6 +93,12 @@ const (
 	// LabelTestHealthPort is a label applied to Pods to set a testing health check port
 	LabelTestHealthPort = "coherence.oracle.com/test_health_port"
 
+	// DefaultWebhookPort is the default port the validating web-hook server binds to.
+	DefaultWebhookPort = 9443
+
+	// DefaultTracingSampleRatio is the default ratio of reconcile requests that are traced.
+	DefaultTracingSampleRatio = 1.0
+
 	// DefaultKubernetesCheckTimeout is the default timeout applied to the initial Kubernetes API connection check.
 	DefaultKubernetesCheckTimeout = time.Minute
 	// MinKubernetesCheckTimeout is the minimum timeout applied to the initial Kubernetes API connection check.
@@ -157,6 +169,11 @@ func SetupFlags(cmd *cobra.Command, v *viper.Viper) {
 		true,
 		"Enables CoherenceJob support",
 	)
+	cmd.Flags().Bool(
+		FlagEnableSnapshots,
+		true,
+		"Enables CoherenceSnapshot and CoherenceRestore support",
+	)
 	cmd.Flags().Bool(
 		FlagEnableHttp2,
 		false,
@@ -165,7 +182,17 @@ func SetupFlags(cmd *cobra.Command, v *viper.Viper) {
 	cmd.Flags().Bool(
 		FlagEnableWebhook,
 		false,
-		"This flag is here for backward compatibility but is ignored",
+		"Enables the validating web-hook server for Coherence and CoherenceJob resources",
+	)
+	cmd.Flags().String(
+		FlagWebhookCertDir,
+		"",
+		"The directory containing the web-hook server TLS certificate and key (tls.crt and tls.key)",
+	)
+	cmd.Flags().Int(
+		FlagWebhookPort,
+		DefaultWebhookPort,
+		"The port the web-hook server binds to",
 	)
 	cmd.Flags().Bool(
 		FlagNodeLookupEnabled,
@@ -219,6 +246,21 @@ func SetupFlags(cmd *cobra.Command, v *viper.Viper) {
 		"Suspend Coherence services on a cluster prior to shutdown or scaling to zero. "+
 			"This option is rarely set to false outside of testing.",
 	)
+	cmd.Flags().String(
+		FlagTracingEndpoint,
+		"",
+		"The host and port of an OTLP gRPC endpoint to send traces to. If not set, tracing is disabled.",
+	)
+	cmd.Flags().Bool(
+		FlagTracingInsecure,
+		false,
+		"If set, traces are sent to the OTLP endpoint without TLS.",
+	)
+	cmd.Flags().Float64(
+		FlagTracingSampleRatio,
+		DefaultTracingSampleRatio,
+		"The ratio, between 0 and 1, of traces to sample.",
+	)
 	cmd.Flags().String(
 		FlagOperatorImage,
 		"",
@@ -320,6 +362,10 @@ func ShouldSupportCoherenceJob() bool {
 	return v.GetBool(FlagEnableCoherenceJobs) || v.GetBool(FlagJobCRD)
 }
 
+func ShouldSupportSnapshots() bool {
+	return GetViper().GetBool(FlagEnableSnapshots)
+}
+
 func IsDryRun() bool {
 	return GetViper().GetBool(FlagDryRun)
 }
@@ -328,6 +374,33 @@ func GetNamespace() string {
 	return GetViper().GetString(FlagOperatorNamespace)
 }
 
+func IsWebhookEnabled() bool {
+	return GetViper().GetBool(FlagEnableWebhook)
+}
+
+// GetTracingEndpoint returns the OTLP endpoint to send traces to, tracing is disabled if this is empty.
+func GetTracingEndpoint() string {
+	return GetViper().GetString(FlagTracingEndpoint)
+}
+
+// IsTracingInsecure returns true if traces are sent to the OTLP endpoint without TLS.
+func IsTracingInsecure() bool {
+	return GetViper().GetBool(FlagTracingInsecure)
+}
+
+// GetTracingSampleRatio returns the ratio of traces to sample, between zero and one.
+func GetTracingSampleRatio() float64 {
+	r := GetViper().GetFloat64(FlagTracingSampleRatio)
+	switch {
+	case r < 0:
+		return 0
+	case r > 1:
+		return 1
+	default:
+		return r
+	}
+}
+
 func IsNodeLookupEnabled() bool {
 	return GetViper().GetBool(FlagNodeLookupEnabled)
 }
@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package probe
+
+import (
+	"context"
+	"fmt"
+
+	coh "github.com/oracle/coherence-operator/api/v1"
+	mgmt "github.com/oracle/coherence-operator/pkg/management"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// EventReasonCheckHALevel is the event reason for the result of checking the required HA status level.
+	EventReasonCheckHALevel = "CheckHALevel"
+)
+
+// ClusterHAStatus is the placement of the members of a Coherence cluster and the HA status
+// of its partitioned services.
+type ClusterHAStatus struct {
+	// Members are the members of the cluster, including members of other deployments.
+	Members []mgmt.MemberData
+	// Services are the partition data of the partitioned services of the cluster.
+	Services []mgmt.ServicePartitionData
+}
+
+// GetClusterHAStatus obtains the members and the partition data of the partitioned services of the
+// Coherence cluster that a StatefulSet belongs to, using Coherence management over REST.
+// The HA status is checked inline in a reconcile, so each request is only attempted once with a short timeout.
+func (in *CoherenceProbe) GetClusterHAStatus(ctx context.Context, deployment *coh.Coherence, sts *appsv1.StatefulSet) (ClusterHAStatus, error) {
+	host, port, err := in.GetManagementHostAndPort(ctx, deployment, sts)
+	if err != nil {
+		return ClusterHAStatus{}, err
+	}
+
+	cl := mgmt.GetHTTPClient(nil, mgmt.ReconcileRequestTimeout)
+	members, code, err := mgmt.GetMembers(cl, host, port, mgmt.SingleAttempt())
+	if err = mgmt.CheckResponse("get cluster members", code, err); err != nil {
+		return ClusterHAStatus{}, err
+	}
+	services, code, err := mgmt.GetServicesPartitionData(cl, host, port, mgmt.SingleAttempt())
+	if err = mgmt.CheckResponse("get services partition data", code, err); err != nil {
+		return ClusterHAStatus{}, err
+	}
+	return ClusterHAStatus{Members: members.Items, Services: services}, nil
+}
+
+// IsRequiredHALevel returns true if the deployment does not have a required HA status level, or if every
+// partitioned service with backups in its cluster is at or above the required level. If the level cannot be
+// checked false is returned. A message describing the result is also returned. The result of the check is
+// sent as an event and, if the probe has a StatusManager, set in the status of the deployment.
+func (in *CoherenceProbe) IsRequiredHALevel(ctx context.Context, deployment coh.CoherenceResource, sts *appsv1.StatefulSet) (bool, string) {
+	spec, found := deployment.GetStatefulSetSpec()
+	c, ok := deployment.(*coh.Coherence)
+	if !found || !ok || !spec.Scaling.IsHALevelRequired() {
+		return true, ""
+	}
+
+	required := spec.Scaling.GetRequiredHALevel()
+	ha, err := in.GetClusterHAStatus(ctx, c, sts)
+	if err != nil {
+		msg := fmt.Sprintf("cannot check the required HA status level %s: %s", required, err.Error())
+		log.Info(msg, "Namespace", deployment.GetNamespace(), "Name", deployment.GetName())
+		in.EventRecorder.Warn(EventReasonCheckHALevel, msg)
+		return false, msg
+	}
+
+	result := NewHALevelStatus(ha, required, metav1.Now())
+	msg := result.Message()
+	log.Info("Checked HA status level", "Namespace", deployment.GetNamespace(), "Name", deployment.GetName(),
+		"Required", result.Required, "Observed", result.Observed, "Service", result.Service, "Safe", result.Safe)
+	if result.Safe {
+		in.EventRecorder.Info(EventReasonCheckHALevel, msg)
+	} else {
+		in.EventRecorder.Warn(EventReasonCheckHALevel, msg)
+	}
+	if in.StatusManager != nil {
+		if err := in.StatusManager.SetHALevel(ctx, deployment, result); err != nil {
+			log.Info("Failed to update HA status level", "Namespace", deployment.GetNamespace(), "Name", deployment.GetName(),
+				"Error", err.Error())
+		}
+	}
+	return result.Safe, msg
+}
+
+// NewHALevelStatus returns the result of checking that every partitioned service with backups in a cluster
+// is at or above the required HA status level. The observed level is the lowest level of the services.
+func NewHALevelStatus(ha ClusterHAStatus, required coh.HALevel, now metav1.Time) coh.HALevelStatus {
+	result := coh.HALevelStatus{Time: now, Required: required, Safe: true}
+	lowest := -1
+	for _, svc := range ha.Services {
+		if svc.BackupCount <= 0 {
+			// a service without backups does not have an HA status level to check
+			continue
+		}
+		if lowest < 0 || svc.HAStatusCode < lowest {
+			lowest = svc.HAStatusCode
+			result.Observed = svc.HAStatus
+			result.Service = svc.Name
+		}
+	}
+	if lowest >= 0 {
+		result.Safe = lowest >= required.StatusCode()
+	}
+	return result
+}
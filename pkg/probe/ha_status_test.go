@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package probe_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/pkg/fakes/stubs"
+	"github.com/oracle/coherence-operator/pkg/management"
+	"github.com/oracle/coherence-operator/pkg/probe"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestNewHALevelStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := metav1.Now()
+	ha := probe.ClusterHAStatus{Services: []management.ServicePartitionData{
+		{Name: "A", PartitionData: management.PartitionData{HAStatus: "SITE_SAFE", HAStatusCode: management.HAStatusCodeSiteSafe, BackupCount: 1}},
+		{Name: "B", PartitionData: management.PartitionData{HAStatus: "MACHINE_SAFE", HAStatusCode: management.HAStatusCodeMachineSafe, BackupCount: 1}},
+		{Name: "C", PartitionData: management.PartitionData{HAStatus: "ENDANGERED", HAStatusCode: management.HAStatusCodeEndangered}},
+	}}
+
+	// the service without backups is ignored, the lowest level is MACHINE_SAFE
+	result := probe.NewHALevelStatus(ha, coh.HALevelRackSafe, now)
+	g.Expect(result).To(Equal(coh.HALevelStatus{Time: now, Required: coh.HALevelRackSafe, Observed: "MACHINE_SAFE", Service: "B", Safe: false}))
+	g.Expect(result.Message()).To(Equal("service B is MACHINE_SAFE, below the required HA status level RACK_SAFE"))
+
+	result = probe.NewHALevelStatus(ha, coh.HALevelMachineSafe, now)
+	g.Expect(result.Safe).To(BeTrue())
+
+	result = probe.NewHALevelStatus(probe.ClusterHAStatus{}, coh.HALevelSiteSafe, now)
+	g.Expect(result.Safe).To(BeTrue())
+	g.Expect(result.Observed).To(BeEmpty())
+}
+
+func TestIsRequiredHALevel(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	server := stubs.NewManagementServer(t)
+	server.SetResponse(stubs.ManagementMembersPath, http.StatusOK,
+		`{"items": [{"memberName": "storage-0", "id": 1, "machineName": "node-a", "rackName": "rack-1", "siteName": "site-1"}]}`)
+	server.SetResponse(stubs.ManagementServicesPath, http.StatusOK, `{"items": [{"name": "PartitionedCache", "type": "DistributedCache"}]}`)
+	setPartitionData := func(level string, code int) {
+		server.SetResponse(stubs.ManagementPartitionPath("PartitionedCache"), http.StatusOK,
+			fmt.Sprintf(`{"HAStatus": "%s", "HAStatusCode": %d, "backupCount": 1, "serviceNodeCount": 3}`, level, code))
+	}
+	setPartitionData("MACHINE_SAFE", management.HAStatusCodeMachineSafe)
+
+	deployment, sts, pod := stubs.NewManagedCoherence(server)
+	c := stubs.NewClient(deployment, sts, pod)
+	p := probe.CoherenceProbe{Client: c, StatusManager: stubs.NewStatusManager(c)}
+
+	latestHALevel := func() *coh.HALevelStatus {
+		latest := &coh.Coherence{}
+		g.Expect(c.Get(ctx, deployment.GetNamespacedName(), latest)).To(Succeed())
+		return latest.Status.HALevel
+	}
+
+	// no level is required
+	safe, msg := p.IsRequiredHALevel(ctx, deployment, sts)
+	g.Expect(safe).To(BeTrue())
+	g.Expect(msg).To(BeEmpty())
+	g.Expect(latestHALevel()).To(BeNil())
+
+	deployment.Spec.Scaling = &coh.ScalingSpec{RequiredHALevel: ptr.To(coh.HALevelSiteSafe)}
+	safe, msg = p.IsRequiredHALevel(ctx, deployment, sts)
+	g.Expect(safe).To(BeFalse())
+	g.Expect(msg).To(Equal("service PartitionedCache is MACHINE_SAFE, below the required HA status level SITE_SAFE"))
+	ha := latestHALevel()
+	g.Expect(ha).NotTo(BeNil())
+	g.Expect(ha.Required).To(Equal(coh.HALevelSiteSafe))
+	g.Expect(ha.Observed).To(Equal("MACHINE_SAFE"))
+	g.Expect(ha.Service).To(Equal("PartitionedCache"))
+	g.Expect(ha.Safe).To(BeFalse())
+
+	setPartitionData("SITE_SAFE", management.HAStatusCodeSiteSafe)
+	safe, _ = p.IsRequiredHALevel(ctx, deployment, sts)
+	g.Expect(safe).To(BeTrue())
+	ha = latestHALevel()
+	g.Expect(ha.Observed).To(Equal("SITE_SAFE"))
+	g.Expect(ha.Safe).To(BeTrue())
+
+	// when the level cannot be checked the last result is kept and the request is not retried
+	server.SetResponse(stubs.ManagementMembersPath, http.StatusInternalServerError, "")
+	requests := server.Requests(stubs.ManagementMembersPath)
+	safe, msg = p.IsRequiredHALevel(ctx, deployment, sts)
+	g.Expect(safe).To(BeFalse())
+	g.Expect(msg).To(ContainSubstring("cannot check the required HA status level SITE_SAFE"))
+	g.Expect(server.Requests(stubs.ManagementMembersPath)).To(Equal(requests + 1))
+	g.Expect(latestHALevel()).To(Equal(ha))
+
+	// without a StatusManager the result is not recorded
+	setPartitionData("MACHINE_SAFE", management.HAStatusCodeMachineSafe)
+	server.SetResponse(stubs.ManagementMembersPath, http.StatusOK,
+		`{"items": [{"memberName": "storage-0", "id": 1, "machineName": "node-a", "rackName": "rack-1", "siteName": "site-1"}]}`)
+	p = probe.CoherenceProbe{Client: c}
+	safe, _ = p.IsRequiredHALevel(ctx, deployment, sts)
+	g.Expect(safe).To(BeFalse())
+	g.Expect(latestHALevel()).To(Equal(ha))
+}
@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2020, 2025, Oracle and/or its affiliates.
+ * Copyright (c) 2020, 2026, Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * http://oss.oracle.com/licenses/upl.
  */
@@ -10,10 +10,15 @@ import (
 	"context"
 	"fmt"
 	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/controllers/status"
 	"github.com/oracle/coherence-operator/pkg/events"
 	mgmt "github.com/oracle/coherence-operator/pkg/management"
+	"github.com/oracle/coherence-operator/pkg/metrics"
 	"github.com/oracle/coherence-operator/pkg/operator"
+	"github.com/oracle/coherence-operator/pkg/tracing"
 	"github.com/spf13/viper"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+	"go.opentelemetry.io/otel/trace"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -24,6 +29,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Result is a string used to handle the results for probing container readiness/liveness
@@ -41,9 +47,11 @@ const (
 var log = logf.Log.WithName("Probe")
 
 type CoherenceProbe struct {
-	Client         client.Client
-	Config         *rest.Config
-	EventRecorder  events.OwnedEventRecorder
+	Client        client.Client
+	Config        *rest.Config
+	EventRecorder events.OwnedEventRecorder
+	// StatusManager, if set, is used to record the result of checking the required HA status level.
+	StatusManager  *status.StatusManager
 	getPodHostName func(pod corev1.Pod) string
 	translatePort  func(name string, port int) int
 }
@@ -98,14 +106,22 @@ func (in *CoherenceProbe) TranslatePort(name string, port int) int {
 // The number of Pods matching the StatefulSet selector must match the StatefulSet replica count
 // ALl Pods must be in the ready state
 // All Pods must pass the StatusHA check
+// All partitioned services must be at the required HA status level, if one is configured
 func (in *CoherenceProbe) IsStatusHA(ctx context.Context, deployment coh.CoherenceResource, sts *appsv1.StatefulSet) bool {
 	log.Info("Checking StatefulSet "+sts.Name+" for StatusHA",
 		"Namespace", deployment.GetNamespace(), "Name", deployment.GetName())
 
 	spec, found := deployment.GetStatefulSetSpec()
 	if found {
+		start := time.Now()
 		p := spec.GetScalingProbe()
-		return in.ExecuteProbe(ctx, sts, deployment.GetWkaServiceName(), p)
+		ha := in.ExecuteProbe(ctx, sts, deployment.GetWkaServiceName(), p)
+		if ha {
+			// the services must also be at the required HA status level, if there is one
+			ha, _ = in.IsRequiredHALevel(ctx, deployment, sts)
+		}
+		metrics.RecordStatusHACheck(deployment.GetNamespace(), deployment.GetName(), ha, time.Since(start))
+		return ha
 	}
 	return true
 }
@@ -118,12 +134,32 @@ const ( // iota is reset to 0
 	ServiceSuspendFailed     ServiceSuspendStatus = iota // == 2
 )
 
+// String returns the name of a ServiceSuspendStatus.
+func (s ServiceSuspendStatus) String() string {
+	switch s {
+	case ServiceSuspendSkipped:
+		return "skipped"
+	case ServiceSuspendSuccessful:
+		return "successful"
+	case ServiceSuspendFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
 // SuspendServices will request services be suspended in the Coherence cluster.
 // This is called prior to stopping a StatefulSet to then have a graceful shutdown.
 // The number of Pods matching the StatefulSet selector must match the StatefulSet replica count
 // ALl Pods must be in the ready state
 // All Pods must pass the StatusHA check
 func (in *CoherenceProbe) SuspendServices(ctx context.Context, deployment coh.CoherenceResource, sts *appsv1.StatefulSet) ServiceSuspendStatus {
+	status := in.suspendServices(ctx, deployment, sts)
+	metrics.RecordServiceSuspension(deployment.GetNamespace(), deployment.GetName(), status.String())
+	return status
+}
+
+func (in *CoherenceProbe) suspendServices(ctx context.Context, deployment coh.CoherenceResource, sts *appsv1.StatefulSet) ServiceSuspendStatus {
 	ns := deployment.GetNamespace()
 	name := deployment.GetName()
 
@@ -250,13 +286,103 @@ func (in *CoherenceProbe) IsPodReady(pod corev1.Pod) (bool, string) {
 	return false, string(pod.Status.Phase)
 }
 
+// GetManagementHostAndPort returns the host name and port of the Coherence management over REST
+// endpoint of a Pod in the specified StatefulSet. A ready Pod is used in preference, otherwise
+// any running Pod is used, as Pods with suspended services may not be ready.
+// If the Coherence container does not expose a port named "management" the port configured in the
+// Coherence resource's management spec is used.
+func (in *CoherenceProbe) GetManagementHostAndPort(ctx context.Context, deployment *coh.Coherence, sts *appsv1.StatefulSet) (string, int32, error) {
+	pod, err := in.findRunningPod(ctx, sts)
+	if err != nil {
+		return "", 0, err
+	}
+
+	port, err := in.findPortInPod(pod, coh.PortNameManagement)
+	if err != nil {
+		port = in.TranslatePort(coh.PortNameManagement, int(deployment.Spec.GetManagementPort()))
+	}
+	return in.GetPodIpOrHostName(pod), int32(port), nil
+}
+
+// ResumeServices will request that the specified services are resumed in the Coherence cluster.
+// Suspended services may stop Pods reaching the ready state, so the request is sent to a
+// running Pod rather than requiring all Pods to be ready.
+func (in *CoherenceProbe) ResumeServices(ctx context.Context, deployment coh.CoherenceResource, sts *appsv1.StatefulSet, services []string) error {
+	pod, err := in.findRunningPod(ctx, sts)
+	if err != nil {
+		return err
+	}
+
+	timeout := 60
+	if spec, found := deployment.GetStatefulSetSpec(); found && spec.SuspendServiceTimeout != nil {
+		timeout = *spec.SuspendServiceTimeout
+	}
+
+	for _, svc := range services {
+		resume := &coh.Probe{
+			TimeoutSeconds: &timeout,
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path: "/resume/" + url.PathEscape(svc),
+					Port: intstr.FromString(coh.PortNameHealth),
+				},
+			},
+		}
+		ok, err := in.RunProbe(ctx, pod, deployment.GetWkaServiceName(), resume)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("failed to resume service %s using Pod %s", svc, pod.Name)
+		}
+		log.Info("Resumed Coherence service "+svc, "Namespace", sts.Namespace, "Name", sts.Name)
+	}
+	return nil
+}
+
+// findRunningPod returns a ready Pod in the StatefulSet, or if no Pods are ready any running Pod.
+func (in *CoherenceProbe) findRunningPod(ctx context.Context, sts *appsv1.StatefulSet) (corev1.Pod, error) {
+	pods, err := in.GetPodsForStatefulSet(ctx, sts)
+	if err != nil {
+		return corev1.Pod{}, err
+	}
+
+	var running *corev1.Pod
+	for i, pod := range pods.Items {
+		if ready, _ := in.IsPodReady(pod); ready {
+			return pod, nil
+		}
+		if running == nil && pod.DeletionTimestamp == nil && pod.Status.Phase == corev1.PodRunning {
+			running = &pods.Items[i]
+		}
+	}
+	if running != nil {
+		return *running, nil
+	}
+	return corev1.Pod{}, fmt.Errorf("cannot find a running Pod in StatefulSet '%s'", sts.Name)
+}
+
 func (in *CoherenceProbe) RunProbe(ctx context.Context, pod corev1.Pod, svc string, handler *coh.Probe) (bool, error) {
+	ctx, span := tracing.Start(ctx, "CoherenceProbe.RunProbe",
+		semconv.K8SNamespaceName(pod.Namespace),
+		semconv.K8SPodName(pod.Name),
+		tracing.AttributeService.String(svc))
+	ok, err := in.runProbe(ctx, pod, svc, handler, span)
+	span.SetAttributes(tracing.AttributeProbeSuccess.Bool(ok))
+	tracing.End(span, err)
+	return ok, err
+}
+
+func (in *CoherenceProbe) runProbe(ctx context.Context, pod corev1.Pod, svc string, handler *coh.Probe, span trace.Span) (bool, error) {
 	switch {
 	case handler.Exec != nil:
+		span.SetAttributes(tracing.AttributeProbeType.String("exec"))
 		return in.ProbeUsingExec(ctx, pod, handler)
 	case handler.HTTPGet != nil:
+		span.SetAttributes(tracing.AttributeProbeType.String("http"))
 		return in.ProbeUsingHTTP(pod, svc, handler)
 	case handler.TCPSocket != nil:
+		span.SetAttributes(tracing.AttributeProbeType.String("tcp"))
 		return in.ProbeUsingTCP(pod, handler)
 	default:
 		return true, nil
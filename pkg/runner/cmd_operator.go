@@ -17,9 +17,11 @@ import (
 
 	coh "github.com/oracle/coherence-operator/api/v1"
 	"github.com/oracle/coherence-operator/controllers"
+	cohwebhook "github.com/oracle/coherence-operator/controllers/webhook"
 	"github.com/oracle/coherence-operator/pkg/clients"
 	"github.com/oracle/coherence-operator/pkg/operator"
 	"github.com/oracle/coherence-operator/pkg/rest"
+	"github.com/oracle/coherence-operator/pkg/tracing"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -39,6 +41,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -140,7 +143,9 @@ func execute(v *viper.Viper) error {
 	}
 	setupLog.Info("Kubernetes server version", "Major", sv.Major, "Minor", sv.Minor, "Platform", sv.Platform, "Host", cfg.Host)
 
-	// The Operator web-hook server has been removed, so we need to delete any existing web-hooks
+	// The Operator no longer has a mutating web-hook, and the validating web-hook is optional,
+	// so we need to delete any existing web-hooks that are not in use
+	enableWebhook := operator.IsWebhookEnabled()
 	setupLog.Info("Ensuring any existing webhook configurations are removed")
 	cl := cs.KubeClient.AdmissionregistrationV1()
 	// we ignore any errors
@@ -151,7 +156,7 @@ func execute(v *viper.Viper) error {
 		_ = cl.MutatingWebhookConfigurations().Delete(context.Background(), operator.DefaultMutatingWebhookName, metav1.DeleteOptions{})
 	}
 	_, err = cl.ValidatingWebhookConfigurations().Get(context.Background(), operator.DefaultValidatingWebhookName, metav1.GetOptions{})
-	if err == nil {
+	if err == nil && !enableWebhook {
 		// found web hook
 		setupLog.Info("Deleting existing ValidatingWebhookConfigurations", "Names", operator.DefaultValidatingWebhookName)
 		_ = cl.ValidatingWebhookConfigurations().Delete(context.Background(), operator.DefaultValidatingWebhookName, metav1.DeleteOptions{})
@@ -208,6 +213,15 @@ func execute(v *viper.Viper) error {
 		},
 	}
 
+	if enableWebhook {
+		setupLog.Info("Configuring validating webhook server", "Port", v.GetInt(operator.FlagWebhookPort))
+		options.WebhookServer = webhook.NewServer(webhook.Options{
+			Port:    v.GetInt(operator.FlagWebhookPort),
+			CertDir: v.GetString(operator.FlagWebhookCertDir),
+			TLSOpts: tlsOpts,
+		})
+	}
+
 	// Determine the Operator scope...
 	watchNamespaces := operator.GetWatchNamespace()
 	switch len(watchNamespaces) {
@@ -266,10 +280,51 @@ func execute(v *viper.Viper) error {
 		}
 	}
 
+	// Set up the CoherenceSnapshot and CoherenceRestore reconcilers
+	if operator.ShouldSupportSnapshots() {
+		setupLog.Info("Setting up CoherenceSnapshot reconciler")
+		if err = (&controllers.CoherenceSnapshotReconciler{
+			Client:    mgr.GetClient(),
+			ClientSet: cs,
+			Log:       ctrl.Log.WithName("controllers").WithName("CoherenceSnapshot"),
+			Scheme:    mgr.GetScheme(),
+		}).SetupWithManager(mgr, cs); err != nil {
+			return errors.Wrap(err, "unable to create CoherenceSnapshot controller")
+		}
+		setupLog.Info("Setting up CoherenceRestore reconciler")
+		if err = (&controllers.CoherenceRestoreReconciler{
+			Client:    mgr.GetClient(),
+			ClientSet: cs,
+			Log:       ctrl.Log.WithName("controllers").WithName("CoherenceRestore"),
+			Scheme:    mgr.GetScheme(),
+		}).SetupWithManager(mgr, cs); err != nil {
+			return errors.Wrap(err, "unable to create CoherenceRestore controller")
+		}
+	}
+
+	// Set up the validating web-hooks
+	if enableWebhook {
+		setupLog.Info("Setting up validating webhooks")
+		if err = cohwebhook.SetupWebhookWithManager(mgr, operator.ShouldSupportCoherenceJob()); err != nil {
+			return errors.Wrap(err, "unable to create validating webhooks")
+		}
+	}
+
 	if !dryRun {
 		// We intercept the signal handler here so that we can do clean-up before the Manager stops
 		handler := ctrl.SetupSignalHandler()
 
+		// Set up the optional tracing, flushing any pending spans when the Manager stops
+		shutdownTracing, err := tracing.Setup(handler)
+		if err != nil {
+			return errors.Wrap(err, "unable to set up tracing")
+		}
+		defer func() {
+			if err := shutdownTracing(context.Background()); err != nil {
+				setupLog.Error(err, "problem shutting down tracing")
+			}
+		}()
+
 		// Create the REST server
 		restServer := rest.NewServer(cs.KubeClient)
 		if err := restServer.SetupWithManager(mgr); err != nil {
@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2020, 2025, Oracle and/or its affiliates.
+ * Copyright (c) 2020, 2026, Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * http://oss.oracle.com/licenses/upl.
  */
@@ -365,6 +365,16 @@ func configureCommand(details *run_details.RunDetails) error {
 		details.AddSystemPropertyArg(v1.SysPropCoherencePersistenceSnapshotDir, snapshots)
 	}
 
+	// Configure the S3 snapshot archiver, the credentials are read by the archiver from the environment
+	endpoint := details.Getenv(v1.EnvVarCohArchiverEndpoint)
+	if endpoint != "" {
+		details.AddSystemPropertyArg(v1.SysPropOperatorArchiverEndpoint, endpoint)
+		details.AddSystemPropertyFromEnvVar(v1.EnvVarCohArchiverBucket, v1.SysPropOperatorArchiverBucket)
+		details.AddSystemPropertyFromEnvVar(v1.EnvVarCohArchiverPrefix, v1.SysPropOperatorArchiverPrefix)
+		details.SetSystemPropertyFromEnvVarOrDefault(v1.EnvVarCohArchiverRegion, v1.SysPropOperatorArchiverRegion, "us-east-1")
+		details.SetSystemPropertyFromEnvVarOrDefault(v1.EnvVarCohArchiverPathStyle, v1.SysPropOperatorArchiverPathStyle, "true")
+	}
+
 	// Set the Coherence site and rack values
 	configureSiteAndRack(details)
 
@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2020, 2025, Oracle and/or its affiliates.
+ * Copyright (c) 2020, 2026, Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * http://oss.oracle.com/licenses/upl.
  */
@@ -113,3 +113,47 @@ func TestServerWithSnapshotDirectory(t *testing.T) {
 	g.Expect(e.OsCmd.Path).To(Equal(GetJavaCommand()))
 	g.Expect(e.OsCmd.Args).To(ConsistOf(GetMinimalExpectedArgsWith(t, "-Dcoherence.distributed.persistence.snapshot.dir="+coh.VolumeMountPathSnapshots)))
 }
+
+func TestServerWithSnapshotArchiver(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	d := &coh.Coherence{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: coh.CoherenceStatefulSetResourceSpec{
+			CoherenceResourceSpec: coh.CoherenceResourceSpec{
+				Coherence: &coh.CoherenceSpec{
+					Persistence: &coh.PersistenceSpec{
+						Archiver: &coh.SnapshotArchiverSpec{
+							Endpoint:          "http://minio:9000",
+							Bucket:            "snapshots",
+							Region:            ptr.To("eu-west-1"),
+							PathStyle:         ptr.To(false),
+							CredentialsSecret: "minio-credentials",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expectedArgs := []string{
+		"-Dcoherence.operator.archiver.s3.endpoint=http://minio:9000",
+		"-Dcoherence.operator.archiver.s3.bucket=snapshots",
+		"-Dcoherence.operator.archiver.s3.region=eu-west-1",
+		"-Dcoherence.operator.archiver.s3.path.style=false",
+	}
+
+	verifyConfigFilesWithArgs(t, d, GetExpectedArgsFileContentWith(expectedArgs...))
+
+	env := EnvVarsFromDeployment(t, d)
+
+	args := []string{"server", "--dry-run"}
+	e, err := ExecuteWithArgsAndNewViper(env, args)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(e).NotTo(BeNil())
+	g.Expect(e.OsCmd).NotTo(BeNil())
+
+	g.Expect(e.OsCmd.Dir).To(Equal(TestAppDir))
+	g.Expect(e.OsCmd.Path).To(Equal(GetJavaCommand()))
+	g.Expect(e.OsCmd.Args).To(ConsistOf(GetMinimalExpectedArgsWith(t, expectedArgs...)))
+}
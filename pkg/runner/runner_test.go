@@ -478,6 +478,34 @@ func TestAddManagementSSLUsesManagementProperties(t *testing.T) {
 	g.Expect(args).NotTo(ContainElement("-Dcoherence.metrics.http.provider=MetricsSSLProvider"))
 }
 
+func TestAddManagementSSLWithCertManagerKeyStores(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ssl := &coh.SSLSpec{
+		Enabled:     ptr.To(true),
+		CertManager: &coh.SSLCertManagerSpec{},
+	}
+	env := make(map[string]string)
+	for _, e := range ssl.CreateEnvVars(coh.EnvVarCohMgmtPrefix, coh.VolumeMountPathManagementCerts) {
+		env[e.Name] = e.Value
+	}
+	details := newSSLRunDetails(env)
+
+	addManagementSSL(details)
+
+	certs := coh.VolumeMountPathManagementCerts + "/"
+	args := details.GetArguments()
+	g.Expect(args).To(ContainElements(
+		"-Dcoherence.management.http.provider=ManagementSSLProvider",
+		"-Dcoherence.management.security.keystore=file:"+certs+"keystore.p12",
+		"-Dcoherence.management.security.keystore.password="+certs+coh.CertificatePasswordFile,
+		"-Dcoherence.management.security.key.password="+certs+coh.CertificatePasswordFile,
+		"-Dcoherence.management.security.keystore.type=PKCS12",
+		"-Dcoherence.management.security.truststore=file:"+certs+"truststore.p12",
+		"-Dcoherence.management.security.truststore.password="+certs+coh.CertificatePasswordFile,
+		"-Dcoherence.management.security.truststore.type=PKCS12",
+	))
+}
+
 func TestAddSSLTrustStoreGuardsUseTrustStoreValues(t *testing.T) {
 	g := NewGomegaWithT(t)
 	trustOnly := newSSLRunDetails(map[string]string{
@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+// Package tracing contains the optional OpenTelemetry tracing of the Coherence Operator.
+// Tracing is enabled by setting the OTLP endpoint flag, otherwise the spans created using
+// this package are no-op spans.
+package tracing
+
+import (
+	"context"
+
+	"github.com/oracle/coherence-operator/pkg/operator"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// TracerName is the name of the Coherence Operator tracer.
+	TracerName = "github.com/oracle/coherence-operator"
+	// ServiceName is the service name the Coherence Operator traces are reported with.
+	ServiceName = "coherence-operator"
+
+	// AttributeResourceKind is the span attribute for the kind of Coherence resource.
+	AttributeResourceKind = attribute.Key("coherence.resource.kind")
+	// AttributeResourceName is the span attribute for the name of a Coherence resource.
+	AttributeResourceName = attribute.Key("coherence.resource.name")
+	// AttributeSecondaryKind is the span attribute for the kind of secondary resource being reconciled.
+	AttributeSecondaryKind = attribute.Key("coherence.secondary.kind")
+	// AttributeService is the span attribute for the name of the WKA service used by a probe.
+	AttributeService = attribute.Key("coherence.service")
+	// AttributeProbeType is the span attribute for the type of probe, exec, http or tcp.
+	AttributeProbeType = attribute.Key("coherence.probe.type")
+	// AttributeProbeSuccess is the span attribute for the result of a probe.
+	AttributeProbeSuccess = attribute.Key("coherence.probe.success")
+	// AttributeUpgradeStrategy is the span attribute for the rolling upgrade strategy.
+	AttributeUpgradeStrategy = attribute.Key("coherence.upgrade.strategy")
+	// AttributePodCount is the span attribute for a number of Pods.
+	AttributePodCount = attribute.Key("coherence.pod.count")
+)
+
+var log = logf.Log.WithName("tracing")
+
+// Setup configures the global tracer provider to export spans to the OTLP endpoint configured
+// by the Operator flags. If no endpoint is configured tracing is disabled. The returned function
+// flushes any pending spans and shuts down the tracer provider.
+func Setup(ctx context.Context) (func(context.Context) error, error) {
+	endpoint := operator.GetTracingEndpoint()
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if operator.IsTracingInsecure() {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ratio := operator.GetTracingSampleRatio()
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceName(ServiceName),
+			semconv.ServiceVersion(operator.GetVersion()),
+			semconv.K8SNamespaceName(operator.GetNamespace()),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	log.Info("Tracing enabled", "Endpoint", endpoint, "SampleRatio", ratio)
+	return provider.Shutdown, nil
+}
+
+// Start starts a span with the specified attributes.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartForResource starts a span for a Coherence resource, with the namespace, name and
+// kind of the resource as attributes.
+func StartForResource(ctx context.Context, name string, kind string, nn types.NamespacedName, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append([]attribute.KeyValue{
+		semconv.K8SNamespaceName(nn.Namespace),
+		AttributeResourceName.String(nn.Name),
+		AttributeResourceKind.String(kind),
+	}, attrs...)
+	return Start(ctx, name, attrs...)
+}
+
+// End ends a span, recording the error, if any, on the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2026, Oracle and/or its affiliates.
+ * Licensed under the Universal Permissive License v 1.0 as shown at
+ * http://oss.oracle.com/licenses/upl.
+ */
+
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	coh "github.com/oracle/coherence-operator/api/v1"
+	"github.com/oracle/coherence-operator/pkg/operator"
+	"github.com/oracle/coherence-operator/pkg/probe"
+	"github.com/oracle/coherence-operator/pkg/tracing"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace/noop"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	tracev1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestSetupWithoutEndpointDisablesTracing(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	operator.SetViper(viper.New())
+	defer operator.SetViper(nil)
+
+	shutdown, err := tracing.Setup(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(shutdown(context.Background())).To(Succeed())
+
+	_, span := tracing.Start(context.Background(), "test")
+	g.Expect(span.SpanContext().IsValid()).To(BeFalse())
+}
+
+func TestSpansAreExportedToCollector(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	collector := startCollector(t)
+
+	v := viper.New()
+	v.Set(operator.FlagTracingEndpoint, collector.addr)
+	v.Set(operator.FlagTracingInsecure, true)
+	v.Set(operator.FlagTracingSampleRatio, 1.0)
+	operator.SetViper(v)
+	defer operator.SetViper(nil)
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	shutdown, err := tracing.Setup(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	nn := types.NamespacedName{Namespace: "test", Name: "storage"}
+	spanCtx, span := tracing.StartForResource(ctx, "CoherenceReconciler.Reconcile", coh.ResourceTypeCoherence.Name(), nn)
+	pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "storage-0"}}
+	p := probe.CoherenceProbe{}
+	ok, err := p.RunProbe(spanCtx, pod, "storage-wka", &coh.Probe{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ok).To(BeTrue())
+	tracing.End(span, errors.New("reconcile failed"))
+
+	// shutting down flushes the spans to the collector
+	g.Expect(shutdown(ctx)).To(Succeed())
+
+	spans, resourceAttrs := collector.received()
+	g.Expect(resourceAttrs).To(HaveKeyWithValue("service.name", tracing.ServiceName))
+	g.Expect(spans).To(HaveLen(2))
+
+	reconcileSpan := findSpan(spans, "CoherenceReconciler.Reconcile")
+	g.Expect(reconcileSpan).NotTo(BeNil())
+	g.Expect(attributes(reconcileSpan.Attributes)).To(And(
+		HaveKeyWithValue("k8s.namespace.name", "test"),
+		HaveKeyWithValue(string(tracing.AttributeResourceName), "storage"),
+		HaveKeyWithValue(string(tracing.AttributeResourceKind), "Coherence"),
+	))
+	g.Expect(reconcileSpan.Status.Code).To(Equal(tracev1.Status_STATUS_CODE_ERROR))
+	g.Expect(reconcileSpan.Status.Message).To(Equal("reconcile failed"))
+
+	probeSpan := findSpan(spans, "CoherenceProbe.RunProbe")
+	g.Expect(probeSpan).NotTo(BeNil())
+	g.Expect(probeSpan.ParentSpanId).To(Equal(reconcileSpan.SpanId))
+	g.Expect(attributes(probeSpan.Attributes)).To(And(
+		HaveKeyWithValue("k8s.pod.name", "storage-0"),
+		HaveKeyWithValue(string(tracing.AttributeService), "storage-wka"),
+		HaveKeyWithValue(string(tracing.AttributeProbeSuccess), "true"),
+	))
+}
+
+// testCollector is an in-process OTLP trace collector.
+type testCollector struct {
+	collectortrace.UnimplementedTraceServiceServer
+	addr          string
+	lock          sync.Mutex
+	spans         []*tracev1.Span
+	resourceAttrs map[string]string
+}
+
+func startCollector(t *testing.T) *testCollector {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	collector := &testCollector{addr: listener.Addr().String(), resourceAttrs: make(map[string]string)}
+	server := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(server, collector)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+	return collector
+}
+
+func (in *testCollector) Export(_ context.Context, req *collectortrace.ExportTraceServiceRequest) (*collectortrace.ExportTraceServiceResponse, error) {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+	for _, rs := range req.ResourceSpans {
+		for k, v := range attributes(rs.Resource.Attributes) {
+			in.resourceAttrs[k] = v
+		}
+		for _, ss := range rs.ScopeSpans {
+			in.spans = append(in.spans, ss.Spans...)
+		}
+	}
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+func (in *testCollector) received() ([]*tracev1.Span, map[string]string) {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+	return in.spans, in.resourceAttrs
+}
+
+func findSpan(spans []*tracev1.Span, name string) *tracev1.Span {
+	for _, s := range spans {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// attributes converts OTLP attributes to a map of string values.
+func attributes(attrs []*commonv1.KeyValue) map[string]string {
+	m := make(map[string]string)
+	for _, kv := range attrs {
+		switch v := kv.Value.Value.(type) {
+		case *commonv1.AnyValue_StringValue:
+			m[kv.Key] = v.StringValue
+		case *commonv1.AnyValue_BoolValue:
+			if v.BoolValue {
+				m[kv.Key] = "true"
+			} else {
+				m[kv.Key] = "false"
+			}
+		}
+	}
+	return m
+}
@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2020, 2025, Oracle and/or its affiliates.
+ * Copyright (c) 2020, 2026, Oracle and/or its affiliates.
  * Licensed under the Universal Permissive License v 1.0 as shown at
  * http://oss.oracle.com/licenses/upl.
  */
@@ -27,8 +27,9 @@ import (
 var log = logf.Log.WithName("Storage")
 
 const (
-	storeKeyLatest   = "latest"
-	storeKeyPrevious = "previous"
+	storeKeyLatest             = "latest"
+	storeKeyPrevious           = "previous"
+	storeKeyPreviousGeneration = "previousGeneration"
 )
 
 type Storage interface {
@@ -38,6 +39,9 @@ type Storage interface {
 	GetLatest() coh.Resources
 	// GetPrevious obtains the deployment resources for the version prior to the specified version
 	GetPrevious() coh.Resources
+	// GetPreviousGeneration obtains the deployment resources last stored for the generation of the
+	// owning resource prior to the latest generation, which are the last known good resources
+	GetPreviousGeneration() coh.Resources
 	// Store will store the deployment resources, this will create a new version in the store
 	Store(context.Context, coh.Resources, coh.CoherenceResource) error
 	// Destroy will destroy the store
@@ -65,12 +69,13 @@ func newStorage(key client.ObjectKey, mgr manager.Manager, patcher patching.Reso
 }
 
 type secretStore struct {
-	manager  manager.Manager
-	key      client.ObjectKey
-	latest   coh.Resources
-	previous coh.Resources
-	hash     *string
-	patcher  patching.ResourcePatcher
+	manager            manager.Manager
+	key                client.ObjectKey
+	latest             coh.Resources
+	previous           coh.Resources
+	previousGeneration coh.Resources
+	hash               *string
+	patcher            patching.ResourcePatcher
 }
 
 func (in *secretStore) IsJob(request reconcile.Request) bool {
@@ -148,6 +153,13 @@ func (in *secretStore) GetPrevious() coh.Resources {
 	return in.previous
 }
 
+func (in *secretStore) GetPreviousGeneration() coh.Resources {
+	if in == nil {
+		return coh.Resources{}
+	}
+	return in.previousGeneration
+}
+
 func (in *secretStore) ResetHash(ctx context.Context, owner coh.CoherenceResource) error {
 	secret, _, err := in.getSecret()
 	if err != nil {
@@ -190,6 +202,10 @@ func (in *secretStore) Store(ctx context.Context, res coh.Resources, owner coh.C
 	if labels == nil {
 		labels = make(map[string]string)
 	}
+	// the latest resources only become the previous generation when the generation changes,
+	// storing the same generation again must not replace the last known good resources
+	oldHash, oldHashFound := labels[coh.LabelCoherenceHash]
+	newGeneration := oldHashFound && oldHash != hash && len(oldLatest) > 0
 	labels[coh.LabelCoherenceHash] = hash
 
 	globalLabels := owner.CreateGlobalLabels()
@@ -212,11 +228,17 @@ func (in *secretStore) Store(ctx context.Context, res coh.Resources, owner coh.C
 
 	secret.Data[storeKeyLatest] = newLatest
 	secret.Data[storeKeyPrevious] = oldLatest
+	if newGeneration {
+		secret.Data[storeKeyPreviousGeneration] = oldLatest
+	}
 
 	err = in.save(ctx, owner, secret)
 
 	if err == nil {
 		// everything was updated successfully so update the storage state
+		if newGeneration {
+			in.previousGeneration = in.latest
+		}
 		in.previous = in.latest
 		in.latest = res
 		in.hash = &hash
@@ -270,6 +292,12 @@ func (in *secretStore) loadVersions() error {
 				return errors.Wrap(err, "unmarshalling previous store state")
 			}
 		}
+		data, found = secret.Data[storeKeyPreviousGeneration]
+		if found && len(data) > 0 {
+			if err = json.Unmarshal(data, &in.previousGeneration); err != nil {
+				return errors.Wrap(err, "unmarshalling previous generation store state")
+			}
+		}
 
 		if hashValue, found := secret.GetLabels()[coh.LabelCoherenceHash]; found {
 			in.hash = &hashValue
@@ -321,6 +321,8 @@ func toLink(typeName string) string {
 		return fmt.Sprintf("%s%s-v1-core[%s]", k8sLink, strings.ToLower(typeName[7:]), escapeTypeName(typeName))
 	case strings.HasPrefix(typeName, "metav1."):
 		return fmt.Sprintf("%s%s-v1-meta[%s]", k8sLink, strings.ToLower(typeName[7:]), escapeTypeName(typeName))
+	case strings.HasPrefix(typeName, "networkingv1."):
+		return fmt.Sprintf("%s%s-v1-networking-k8s-io[%s]", k8sLink, strings.ToLower(typeName[13:]), escapeTypeName(typeName))
 	}
 
 	return typeName